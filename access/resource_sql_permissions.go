@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/compute"
@@ -245,6 +246,9 @@ func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceDat
 	if ci, ok := d.GetOk("cluster_id"); ok {
 		ta.ClusterID = ci.(string)
 	} else {
+		if err = ta.checkImplicitCompute(c); err != nil {
+			return
+		}
 		ta.ClusterID, err = ta.getOrCreateCluster(clustersAPI)
 		if err != nil {
 			return
@@ -253,6 +257,9 @@ func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceDat
 	clusterInfo, err := clustersAPI.StartAndGetInfo(ta.ClusterID)
 	if apierr.IsMissing(err) {
 		// cluster that was previously in a tfstate was deleted
+		if err = ta.checkImplicitCompute(c); err != nil {
+			return
+		}
 		ta.ClusterID, err = ta.getOrCreateCluster(clustersAPI)
 		if err != nil {
 			return
@@ -271,6 +278,13 @@ func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func (ta *SqlPermissions) checkImplicitCompute(c *common.DatabricksClient) error {
+	if err := c.CheckImplicitCompute("terraform-table-acl"); err != nil {
+		return fmt.Errorf("%w: specify cluster_id", err)
+	}
+	return nil
+}
+
 func (ta *SqlPermissions) getOrCreateCluster(clustersAPI clusters.ClustersAPI) (string, error) {
 	sparkVersion := clusters.LatestSparkVersionOrDefault(clustersAPI.Context(), clustersAPI.WorkspaceClient(), compute.SparkVersionRequest{
 		Latest: true,
@@ -313,6 +327,9 @@ func tableAclForLoad(ctx context.Context, d *schema.ResourceData, c *common.Data
 	return
 }
 
+// sqlPermissionsDefaultTimeout covers the start of a cluster and execution of GRANT statements on it
+const sqlPermissionsDefaultTimeout = 20 * time.Minute
+
 // ResourceSqlPermissions manages table ACLs
 func ResourceSqlPermissions() common.Resource {
 	s := common.StructToSchema(SqlPermissions{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -374,5 +391,10 @@ func ResourceSqlPermissions() common.Resource {
 			}
 			return ta.revoke()
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(sqlPermissionsDefaultTimeout),
+			Update: schema.DefaultTimeout(sqlPermissionsDefaultTimeout),
+			Delete: schema.DefaultTimeout(sqlPermissionsDefaultTimeout),
+		},
 	}
 }
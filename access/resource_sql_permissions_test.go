@@ -452,6 +452,20 @@ func TestResourceSqlPermissions_Create_Error(t *testing.T) {
 	}.ExpectError(t, "cannot read current grants: Some error")
 }
 
+func TestResourceSqlPermissions_Create_DisallowImplicitCompute(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `table = "foo"
+		privilege_assignments {
+			principal = "serge@example.com"
+			privileges = ["SELECT"]
+		}`,
+		DisallowImplicitCompute: true,
+		Resource:                ResourceSqlPermissions(),
+		Create:                  true,
+	}.ExpectError(t, "creation of the default terraform-table-acl cluster is disallowed by "+
+		"disallow_implicit_compute: specify cluster_id")
+}
+
 func TestResourceSqlPermissions_Create_Error2(t *testing.T) {
 	qa.ResourceFixture{
 		HCL: `table = "foo"
@@ -0,0 +1,117 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cascadeAcknowledgedSchema returns the schema of the attribute, that allows force_destroy to drop objects within
+// a catalog or a schema. There's no default value, so that existing resources don't get a diff after upgrade.
+func cascadeAcknowledgedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+// customizeCascadeDiff checks the cascade attributes at plan time. Dependents could only be listed with API calls,
+// that aren't made in CustomizeDiff, and Terraform doesn't call CustomizeDiff when planning the destroy, so they are
+// checked by checkCascade at the start of the deletion.
+func customizeCascadeDiff(d *schema.ResourceDiff) error {
+	if d.Get("cascade_acknowledged").(bool) && !d.Get("force_destroy").(bool) {
+		return fmt.Errorf("cascade_acknowledged could only be set together with force_destroy")
+	}
+	return nil
+}
+
+// schemaDependents lists tables, volumes, functions and models, that would be dropped together with the schema
+func schemaDependents(ctx context.Context, w *databricks.WorkspaceClient, catalogName, schemaName string) ([]string, error) {
+	var dependents []string
+	tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{
+		CatalogName:    catalogName,
+		SchemaName:     schemaName,
+		OmitColumns:    true,
+		OmitProperties: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		dependents = append(dependents, fmt.Sprintf("table %s (%s)", t.FullName, t.TableType))
+	}
+	volumes, err := w.Volumes.ListAll(ctx, catalog.ListVolumesRequest{
+		CatalogName: catalogName,
+		SchemaName:  schemaName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range volumes {
+		dependents = append(dependents, fmt.Sprintf("volume %s", v.FullName))
+	}
+	functions, err := w.Functions.ListAll(ctx, catalog.ListFunctionsRequest{
+		CatalogName: catalogName,
+		SchemaName:  schemaName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range functions {
+		dependents = append(dependents, fmt.Sprintf("function %s", f.FullName))
+	}
+	models, err := w.RegisteredModels.ListAll(ctx, catalog.ListRegisteredModelsRequest{
+		CatalogName: catalogName,
+		SchemaName:  schemaName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		dependents = append(dependents, fmt.Sprintf("model %s", m.FullName))
+	}
+	return dependents, nil
+}
+
+// catalogDependents lists schemas and their contents, that would be dropped together with the catalog
+func catalogDependents(ctx context.Context, w *databricks.WorkspaceClient, catalogName string) ([]string, error) {
+	var dependents []string
+	schemas, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{CatalogName: catalogName})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schemas {
+		// information_schema is managed by Databricks and is always present
+		if s.Name == "information_schema" {
+			continue
+		}
+		dependents = append(dependents, fmt.Sprintf("schema %s", s.FullName))
+		schemaObjects, err := schemaDependents(ctx, w, catalogName, s.Name)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, schemaObjects...)
+	}
+	return dependents, nil
+}
+
+// checkCascade fails the deletion with force_destroy, if it would drop any objects and it isn't acknowledged
+// with cascade_acknowledged = true
+func checkCascade(d *schema.ResourceData, kind string, dependents func() ([]string, error)) error {
+	if !d.Get("force_destroy").(bool) || d.Get("cascade_acknowledged").(bool) {
+		return nil
+	}
+	list, err := dependents()
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s %s can't be deleted, because force_destroy would drop the following objects, "+
+		"set cascade_acknowledged = true to drop them:\n- %s", kind, d.Id(), strings.Join(list, "\n- "))
+}
@@ -8,16 +8,48 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+// catalogSummary has the attributes of the catalog, that are returned by the list API
+type catalogSummary struct {
+	Name          string            `json:"name"`
+	CatalogType   string            `json:"catalog_type,omitempty"`
+	Owner         string            `json:"owner,omitempty"`
+	Comment       string            `json:"comment,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+	StorageRoot   string            `json:"storage_root,omitempty"`
+	IsolationMode string            `json:"isolation_mode,omitempty"`
+}
+
 func DataSourceCatalogs() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		Ids []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		NameGlob  string           `json:"name_glob,omitempty"`
+		NameRegex string           `json:"name_regex,omitempty"`
+		Ids       []string         `json:"ids,omitempty" tf:"computed,slice_set"`
+		Catalogs  []catalogSummary `json:"catalogs,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
+		matchesName, err := newNameFilter(data.NameGlob, data.NameRegex)
+		if err != nil {
+			return err
+		}
 		catalogs, err := w.Catalogs.ListAll(ctx, catalog.ListCatalogsRequest{})
 		if err != nil {
 			return err
 		}
+		data.Ids = nil
+		data.Catalogs = nil
 		for _, v := range catalogs {
+			if !matchesName(v.Name) {
+				continue
+			}
 			data.Ids = append(data.Ids, v.Name)
+			data.Catalogs = append(data.Catalogs, catalogSummary{
+				Name:          v.Name,
+				CatalogType:   string(v.CatalogType),
+				Owner:         v.Owner,
+				Comment:       v.Comment,
+				Properties:    v.Properties,
+				StorageRoot:   v.StorageRoot,
+				IsolationMode: string(v.IsolationMode),
+			})
 		}
 		return nil
 	})
@@ -34,6 +34,64 @@ func TestCatalogsData(t *testing.T) {
 	})
 }
 
+func TestCatalogsData_Filtered(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs?",
+				Response: catalog.ListCatalogsResponse{
+					Catalogs: []catalog.CatalogInfo{
+						{
+							Name:        "prod_sales",
+							CatalogType: catalog.CatalogTypeManagedCatalog,
+							Owner:       "data engineers",
+							Comment:     "sales data",
+							Properties: map[string]string{
+								"domain": "sales",
+							},
+							StorageRoot:   "s3://bucket/sales",
+							IsolationMode: catalog.CatalogIsolationModeIsolated,
+						},
+						{
+							Name: "dev_sales",
+						},
+						{
+							Name: "prod_marketing",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceCatalogs(),
+		HCL:         `name_glob = "prod_*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                          []string{"prod_marketing", "prod_sales"},
+		"catalogs.#":                   2,
+		"catalogs.0.name":              "prod_sales",
+		"catalogs.0.catalog_type":      "MANAGED_CATALOG",
+		"catalogs.0.owner":             "data engineers",
+		"catalogs.0.comment":           "sales data",
+		"catalogs.0.properties.domain": "sales",
+		"catalogs.0.storage_root":      "s3://bucket/sales",
+		"catalogs.0.isolation_mode":    "ISOLATED",
+		"catalogs.1.name":              "prod_marketing",
+	})
+}
+
+func TestCatalogsData_InvalidRegex(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceCatalogs(),
+		HCL:         `name_regex = "(a"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid name_regex (a: error parsing regexp: missing closing ): `(a`")
+}
+
 func TestCatalogsData_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    qa.HTTPFailures,
@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/catalog/permissions"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// EffectivePrivilege is a privilege of the principal, that is either granted directly on the securable,
+// or inherited from its parent, e.g. the catalog of the table
+type EffectivePrivilege struct {
+	Privilege         string `json:"privilege"`
+	InheritedFromType string `json:"inherited_from_type,omitempty"`
+	InheritedFromName string `json:"inherited_from_name,omitempty"`
+}
+
+type EffectiveGrant struct {
+	Principal  string               `json:"principal"`
+	Privileges []EffectivePrivilege `json:"privileges,omitempty"`
+}
+
+func DataSourceEffectiveGrants() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id            string           `json:"id,omitempty" tf:"computed"`
+		SecurableType string           `json:"securable_type"`
+		FullName      string           `json:"full_name"`
+		Principal     string           `json:"principal,omitempty"`
+		Grants        []EffectiveGrant `json:"grant,omitempty" tf:"computed"`
+		Privileges    []string         `json:"privileges,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		securableType, ok := permissions.Mappings[data.SecurableType]
+		if !ok {
+			securables := make([]string, 0, len(permissions.Mappings))
+			for securable := range permissions.Mappings {
+				securables = append(securables, securable)
+			}
+			sort.Strings(securables)
+			return fmt.Errorf("securable_type must be one of %s, got %s",
+				strings.Join(securables, ", "), data.SecurableType)
+		}
+		effective, err := w.Grants.GetEffective(ctx, catalog.GetEffectiveRequest{
+			SecurableType: securableType,
+			FullName:      data.FullName,
+			Principal:     data.Principal,
+		})
+		if err != nil {
+			return err
+		}
+		data.Grants = nil
+		data.Privileges = nil
+		// privileges are deduplicated, as the same privilege could be granted on several levels
+		privileges := map[string]bool{}
+		for _, pa := range effective.PrivilegeAssignments {
+			grant := EffectiveGrant{Principal: pa.Principal}
+			for _, p := range pa.Privileges {
+				grant.Privileges = append(grant.Privileges, EffectivePrivilege{
+					Privilege:         p.Privilege.String(),
+					InheritedFromType: string(p.InheritedFromType),
+					InheritedFromName: p.InheritedFromName,
+				})
+				privileges[p.Privilege.String()] = true
+			}
+			data.Grants = append(data.Grants, grant)
+		}
+		for privilege := range privileges {
+			data.Privileges = append(data.Privileges, privilege)
+		}
+		sort.Strings(data.Privileges)
+		data.Id = fmt.Sprintf("%s/%s", data.SecurableType, data.FullName)
+		if data.Principal != "" {
+			data.Id += "/" + data.Principal
+		}
+		return nil
+	})
+}
@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEffectiveGrantsData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockGrantsAPI().EXPECT().GetEffective(mock.Anything, catalog.GetEffectiveRequest{
+				SecurableType: catalog.SecurableTypeTable,
+				FullName:      "main.sales.orders",
+				Principal:     "analysts",
+			}).Return(&catalog.EffectivePermissionsList{
+				PrivilegeAssignments: []catalog.EffectivePrivilegeAssignment{
+					{
+						Principal: "analysts",
+						Privileges: []catalog.EffectivePrivilege{
+							{
+								Privilege: catalog.PrivilegeSelect,
+							},
+							{
+								Privilege:         catalog.PrivilegeSelect,
+								InheritedFromType: catalog.SecurableTypeSchema,
+								InheritedFromName: "main.sales",
+							},
+							{
+								Privilege:         catalog.PrivilegeUseCatalog,
+								InheritedFromType: catalog.SecurableTypeCatalog,
+								InheritedFromName: "main",
+							},
+						},
+					},
+				},
+			}, nil)
+		},
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		principal      = "analysts"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "table/main.sales.orders/analysts",
+		"grant.#":                        1,
+		"grant.0.principal":              "analysts",
+		"grant.0.privileges.#":           3,
+		"grant.0.privileges.0.privilege": "SELECT",
+		"grant.0.privileges.1.inherited_from_type": "schema",
+		"grant.0.privileges.1.inherited_from_name": "main.sales",
+		"grant.0.privileges.2.privilege":           "USE_CATALOG",
+		"privileges":                               []any{"SELECT", "USE_CATALOG"},
+	})
+}
+
+func TestEffectiveGrantsData_InvalidSecurableType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		securable_type = "cluster"
+		full_name      = "abc"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "securable_type must be one of catalog, external_location, foreign_connection, function, "+
+		"metastore, model, pipeline, recipient, schema, share, storage_credential, table, volume, got cluster")
+}
+
+func TestEffectiveGrantsData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: qa.HTTPFailures,
+		Resource: DataSourceEffectiveGrants(),
+		HCL: `
+		securable_type = "catalog"
+		full_name      = "main"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
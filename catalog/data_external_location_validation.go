@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataSourceExternalLocationValidation checks, which operations on the path of an external location are permitted by
+// its storage credential
+func DataSourceExternalLocationValidation() common.Resource {
+	type ExternalLocationValidation struct {
+		Id                   string                     `json:"id,omitempty" tf:"computed"`
+		ExternalLocationName string                     `json:"external_location_name,omitempty"`
+		Url                  string                     `json:"url,omitempty" tf:"computed"`
+		CredentialName       string                     `json:"credential_name,omitempty" tf:"computed"`
+		ReadOnly             bool                       `json:"read_only,omitempty" tf:"computed"`
+		IsDir                bool                       `json:"is_dir,omitempty" tf:"computed"`
+		Passed               bool                       `json:"passed,omitempty" tf:"computed"`
+		Results              []catalog.ValidationResult `json:"results,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *ExternalLocationValidation, w *databricks.WorkspaceClient) error {
+		if data.ExternalLocationName != "" {
+			location, err := w.ExternalLocations.GetByName(ctx, data.ExternalLocationName)
+			if err != nil {
+				return err
+			}
+			if data.Url == "" {
+				data.Url = location.Url
+			}
+			if data.CredentialName == "" {
+				data.CredentialName = location.CredentialName
+			}
+			data.ReadOnly = data.ReadOnly || location.ReadOnly
+		}
+		if data.Url == "" || data.CredentialName == "" {
+			return fmt.Errorf("either external_location_name or both url and credential_name must be specified")
+		}
+		validation, err := w.StorageCredentials.Validate(ctx, catalog.ValidateStorageCredential{
+			ExternalLocationName:  data.ExternalLocationName,
+			StorageCredentialName: data.CredentialName,
+			Url:                   data.Url,
+			ReadOnly:              data.ReadOnly,
+		})
+		if err != nil {
+			return err
+		}
+		data.IsDir = validation.IsDir
+		data.Results = validation.Results
+		data.Passed = true
+		for _, result := range validation.Results {
+			if result.Result == catalog.ValidationResultResultFail {
+				data.Passed = false
+			}
+		}
+		data.Id = data.Url
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExternalLocationValidationData_ByName(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockExternalLocationsAPI().EXPECT().GetByName(mock.Anything, "abc").Return(
+				&catalog.ExternalLocationInfo{
+					Name:           "abc",
+					CredentialName: "cred",
+					Url:            "s3://test",
+					ReadOnly:       true,
+				}, nil)
+			w.GetMockStorageCredentialsAPI().EXPECT().Validate(mock.Anything, catalog.ValidateStorageCredential{
+				ExternalLocationName:  "abc",
+				StorageCredentialName: "cred",
+				Url:                   "s3://test",
+				ReadOnly:              true,
+			}).Return(&catalog.ValidateStorageCredentialResponse{
+				IsDir: true,
+				Results: []catalog.ValidationResult{
+					{
+						Operation: catalog.ValidationResultOperationList,
+						Result:    catalog.ValidationResultResultPass,
+					},
+					{
+						Operation: catalog.ValidationResultOperationRead,
+						Result:    catalog.ValidationResultResultFail,
+						Message:   "Access denied",
+					},
+				},
+			}, nil)
+		},
+		Resource:    DataSourceExternalLocationValidation(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `external_location_name = "abc"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "s3://test",
+		"credential_name":     "cred",
+		"read_only":           true,
+		"is_dir":              true,
+		"passed":              false,
+		"results.#":           2,
+		"results.1.operation": "READ",
+		"results.1.result":    "FAIL",
+		"results.1.message":   "Access denied",
+	})
+}
+
+func TestExternalLocationValidationData_ByUrl(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStorageCredentialsAPI().EXPECT().Validate(mock.Anything, catalog.ValidateStorageCredential{
+				StorageCredentialName: "cred",
+				Url:                   "s3://test/path",
+			}).Return(&catalog.ValidateStorageCredentialResponse{
+				Results: []catalog.ValidationResult{
+					{
+						Operation: catalog.ValidationResultOperationWrite,
+						Result:    catalog.ValidationResultResultPass,
+					},
+				},
+			}, nil)
+		},
+		Resource:    DataSourceExternalLocationValidation(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		url = "s3://test/path"
+		credential_name = "cred"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "s3://test/path",
+		"passed":    true,
+		"results.#": 1,
+	})
+}
+
+func TestExternalLocationValidationData_MissingUrl(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                DataSourceExternalLocationValidation(),
+		Read:                    true,
+		NonWritable:             true,
+		ID:                      "_",
+		HCL:                     `credential_name = "cred"`,
+	}.ExpectError(t, "either external_location_name or both url and credential_name must be specified")
+}
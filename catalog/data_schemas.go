@@ -8,17 +8,49 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+// schemaSummary has the attributes of the schema, that are returned by the list API
+type schemaSummary struct {
+	FullName    string            `json:"full_name"`
+	Name        string            `json:"name"`
+	CatalogName string            `json:"catalog_name,omitempty"`
+	Owner       string            `json:"owner,omitempty"`
+	Comment     string            `json:"comment,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	StorageRoot string            `json:"storage_root,omitempty"`
+}
+
 func DataSourceSchemas() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		CatalogName string   `json:"catalog_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string          `json:"catalog_name"`
+		NameGlob    string          `json:"name_glob,omitempty"`
+		NameRegex   string          `json:"name_regex,omitempty"`
+		Ids         []string        `json:"ids,omitempty" tf:"computed,slice_set"`
+		Schemas     []schemaSummary `json:"schemas,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
+		matchesName, err := newNameFilter(data.NameGlob, data.NameRegex)
+		if err != nil {
+			return err
+		}
 		schemas, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{CatalogName: data.CatalogName})
 		if err != nil {
 			return err
 		}
+		data.Ids = nil
+		data.Schemas = nil
 		for _, v := range schemas {
+			if !matchesName(v.Name) {
+				continue
+			}
 			data.Ids = append(data.Ids, v.FullName)
+			data.Schemas = append(data.Schemas, schemaSummary{
+				FullName:    v.FullName,
+				Name:        v.Name,
+				CatalogName: v.CatalogName,
+				Owner:       v.Owner,
+				Comment:     v.Comment,
+				Properties:  v.Properties,
+				StorageRoot: v.StorageRoot,
+			})
 		}
 		return nil
 	})
@@ -33,6 +33,64 @@ func TestSchemasData(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestSchemasData_Filtered(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/schemas?catalog_name=a",
+				Response: catalog.ListSchemasResponse{
+					Schemas: []catalog.SchemaInfo{
+						{
+							FullName:    "a.raw_events",
+							Name:        "raw_events",
+							CatalogName: "a",
+							Owner:       "ingestion",
+							Comment:     "raw data",
+							Properties: map[string]string{
+								"layer": "bronze",
+							},
+							StorageRoot: "s3://bucket/raw",
+						},
+						{
+							FullName:    "a.gold",
+							Name:        "gold",
+							CatalogName: "a",
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceSchemas(),
+		HCL: `catalog_name = "a"
+		name_regex = "^raw_"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                        []string{"a.raw_events"},
+		"schemas.#":                  1,
+		"schemas.0.full_name":        "a.raw_events",
+		"schemas.0.name":             "raw_events",
+		"schemas.0.catalog_name":     "a",
+		"schemas.0.owner":            "ingestion",
+		"schemas.0.comment":          "raw data",
+		"schemas.0.properties.layer": "bronze",
+		"schemas.0.storage_root":     "s3://bucket/raw",
+	})
+}
+
+func TestSchemasData_InvalidGlob(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceSchemas(),
+		HCL: `catalog_name = "a"
+		name_glob = "[a"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid name_glob [a: syntax error in pattern")
+}
+
 func TestSchemasData_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    qa.HTTPFailures,
@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceSystemSchemas() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id          string                     `json:"id,omitempty" tf:"computed"`
+		MetastoreID string                     `json:"metastore_id,omitempty" tf:"computed"`
+		Schemas     []catalog.SystemSchemaInfo `json:"schemas,omitempty" tf:"computed"`
+		// Available are the schemas, that could be enabled with databricks_system_schema
+		Available []string `json:"available,omitempty" tf:"computed,slice_set"`
+		Enabled   []string `json:"enabled,omitempty" tf:"computed,slice_set"`
+	}, w *databricks.WorkspaceClient) error {
+		metastoreSummary, err := w.Metastores.Summary(ctx)
+		if err != nil {
+			return err
+		}
+		schemas, err := w.SystemSchemas.ListByMetastoreId(ctx, metastoreSummary.MetastoreId)
+		if err != nil {
+			return err
+		}
+		data.Id = metastoreSummary.MetastoreId
+		data.MetastoreID = metastoreSummary.MetastoreId
+		data.Schemas = schemas.Schemas
+		for _, s := range schemas.Schemas {
+			switch s.State {
+			case catalog.SystemSchemaInfoStateAvailable:
+				data.Available = append(data.Available, s.Schema)
+			case catalog.SystemSchemaInfoStateEnableCompleted, catalog.SystemSchemaInfoStateEnableInitialized:
+				data.Enabled = append(data.Enabled, s.Schema)
+			}
+		}
+		return nil
+	})
+}
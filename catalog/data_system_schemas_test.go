@@ -0,0 +1,68 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestSystemSchemasData(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastore_summary",
+				Response: catalog.GetMetastoreSummaryResponse{
+					MetastoreId: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/metastores/abc/systemschemas?",
+				Response: catalog.ListSystemSchemasResponse{
+					Schemas: []catalog.SystemSchemaInfo{
+						{
+							Schema: "access",
+							State:  catalog.SystemSchemaInfoStateEnableCompleted,
+						},
+						{
+							Schema: "billing",
+							State:  catalog.SystemSchemaInfoStateEnableInitialized,
+						},
+						{
+							Schema: "compute",
+							State:  catalog.SystemSchemaInfoStateAvailable,
+						},
+						{
+							Schema: "marketplace",
+							State:  catalog.SystemSchemaInfoStateUnavailable,
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceSystemSchemas(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "abc",
+		"metastore_id":     "abc",
+		"schemas.#":        4,
+		"schemas.2.schema": "compute",
+		"schemas.3.state":  "UNAVAILABLE",
+		"available":        []string{"compute"},
+		"enabled":          []string{"access", "billing"},
+	})
+}
+
+func TestSystemSchemasData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceSystemSchemas(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -8,11 +8,23 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+// TableColumn is a flattened column of the table, that is easier to use in expressions than ColumnInfo
+type TableColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+	Comment  string `json:"comment,omitempty"`
+}
+
 func DataSourceTable() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		Id    string             `json:"id,omitempty" tf:"computed"`
-		Name  string             `json:"name"`
-		Table *catalog.TableInfo `json:"table_info,omitempty" tf:"computed"`
+		Id              string             `json:"id,omitempty" tf:"computed"`
+		Name            string             `json:"name"`
+		Table           *catalog.TableInfo `json:"table_info,omitempty" tf:"computed"`
+		Columns         []TableColumn      `json:"columns,omitempty" tf:"computed"`
+		Properties      map[string]string  `json:"properties,omitempty" tf:"computed"`
+		StorageLocation string             `json:"storage_location,omitempty" tf:"computed"`
+		Owner           string             `json:"owner,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
 		table, err := w.Tables.GetByFullName(ctx, data.Name)
 		if err != nil {
@@ -20,6 +32,18 @@ func DataSourceTable() common.Resource {
 		}
 		data.Table = table
 		data.Id = table.TableId
+		data.Columns = nil
+		for _, column := range table.Columns {
+			data.Columns = append(data.Columns, TableColumn{
+				Name:     column.Name,
+				Type:     column.TypeText,
+				Nullable: column.Nullable,
+				Comment:  column.Comment,
+			})
+		}
+		data.Properties = table.Properties
+		data.StorageLocation = table.StorageLocation
+		data.Owner = table.Owner
 		return nil
 	})
 }
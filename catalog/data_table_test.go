@@ -18,6 +18,23 @@ func TestTableData(t *testing.T) {
 				Name:      "c",
 				Owner:     "account users",
 				TableType: catalog.TableTypeExternal,
+				Columns: []catalog.ColumnInfo{
+					{
+						Name:     "id",
+						TypeText: "bigint",
+						Nullable: false,
+						Comment:  "identifier",
+					},
+					{
+						Name:     "tags",
+						TypeText: "map<string,string>",
+						Nullable: true,
+					},
+				},
+				Properties: map[string]string{
+					"quality": "gold",
+				},
+				StorageLocation: "s3://bucket/c",
 			}, nil)
 		},
 		Resource: DataSourceTable(),
@@ -32,6 +49,17 @@ func TestTableData(t *testing.T) {
 		"table_info.0.name":       "c",
 		"table_info.0.owner":      "account users",
 		"table_info.0.table_type": "EXTERNAL",
+		"columns.#":               2,
+		"columns.0.name":          "id",
+		"columns.0.type":          "bigint",
+		"columns.0.nullable":      false,
+		"columns.0.comment":       "identifier",
+		"columns.1.name":          "tags",
+		"columns.1.type":          "map<string,string>",
+		"columns.1.nullable":      true,
+		"properties.quality":      "gold",
+		"storage_location":        "s3://bucket/c",
+		"owner":                   "account users",
 	})
 }
 
@@ -2,26 +2,94 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"regexp"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+// tableSummary is a short description of the table, that doesn't require reading every table separately
+type tableSummary struct {
+	FullName  string `json:"full_name"`
+	Name      string `json:"name"`
+	TableType string `json:"table_type,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+// newNameFilter returns a function, that matches names against optional glob and regular expression patterns
+func newNameFilter(nameGlob, nameRegex string) (func(string) bool, error) {
+	if nameGlob != "" {
+		if _, err := path.Match(nameGlob, ""); err != nil {
+			return nil, fmt.Errorf("invalid name_glob %s: %w", nameGlob, err)
+		}
+	}
+	var re *regexp.Regexp
+	if nameRegex != "" {
+		var err error
+		re, err = regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex %s: %w", nameRegex, err)
+		}
+	}
+	return func(name string) bool {
+		if nameGlob != "" {
+			// pattern is validated above, so the error could be ignored
+			if matched, _ := path.Match(nameGlob, name); !matched {
+				return false
+			}
+		}
+		return re == nil || re.MatchString(name)
+	}, nil
+}
+
 func DataSourceTables() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		CatalogName string   `json:"catalog_name"`
-		SchemaName  string   `json:"schema_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName           string         `json:"catalog_name"`
+		SchemaName            string         `json:"schema_name"`
+		NameGlob              string         `json:"name_glob,omitempty"`
+		NameRegex             string         `json:"name_regex,omitempty"`
+		OmitViews             bool           `json:"omit_views,omitempty" tf:"default:true"`
+		OmitMaterializedViews bool           `json:"omit_materialized_views,omitempty"`
+		Ids                   []string       `json:"ids,omitempty" tf:"computed,slice_set"`
+		Tables                []tableSummary `json:"tables,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
-		tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{CatalogName: data.CatalogName, SchemaName: data.SchemaName})
+		matchesName, err := newNameFilter(data.NameGlob, data.NameRegex)
 		if err != nil {
 			return err
 		}
+		tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{
+			CatalogName:    data.CatalogName,
+			SchemaName:     data.SchemaName,
+			OmitColumns:    true,
+			OmitProperties: true,
+		})
+		if err != nil {
+			return err
+		}
+		data.Ids = nil
+		data.Tables = nil
 		for _, v := range tables {
-			if v.TableType != "VIEW" {
-				data.Ids = append(data.Ids, v.FullName)
+			if data.OmitViews && v.TableType == catalog.TableTypeView {
+				continue
+			}
+			if data.OmitMaterializedViews && v.TableType == catalog.TableTypeMaterializedView {
+				continue
+			}
+			if !matchesName(v.Name) {
+				continue
 			}
+			data.Ids = append(data.Ids, v.FullName)
+			data.Tables = append(data.Tables, tableSummary{
+				FullName:  v.FullName,
+				Name:      v.Name,
+				TableType: string(v.TableType),
+				Owner:     v.Owner,
+				Comment:   v.Comment,
+			})
 		}
 		return nil
 	})
@@ -15,7 +15,7 @@ func TestTablesData(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&schema_name=b",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&omit_columns=true&omit_properties=true&schema_name=b",
 				Response: catalog.ListTablesResponse{
 					Tables: []catalog.TableInfo{
 						{
@@ -47,7 +47,7 @@ func TestTablesDataIssue1264(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&schema_name=b",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&omit_columns=true&omit_properties=true&schema_name=b",
 				Response: catalog.ListTablesResponse{
 					Tables: []catalog.TableInfo{
 						{
@@ -79,7 +79,7 @@ func TestTablesDataIssue1264(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&schema_name=b",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&omit_columns=true&omit_properties=true&schema_name=b",
 				Response: catalog.ListTablesResponse{
 					Tables: []catalog.TableInfo{
 						{
@@ -108,6 +108,102 @@ func TestTablesDataIssue1264(t *testing.T) {
 	assert.True(t, s.Contains("a.b.c"))
 }
 
+func TestTablesData_Filters(t *testing.T) {
+	fixtures := []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&omit_columns=true&omit_properties=true&schema_name=b",
+			Response: catalog.ListTablesResponse{
+				Tables: []catalog.TableInfo{
+					{
+						FullName:  "a.b.fct_sales",
+						Name:      "fct_sales",
+						TableType: catalog.TableTypeManaged,
+						Owner:     "data engineers",
+						Comment:   "sales facts",
+					},
+					{
+						FullName:  "a.b.fct_sales_v",
+						Name:      "fct_sales_v",
+						TableType: catalog.TableTypeView,
+					},
+					{
+						FullName:  "a.b.fct_sales_mv",
+						Name:      "fct_sales_mv",
+						TableType: catalog.TableTypeMaterializedView,
+					},
+					{
+						FullName:  "a.b.dim_date",
+						Name:      "dim_date",
+						TableType: catalog.TableTypeExternal,
+					},
+				},
+			},
+			ReuseRequest: true,
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: fixtures,
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		name_glob = "fct_*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                 []string{"a.b.fct_sales", "a.b.fct_sales_mv"},
+		"tables.#":            2,
+		"tables.0.full_name":  "a.b.fct_sales",
+		"tables.0.name":       "fct_sales",
+		"tables.0.table_type": "MANAGED",
+		"tables.0.owner":      "data engineers",
+		"tables.0.comment":    "sales facts",
+		"tables.1.table_type": "MATERIALIZED_VIEW",
+	})
+	qa.ResourceFixture{
+		Fixtures: fixtures,
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		name_regex = "_(v|mv)$"
+		omit_views = false
+		omit_materialized_views = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                 []string{"a.b.fct_sales_v"},
+		"tables.#":            1,
+		"tables.0.table_type": "VIEW",
+	})
+}
+
+func TestTablesData_InvalidFilters(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		name_glob = "[a"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid name_glob [a: syntax error in pattern")
+	qa.ResourceFixture{
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		name_regex = "(a"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid name_regex (a: error parsing regexp: missing closing ): `(a`")
+}
+
 func TestTablesData_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    qa.HTTPFailures,
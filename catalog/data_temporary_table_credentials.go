@@ -0,0 +1,91 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Temporary table credentials aren't available in the Go SDK yet, so the API is defined here
+type AwsTemporaryCredentials struct {
+	AccessKeyId     string `json:"access_key_id,omitempty" tf:"sensitive"`
+	SecretAccessKey string `json:"secret_access_key,omitempty" tf:"sensitive"`
+	SessionToken    string `json:"session_token,omitempty" tf:"sensitive"`
+	AccessPoint     string `json:"access_point,omitempty"`
+}
+
+type AzureUserDelegationSas struct {
+	SasToken string `json:"sas_token,omitempty" tf:"sensitive"`
+}
+
+type GcpOauthToken struct {
+	OauthToken string `json:"oauth_token,omitempty" tf:"sensitive"`
+}
+
+type R2TemporaryCredentials struct {
+	AccessKeyId     string `json:"access_key_id,omitempty" tf:"sensitive"`
+	SecretAccessKey string `json:"secret_access_key,omitempty" tf:"sensitive"`
+	SessionToken    string `json:"session_token,omitempty" tf:"sensitive"`
+}
+
+type generateTemporaryTableCredentials struct {
+	TableId   string `json:"table_id"`
+	Operation string `json:"operation"`
+}
+
+type TemporaryTableCredentials struct {
+	AwsTempCredentials     *AwsTemporaryCredentials `json:"aws_temp_credentials,omitempty" tf:"computed"`
+	AzureUserDelegationSas *AzureUserDelegationSas  `json:"azure_user_delegation_sas,omitempty" tf:"computed"`
+	GcpOauthToken          *GcpOauthToken           `json:"gcp_oauth_token,omitempty" tf:"computed"`
+	R2TempCredentials      *R2TemporaryCredentials  `json:"r2_temp_credentials,omitempty" tf:"computed"`
+	// ExpirationTime is the time in milliseconds since epoch, after which the credentials can't be used anymore
+	ExpirationTime int64  `json:"expiration_time,omitempty" tf:"computed"`
+	Url            string `json:"url,omitempty" tf:"computed"`
+}
+
+type temporaryTableCredentialsData struct {
+	Id        string `json:"id,omitempty" tf:"computed"`
+	TableName string `json:"table_name"`
+	Operation string `json:"operation,omitempty" tf:"default:READ"`
+	TableId   string `json:"table_id,omitempty" tf:"computed"`
+	TemporaryTableCredentials
+}
+
+// DataSourceTemporaryTableCredentials generates temporary credentials for a table on every read,
+// so that the credentials in the state are always fresh at the time of plan or apply
+func DataSourceTemporaryTableCredentials() common.Resource {
+	s := common.StructToSchema(temporaryTableCredentialsData{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "operation").SetValidateFunc(validation.StringInSlice([]string{"READ", "READ_WRITE"}, false))
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var data temporaryTableCredentialsData
+			common.DataToStructPointer(d, s, &data)
+			table, err := w.Tables.GetByFullName(ctx, data.TableName)
+			if err != nil {
+				return err
+			}
+			var credentials TemporaryTableCredentials
+			err = c.Post(ctx, "/unity-catalog/temporary-table-credentials", generateTemporaryTableCredentials{
+				TableId:   table.TableId,
+				Operation: data.Operation,
+			}, &credentials)
+			if err != nil {
+				return err
+			}
+			data.Id = table.TableId
+			data.TableId = table.TableId
+			data.TemporaryTableCredentials = credentials
+			d.SetId(table.TableId)
+			return common.StructToData(data, s, d)
+		},
+	}
+}
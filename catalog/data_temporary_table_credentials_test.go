@@ -0,0 +1,77 @@
+package catalog
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemporaryTableCredentialsData(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders?",
+				Response: catalog.TableInfo{
+					FullName: "main.sales.orders",
+					TableId:  "a1b2c3",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/unity-catalog/temporary-table-credentials",
+				ExpectedRequest: generateTemporaryTableCredentials{
+					TableId:   "a1b2c3",
+					Operation: "READ_WRITE",
+				},
+				Response: TemporaryTableCredentials{
+					AwsTempCredentials: &AwsTemporaryCredentials{
+						AccessKeyId:     "ASIA",
+						SecretAccessKey: "secret",
+						SessionToken:    "token",
+					},
+					ExpirationTime: 1700003600000,
+					Url:            "s3://bucket/orders",
+				},
+			},
+		},
+		Resource: DataSourceTemporaryTableCredentials(),
+		HCL: `
+		table_name = "main.sales.orders"
+		operation  = "READ_WRITE"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                   "a1b2c3",
+		"table_id":                             "a1b2c3",
+		"aws_temp_credentials.0.access_key_id": "ASIA",
+		"aws_temp_credentials.0.session_token": "token",
+		"expiration_time":                      1700003600000,
+		"url":                                  "s3://bucket/orders",
+	})
+}
+
+func TestTemporaryTableCredentialsData_InvalidOperation(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceTemporaryTableCredentials(),
+		HCL: `
+		table_name = "main.sales.orders"
+		operation  = "WRITE"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid config supplied. [operation] expected operation to be one of [READ READ_WRITE], got WRITE")
+}
+
+func TestTemporaryTableCredentialsData_Sensitive(t *testing.T) {
+	s := DataSourceTemporaryTableCredentials().Schema
+	aws := s["aws_temp_credentials"].Elem.(*schema.Resource).Schema
+	assert.True(t, aws["secret_access_key"].Sensitive)
+	assert.True(t, aws["session_token"].Sensitive)
+	assert.False(t, aws["access_point"].Sensitive)
+}
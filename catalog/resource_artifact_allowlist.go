@@ -2,10 +2,14 @@ package catalog
 
 import (
 	"context"
+	"slices"
+	"sync"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type ArtifactAllowlistInfo struct {
@@ -21,8 +25,76 @@ type ArtifactAllowlistInfo struct {
 	MetastoreId string `json:"metastore_id,omitempty" tf:"computed"`
 }
 
+// isAuthoritativeAllowlist is true, unless authoritative is explicitly set to false. There's no default value,
+// so that existing resources don't get a diff after upgrade, the same as for authoritative of databricks_permissions.
+func isAuthoritativeAllowlist(d *schema.ResourceData) bool {
+	v, ok := d.GetOkExists("authoritative")
+	return !ok || v.(bool)
+}
+
+// artifactAllowlistMutex serializes changes of allowlists, because non-authoritative resources of the same
+// artifact type are applied in parallel, and each of them reads and writes the whole allowlist
+var artifactAllowlistMutex sync.Mutex
+
+// mergeArtifactMatchers keeps the remote matchers, that aren't removed, and appends the added ones
+func mergeArtifactMatchers(remote, removed, added []catalog.ArtifactMatcher) []catalog.ArtifactMatcher {
+	merged := []catalog.ArtifactMatcher{}
+	for _, m := range remote {
+		if slices.Contains(removed, m) || slices.Contains(added, m) {
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return append(merged, added...)
+}
+
+func artifactMatchersFrom(v any) (matchers []catalog.ArtifactMatcher) {
+	for _, item := range v.(*schema.Set).List() {
+		m := item.(map[string]any)
+		matchers = append(matchers, catalog.ArtifactMatcher{
+			Artifact:  m["artifact"].(string),
+			MatchType: catalog.MatchType(m["match_type"].(string)),
+		})
+	}
+	return
+}
+
+// setArtifactMatchers replaces the allowlist, or, if the resource isn't authoritative,
+// changes only the matchers, that are managed by this resource
+func setArtifactMatchers(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData,
+	artifactType catalog.ArtifactType, removed, added []catalog.ArtifactMatcher) (*catalog.ArtifactAllowlistInfo, error) {
+	artifactAllowlistMutex.Lock()
+	defer artifactAllowlistMutex.Unlock()
+	matchers := added
+	if !isAuthoritativeAllowlist(d) {
+		current, err := w.ArtifactAllowlists.GetByArtifactType(ctx, artifactType)
+		if err != nil {
+			return nil, err
+		}
+		matchers = mergeArtifactMatchers(current.ArtifactMatchers, removed, added)
+	}
+	if matchers == nil {
+		matchers = []catalog.ArtifactMatcher{}
+	}
+	return w.ArtifactAllowlists.Update(ctx, catalog.SetArtifactAllowlist{
+		ArtifactMatchers: matchers,
+		ArtifactType:     artifactType,
+	})
+}
+
 func ResourceArtifactAllowlist() common.Resource {
-	allowlistSchema := common.StructToSchema(ArtifactAllowlistInfo{}, common.NoCustomize)
+	allowlistSchema := common.StructToSchema(ArtifactAllowlistInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "artifact_type").SetValidateFunc(validation.StringInSlice([]string{
+			string(catalog.ArtifactTypeInitScript),
+			string(catalog.ArtifactTypeLibraryJar),
+			string(catalog.ArtifactTypeLibraryMaven),
+		}, false))
+		m["authoritative"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+		return m
+	})
 	p := common.NewPairID("metastore_id", "artifact_type")
 
 	createOrUpdate := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -38,11 +110,12 @@ func ResourceArtifactAllowlist() common.Resource {
 		var createAllowlist ArtifactAllowlistInfo
 		common.DataToStructPointer(d, allowlistSchema, &createAllowlist)
 
-		al, err := w.ArtifactAllowlists.Update(ctx, catalog.SetArtifactAllowlist{
-			ArtifactMatchers: createAllowlist.ArtifactMatchers,
-			ArtifactType:     createAllowlist.ArtifactType,
-		})
-
+		var removed []catalog.ArtifactMatcher
+		if d.HasChange("artifact_matcher") {
+			old, new := d.GetChange("artifact_matcher")
+			removed = artifactMatchersFrom(old.(*schema.Set).Difference(new.(*schema.Set)))
+		}
+		al, err := setArtifactMatchers(ctx, w, d, createAllowlist.ArtifactType, removed, createAllowlist.ArtifactMatchers)
 		if err != nil {
 			return err
 		}
@@ -77,6 +150,14 @@ func ResourceArtifactAllowlist() common.Resource {
 				MetastoreId:      al.MetastoreId,
 				ArtifactType:     catalog.ArtifactType(artifactType),
 			}
+			// imported allowlists are managed authoritatively
+			if !isAuthoritativeAllowlist(d) {
+				// matchers of other resources or teams aren't a configuration drift
+				managed := artifactMatchersFrom(d.Get("artifact_matcher"))
+				allowlist.ArtifactMatchers = slices.DeleteFunc(allowlist.ArtifactMatchers, func(m catalog.ArtifactMatcher) bool {
+					return !slices.Contains(managed, m)
+				})
+			}
 
 			return common.StructToData(allowlist, allowlistSchema, d)
 		},
@@ -97,10 +178,8 @@ func ResourceArtifactAllowlist() common.Resource {
 				return err
 			}
 
-			_, err = w.ArtifactAllowlists.Update(ctx, catalog.SetArtifactAllowlist{
-				ArtifactType:     catalog.ArtifactType(artifactType),
-				ArtifactMatchers: []catalog.ArtifactMatcher{},
-			})
+			_, err = setArtifactMatchers(ctx, w, d, catalog.ArtifactType(artifactType),
+				artifactMatchersFrom(d.Get("artifact_matcher")), nil)
 			if err != nil {
 				return err
 			}
@@ -293,3 +293,231 @@ func TestArtifactAllowlistDelete_Error(t *testing.T) {
 		ID:       id,
 	}.ExpectError(t, "Something went wrong")
 }
+
+func TestArtifactAllowlistImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/INIT_SCRIPT?",
+				Response: artifactInfo,
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Read:     true,
+		New:      true,
+		ID:       id,
+	}.ApplyAndExpectData(t, map[string]any{
+		"artifact_matcher.#": 1,
+		"authoritative":      false,
+	})
+}
+
+var sharedJarsInfo = catalog.ArtifactAllowlistInfo{
+	ArtifactMatchers: []catalog.ArtifactMatcher{
+		{
+			Artifact:  "/Volumes/other_team/jars",
+			MatchType: catalog.MatchTypePrefixMatch,
+		},
+		{
+			Artifact:  "/Volumes/jars",
+			MatchType: catalog.MatchTypePrefixMatch,
+		},
+	},
+	MetastoreId: "abc",
+}
+
+func TestArtifactAllowlistCreate_NonAuthoritative(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: catalog.ArtifactAllowlistInfo{
+					ArtifactMatchers: sharedJarsInfo.ArtifactMatchers[:1],
+					MetastoreId:      "abc",
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR",
+				ExpectedRequest: catalog.SetArtifactAllowlist{
+					ArtifactType:     catalog.ArtifactTypeLibraryJar,
+					ArtifactMatchers: sharedJarsInfo.ArtifactMatchers,
+				},
+				Response: sharedJarsInfo,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: sharedJarsInfo,
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Create:   true,
+		HCL: `
+		artifact_type = "LIBRARY_JAR"
+		authoritative = false
+		artifact_matcher {
+			artifact = "/Volumes/jars"
+			match_type = "PREFIX_MATCH"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                 "abc|LIBRARY_JAR",
+		"authoritative":      false,
+		"artifact_matcher.#": 1,
+	})
+}
+
+func TestArtifactAllowlistUpdate_NonAuthoritative(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: sharedJarsInfo,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR",
+				ExpectedRequest: catalog.SetArtifactAllowlist{
+					ArtifactType: catalog.ArtifactTypeLibraryJar,
+					ArtifactMatchers: []catalog.ArtifactMatcher{
+						{
+							Artifact:  "/Volumes/other_team/jars",
+							MatchType: catalog.MatchTypePrefixMatch,
+						},
+						{
+							Artifact:  "/Volumes/new_jars",
+							MatchType: catalog.MatchTypePrefixMatch,
+						},
+					},
+				},
+				Response: sharedJarsInfo,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: catalog.ArtifactAllowlistInfo{
+					ArtifactMatchers: []catalog.ArtifactMatcher{
+						{
+							Artifact:  "/Volumes/other_team/jars",
+							MatchType: catalog.MatchTypePrefixMatch,
+						},
+						{
+							Artifact:  "/Volumes/new_jars",
+							MatchType: catalog.MatchTypePrefixMatch,
+						},
+					},
+					MetastoreId: "abc",
+				},
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Update:   true,
+		ID:       "abc|LIBRARY_JAR",
+		InstanceState: map[string]string{
+			"artifact_type":                          "LIBRARY_JAR",
+			"authoritative":                          "false",
+			"artifact_matcher.#":                     "1",
+			"artifact_matcher.4100597823.artifact":   "/Volumes/jars",
+			"artifact_matcher.4100597823.match_type": "PREFIX_MATCH",
+		},
+		HCL: `
+		artifact_type = "LIBRARY_JAR"
+		authoritative = false
+		artifact_matcher {
+			artifact = "/Volumes/new_jars"
+			match_type = "PREFIX_MATCH"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"artifact_matcher.#": 1,
+	})
+}
+
+func TestArtifactAllowlistDelete_NonAuthoritative(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: sharedJarsInfo,
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR",
+				ExpectedRequest: catalog.SetArtifactAllowlist{
+					ArtifactType:     catalog.ArtifactTypeLibraryJar,
+					ArtifactMatchers: sharedJarsInfo.ArtifactMatchers[:1],
+				},
+				Response: catalog.ArtifactAllowlistInfo{
+					ArtifactMatchers: sharedJarsInfo.ArtifactMatchers[:1],
+					MetastoreId:      "abc",
+				},
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Delete:   true,
+		ID:       "abc|LIBRARY_JAR",
+		HCL: `
+		artifact_type = "LIBRARY_JAR"
+		authoritative = false
+		artifact_matcher {
+			artifact = "/Volumes/jars"
+			match_type = "PREFIX_MATCH"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestArtifactAllowlistRead_NonAuthoritative(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/artifact-allowlists/LIBRARY_JAR?",
+				Response: sharedJarsInfo,
+			},
+		},
+		Resource: ResourceArtifactAllowlist(),
+		Read:     true,
+		ID:       "abc|LIBRARY_JAR",
+		HCL: `
+		artifact_type = "LIBRARY_JAR"
+		authoritative = false
+		artifact_matcher {
+			artifact = "/Volumes/jars"
+			match_type = "PREFIX_MATCH"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"artifact_matcher.#": 1,
+	})
+}
+
+func TestArtifactAllowlistInvalidType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceArtifactAllowlist(),
+		Create:   true,
+		HCL: `
+		artifact_type = "NOTEBOOK"
+		artifact_matcher {
+			artifact = "/Volumes/jars"
+			match_type = "PREFIX_MATCH"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [artifact_type] expected artifact_type to be one of "+
+		"[INIT_SCRIPT LIBRARY_JAR LIBRARY_MAVEN], got NOTEBOOK")
+}
+
+func TestMergeArtifactMatchers(t *testing.T) {
+	a := catalog.ArtifactMatcher{Artifact: "a", MatchType: catalog.MatchTypePrefixMatch}
+	b := catalog.ArtifactMatcher{Artifact: "b", MatchType: catalog.MatchTypePrefixMatch}
+	c := catalog.ArtifactMatcher{Artifact: "c", MatchType: catalog.MatchTypePrefixMatch}
+	assert.Equal(t, []catalog.ArtifactMatcher{a, c, b},
+		mergeArtifactMatchers([]catalog.ArtifactMatcher{a, b, c}, nil, []catalog.ArtifactMatcher{b}))
+	assert.Equal(t, []catalog.ArtifactMatcher{a},
+		mergeArtifactMatchers([]catalog.ArtifactMatcher{a, b}, []catalog.ArtifactMatcher{b}, nil))
+}
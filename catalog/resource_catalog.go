@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/catalog/bindings"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -41,6 +42,71 @@ type CatalogInfo struct {
 	Owner                        string            `json:"owner,omitempty" tf:"computed"`
 	IsolationMode                string            `json:"isolation_mode,omitempty" tf:"computed"`
 	MetastoreID                  string            `json:"metastore_id,omitempty" tf:"computed"`
+	// WorkspaceBindings are all workspace bindings of an isolated catalog, that are managed authoritatively.
+	// Unlike databricks_catalog_bindings, they are applied in the same step as the isolation mode, so a new
+	// catalog is never isolated without the bindings it's configured with. databricks_catalog_bindings is
+	// meant for catalogs, that aren't managed in the same configuration.
+	WorkspaceBindings []catalog.WorkspaceBinding `json:"workspace_bindings,omitempty" tf:"alias:workspace_binding,slice_set"`
+}
+
+// applyCatalogWorkspaceBindings replaces all workspace bindings of the catalog with the ones from the configuration,
+// or only binds the current workspace to the isolated catalog, if no bindings are configured
+func applyCatalogWorkspaceBindings(ctx context.Context, d *schema.ResourceData, w *databricks.WorkspaceClient,
+	catalogSchema map[string]*schema.Schema, catalogName string) error {
+	var desired, managed CatalogInfo
+	common.DataToStructPointer(d, catalogSchema, &desired)
+	if len(desired.WorkspaceBindings) == 0 {
+		if d.HasChange("workspace_binding") {
+			// bindings removed from the configuration are unbound, the other bindings are kept
+			old, _ := d.GetChange("workspace_binding")
+			for _, v := range old.(*schema.Set).List() {
+				m := v.(map[string]any)
+				managed.WorkspaceBindings = append(managed.WorkspaceBindings, catalog.WorkspaceBinding{
+					WorkspaceId: int64(m["workspace_id"].(int)),
+					BindingType: catalog.WorkspaceBindingBindingType(m["binding_type"].(string)),
+				})
+			}
+			if err := updateCatalogBindings(ctx, w, catalogName, managed.WorkspaceBindings, nil); err != nil {
+				return err
+			}
+		}
+		return bindings.AddCurrentWorkspaceBindings(ctx, d, w, catalogName, catalog.UpdateBindingsSecurableTypeCatalog)
+	}
+	current, err := getCatalogBindings(ctx, w, catalogName)
+	if err != nil {
+		return err
+	}
+	return updateCatalogBindings(ctx, w, catalogName, current, desired.WorkspaceBindings)
+}
+
+// foreignCatalogRequiredOptions are the options of foreign catalogs, that point to the database in the external system,
+// by the type of the connection. Connection types, that aren't listed here, e.g. MYSQL, don't require any options.
+var foreignCatalogRequiredOptions = map[catalog.ConnectionType]string{
+	catalog.ConnectionTypePostgresql:                "database",
+	catalog.ConnectionTypeRedshift:                  "database",
+	catalog.ConnectionTypeSnowflake:                 "database",
+	catalog.ConnectionTypeSqlserver:                 "database",
+	catalog.ConnectionTypeSqldw:                     "database",
+	catalog.ConnectionTypeDatabricks:                "catalog",
+	catalog.ConnectionType("SALESFORCE_DATA_CLOUD"): "dataspace",
+}
+
+// validateForeignCatalogOptions checks, that options of the foreign catalog have the ones required by the connection
+func validateForeignCatalogOptions(ctx context.Context, w *databricks.WorkspaceClient,
+	connectionName string, options map[string]string) error {
+	conn, err := w.Connections.GetByName(ctx, connectionName)
+	if err != nil {
+		return fmt.Errorf("cannot read connection %s: %w", connectionName, err)
+	}
+	required, ok := foreignCatalogRequiredOptions[conn.ConnectionType]
+	if !ok {
+		return nil
+	}
+	if options[required] == "" {
+		return fmt.Errorf("options.%s is required for foreign catalogs of %s connection %s",
+			required, conn.ConnectionType, connectionName)
+	}
+	return nil
 }
 
 func ResourceCatalog() common.Resource {
@@ -51,11 +117,19 @@ func ResourceCatalog() common.Resource {
 				Optional: true,
 				Default:  false,
 			}
+			s["cascade_acknowledged"] = cascadeAcknowledgedSchema()
 			common.CustomizeSchemaPath(s, "storage_root").SetCustomSuppressDiff(ucDirectoryPathSlashOnlySuppressDiff)
 			common.CustomizeSchemaPath(s, "name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
 			common.CustomizeSchemaPath(s, "enable_predictive_optimization").SetValidateFunc(
 				validation.StringInSlice([]string{"DISABLE", "ENABLE", "INHERIT"}, false),
 			)
+			common.CustomizeSchemaPath(s, "workspace_binding", "binding_type").SetDefault(
+				catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite).SetValidateFunc(
+				validation.StringInSlice([]string{
+					string(catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite),
+					string(catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly),
+				}, false))
+			common.CustomizeSchemaPath(s, "workspace_binding", "workspace_id").SetRequired()
 			return s
 		})
 	return common.Resource{
@@ -73,6 +147,12 @@ func ResourceCatalog() common.Resource {
 
 			var createCatalogRequest catalog.CreateCatalog
 			common.DataToStructPointer(d, catalogSchema, &createCatalogRequest)
+			if createCatalogRequest.ConnectionName != "" {
+				err = validateForeignCatalogOptions(ctx, w, createCatalogRequest.ConnectionName, createCatalogRequest.Options)
+				if err != nil {
+					return err
+				}
+			}
 			ci, err := w.Catalogs.Create(ctx, createCatalogRequest)
 			if err != nil {
 				return err
@@ -87,20 +167,18 @@ func ResourceCatalog() common.Resource {
 			d.SetId(ci.Name)
 
 			// Update owner, isolation mode or predictive optimization if it is provided
-			if !updateRequired(d, []string{"owner", "isolation_mode", "enable_predictive_optimization"}) {
-				return nil
-			}
-
-			var updateCatalogRequest catalog.UpdateCatalog
-			common.DataToStructPointer(d, catalogSchema, &updateCatalogRequest)
-			updateCatalogRequest.Name = d.Id()
-			_, err = w.Catalogs.Update(ctx, updateCatalogRequest)
-			if err != nil {
-				return err
+			if updateRequired(d, []string{"owner", "isolation_mode", "enable_predictive_optimization"}) {
+				var updateCatalogRequest catalog.UpdateCatalog
+				common.DataToStructPointer(d, catalogSchema, &updateCatalogRequest)
+				updateCatalogRequest.Name = d.Id()
+				_, err = w.Catalogs.Update(ctx, updateCatalogRequest)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Bind the current workspace if the catalog is isolated, otherwise the read will fail
-			return bindings.AddCurrentWorkspaceBindings(ctx, d, w, ci.Name, catalog.UpdateBindingsSecurableTypeCatalog)
+			return applyCatalogWorkspaceBindings(ctx, d, w, catalogSchema, ci.Name)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -108,11 +186,36 @@ func ResourceCatalog() common.Resource {
 				return err
 			}
 
+			// the name is only missing from the state on import
+			importing := d.Get("name").(string) == ""
 			ci, err := w.Catalogs.GetByName(ctx, d.Id())
 			if err != nil {
 				return err
 			}
-			return common.StructToData(ci, catalogSchema, d)
+			err = common.StructToData(ci, catalogSchema, d)
+			if err != nil {
+				return err
+			}
+			// bindings are only read, if they are managed by this resource. All bindings of an isolated catalog
+			// are read on import, so that they aren't lost if the configuration doesn't list them.
+			readBindings := d.Get("workspace_binding").(*schema.Set).Len() > 0 ||
+				(importing && ci.IsolationMode == catalog.CatalogIsolationModeIsolated)
+			if !readBindings {
+				return nil
+			}
+			current, err := getCatalogBindings(ctx, w, d.Id())
+			if err != nil {
+				return err
+			}
+			sortBindings(current)
+			workspaceBindings := []any{}
+			for _, b := range current {
+				workspaceBindings = append(workspaceBindings, map[string]any{
+					"workspace_id": int(b.WorkspaceId),
+					"binding_type": string(b.BindingType),
+				})
+			}
+			return d.Set("workspace_binding", workspaceBindings)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -139,7 +242,10 @@ func ResourceCatalog() common.Resource {
 				}
 			}
 
-			if !d.HasChangeExcept("owner") {
+			if !d.HasChangesExcept("owner", "workspace_binding") {
+				if d.HasChange("workspace_binding") {
+					return applyCatalogWorkspaceBindings(ctx, d, w, catalogSchema, d.Id())
+				}
 				return nil
 			}
 
@@ -166,7 +272,20 @@ func ResourceCatalog() common.Resource {
 			d.SetId(ci.Name)
 
 			// Bind the current workspace if the catalog is isolated, otherwise the read will fail
-			return bindings.AddCurrentWorkspaceBindings(ctx, d, w, ci.Name, catalog.UpdateBindingsSecurableTypeCatalog)
+			return applyCatalogWorkspaceBindings(ctx, d, w, catalogSchema, ci.Name)
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if err := customizeCascadeDiff(d); err != nil {
+				return err
+			}
+			if d.Get("workspace_binding").(*schema.Set).Len() == 0 || !d.NewValueKnown("isolation_mode") {
+				return nil
+			}
+			isolationMode := d.Get("isolation_mode").(string)
+			if isolationMode != "ISOLATED" && isolationMode != "ISOLATION_MODE_ISOLATED" {
+				return fmt.Errorf("workspace_binding could only be used with isolation_mode = \"ISOLATED\"")
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -179,6 +298,13 @@ func ResourceCatalog() common.Resource {
 				return err
 			}
 
+			err = checkCascade(d, "catalog", func() ([]string, error) {
+				return catalogDependents(ctx, w, d.Id())
+			})
+			if err != nil {
+				return err
+			}
+
 			force := d.Get("force_destroy").(bool)
 			// If the workspace has isolation mode ISOLATED, we need to add the current workspace to its
 			// bindings before deleting.
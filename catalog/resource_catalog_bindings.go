@@ -0,0 +1,129 @@
+package catalog
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CatalogBindings are all workspace bindings of a catalog, that are managed together
+type CatalogBindings struct {
+	CatalogName string                     `json:"catalog_name" tf:"force_new"`
+	Bindings    []catalog.WorkspaceBinding `json:"bindings,omitempty" tf:"alias:binding,slice_set"`
+}
+
+func (CatalogBindings) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
+	s.SchemaPath("binding", "binding_type").SetDefault(catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite).SetValidateFunc(
+		validation.StringInSlice([]string{
+			string(catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite),
+			string(catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly),
+		}, false))
+	return s
+}
+
+func getCatalogBindings(ctx context.Context, w *databricks.WorkspaceClient, catalogName string) ([]catalog.WorkspaceBinding, error) {
+	return w.WorkspaceBindings.GetBindingsAll(ctx, catalog.GetBindingsRequest{
+		SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+		SecurableName: catalogName,
+	})
+}
+
+// updateCatalogBindings applies the difference between the current and the desired bindings with a single request,
+// so that the bindings of other workspaces aren't overwritten by concurrent updates
+func updateCatalogBindings(ctx context.Context, w *databricks.WorkspaceClient, catalogName string,
+	current, desired []catalog.WorkspaceBinding) error {
+	currentTypes := map[int64]catalog.WorkspaceBindingBindingType{}
+	for _, b := range current {
+		currentTypes[b.WorkspaceId] = b.BindingType
+	}
+	desiredTypes := map[int64]bool{}
+	var add, remove []catalog.WorkspaceBinding
+	for _, b := range desired {
+		desiredTypes[b.WorkspaceId] = true
+		if bindingType, ok := currentTypes[b.WorkspaceId]; !ok || bindingType != b.BindingType {
+			add = append(add, b)
+		}
+	}
+	for _, b := range current {
+		if !desiredTypes[b.WorkspaceId] {
+			remove = append(remove, b)
+		}
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	sortBindings(add)
+	sortBindings(remove)
+	_, err := w.WorkspaceBindings.UpdateBindings(ctx, catalog.UpdateWorkspaceBindingsParameters{
+		SecurableType: catalog.UpdateBindingsSecurableTypeCatalog,
+		SecurableName: catalogName,
+		Add:           add,
+		Remove:        remove,
+	})
+	return err
+}
+
+func sortBindings(bindings []catalog.WorkspaceBinding) {
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].WorkspaceId < bindings[j].WorkspaceId
+	})
+}
+
+// ResourceCatalogBindings manages all workspace bindings of a catalog authoritatively
+func ResourceCatalogBindings() common.Resource {
+	s := common.StructToSchema(CatalogBindings{}, nil)
+	apply := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		var cb CatalogBindings
+		common.DataToStructPointer(d, s, &cb)
+		current, err := getCatalogBindings(ctx, w, cb.CatalogName)
+		if err != nil {
+			return err
+		}
+		return updateCatalogBindings(ctx, w, cb.CatalogName, current, cb.Bindings)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := apply(ctx, d, c); err != nil {
+				return err
+			}
+			d.SetId(d.Get("catalog_name").(string))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			bindings, err := getCatalogBindings(ctx, w, d.Id())
+			if err != nil {
+				return err
+			}
+			sortBindings(bindings)
+			return common.StructToData(CatalogBindings{
+				CatalogName: d.Id(),
+				Bindings:    bindings,
+			}, s, d)
+		},
+		Update: apply,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var cb CatalogBindings
+			common.DataToStructPointer(d, s, &cb)
+			// only the bindings, that are known to the state, are removed
+			return updateCatalogBindings(ctx, w, d.Id(), cb.Bindings, nil)
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+var getCatalogBindingsRequest = catalog.GetBindingsRequest{
+	SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+	SecurableName: "my_catalog",
+}
+
+func TestCatalogBindingsCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCatalogBindings(),
+		qa.CornerCaseID("my_catalog"),
+		// nothing is removed, if there are no bindings in the state
+		qa.CornerCaseSkipCRUD("delete"))
+}
+
+func TestCatalogBindings_Create(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockWorkspaceBindingsAPI().EXPECT()
+			e.GetBindingsAll(mock.Anything, getCatalogBindingsRequest).Return([]catalog.WorkspaceBinding{
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 3,
+				},
+			}, nil).Once()
+			e.UpdateBindings(mock.Anything, catalog.UpdateWorkspaceBindingsParameters{
+				SecurableType: catalog.UpdateBindingsSecurableTypeCatalog,
+				SecurableName: "my_catalog",
+				Add: []catalog.WorkspaceBinding{
+					{
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+						WorkspaceId: 1,
+					},
+					{
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+						WorkspaceId: 2,
+					},
+				},
+				Remove: []catalog.WorkspaceBinding{
+					{
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+						WorkspaceId: 3,
+					},
+				},
+			}).Return(&catalog.WorkspaceBindingsResponse{}, nil)
+			e.GetBindingsAll(mock.Anything, getCatalogBindingsRequest).Return([]catalog.WorkspaceBinding{
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+					WorkspaceId: 2,
+				},
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 1,
+				},
+			}, nil)
+		},
+		Resource: ResourceCatalogBindings(),
+		Create:   true,
+		HCL: `
+		catalog_name = "my_catalog"
+		binding {
+			workspace_id = 1
+		}
+		binding {
+			workspace_id = 2
+			binding_type = "BINDING_TYPE_READ_ONLY"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "my_catalog",
+		"binding.#": 2,
+	})
+}
+
+func TestCatalogBindings_UpdateBindingType(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockWorkspaceBindingsAPI().EXPECT()
+			e.GetBindingsAll(mock.Anything, getCatalogBindingsRequest).Return([]catalog.WorkspaceBinding{
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 1,
+				},
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 2,
+				},
+			}, nil).Once()
+			e.UpdateBindings(mock.Anything, catalog.UpdateWorkspaceBindingsParameters{
+				SecurableType: catalog.UpdateBindingsSecurableTypeCatalog,
+				SecurableName: "my_catalog",
+				Add: []catalog.WorkspaceBinding{
+					{
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+						WorkspaceId: 2,
+					},
+				},
+			}).Return(&catalog.WorkspaceBindingsResponse{}, nil)
+			e.GetBindingsAll(mock.Anything, getCatalogBindingsRequest).Return([]catalog.WorkspaceBinding{
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 1,
+				},
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+					WorkspaceId: 2,
+				},
+			}, nil)
+		},
+		Resource: ResourceCatalogBindings(),
+		Update:   true,
+		ID:       "my_catalog",
+		InstanceState: map[string]string{
+			"catalog_name": "my_catalog",
+		},
+		HCL: `
+		catalog_name = "my_catalog"
+		binding {
+			workspace_id = 1
+		}
+		binding {
+			workspace_id = 2
+			binding_type = "BINDING_TYPE_READ_ONLY"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"binding.#": 2,
+	})
+}
+
+func TestCatalogBindings_UpdateNoChanges(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockWorkspaceBindingsAPI().EXPECT()
+			e.GetBindingsAll(mock.Anything, getCatalogBindingsRequest).Return([]catalog.WorkspaceBinding{
+				{
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					WorkspaceId: 1,
+				},
+			}, nil)
+		},
+		Resource: ResourceCatalogBindings(),
+		Update:   true,
+		ID:       "my_catalog",
+		InstanceState: map[string]string{
+			"catalog_name": "my_catalog",
+		},
+		HCL: `
+		catalog_name = "my_catalog"
+		binding {
+			workspace_id = 1
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestCatalogBindings_Delete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockWorkspaceBindingsAPI().EXPECT().UpdateBindings(mock.Anything, catalog.UpdateWorkspaceBindingsParameters{
+				SecurableType: catalog.UpdateBindingsSecurableTypeCatalog,
+				SecurableName: "my_catalog",
+				Remove: []catalog.WorkspaceBinding{
+					{
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+						WorkspaceId: 1,
+					},
+				},
+			}).Return(&catalog.WorkspaceBindingsResponse{}, nil)
+		},
+		Resource: ResourceCatalogBindings(),
+		Delete:   true,
+		ID:       "my_catalog",
+		HCL: `
+		catalog_name = "my_catalog"
+		binding {
+			workspace_id = 1
+		}
+		`,
+	}.ApplyNoError(t)
+}
@@ -59,6 +59,10 @@ func TestCatalogCreateAlsoDeletesDefaultSchema(t *testing.T) {
 func TestCatalogCreateWithForeignCatalogDoesNotDeleteDefaultSchema(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockConnectionsAPI().EXPECT().GetByName(mock.Anything, "g").Return(&catalog.ConnectionInfo{
+				Name:           "g",
+				ConnectionType: catalog.ConnectionTypeMysql,
+			}, nil)
 			e := w.GetMockCatalogsAPI().EXPECT()
 			e.Create(mock.Anything, catalog.CreateCatalog{
 				Name:    "a",
@@ -523,10 +527,87 @@ func TestForceDeleteCatalog(t *testing.T) {
 		comment = "c"
 		owner = "administrators"
 		force_destroy = true
+		cascade_acknowledged = true
 		`,
 	}.ApplyNoError(t)
 }
 
+func TestForceDeleteCatalog_CascadeNotAcknowledged(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockSchemasAPI().EXPECT().ListAll(mock.Anything, catalog.ListSchemasRequest{
+				CatalogName: "b",
+			}).Return([]catalog.SchemaInfo{
+				{
+					Name:     "information_schema",
+					FullName: "b.information_schema",
+				},
+				{
+					Name:     "a",
+					FullName: "b.a",
+				},
+			}, nil)
+			w.GetMockTablesAPI().EXPECT().ListAll(mock.Anything, catalog.ListTablesRequest{
+				CatalogName:    "b",
+				SchemaName:     "a",
+				OmitColumns:    true,
+				OmitProperties: true,
+			}).Return([]catalog.TableInfo{
+				{
+					FullName:  "b.a.c",
+					TableType: catalog.TableTypeExternal,
+				},
+			}, nil)
+			w.GetMockVolumesAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+			w.GetMockFunctionsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return([]catalog.FunctionInfo{
+				{
+					FullName: "b.a.mask",
+				},
+			}, nil)
+			w.GetMockRegisteredModelsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+		},
+		Resource: ResourceCatalog(),
+		Delete:   true,
+		ID:       "b",
+		HCL: `
+		name = "b"
+		force_destroy = true
+		`,
+	}.ExpectError(t, "catalog b can't be deleted, because force_destroy would drop the following objects, "+
+		"set cascade_acknowledged = true to drop them:\n"+
+		"- schema b.a\n"+
+		"- table b.a.c (EXTERNAL)\n"+
+		"- function b.a.mask")
+}
+
+func TestForceDeleteCatalog_CascadeAcknowledged(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockCatalogsAPI().EXPECT().Delete(mock.Anything,
+				catalog.DeleteCatalogRequest{Name: "b", Force: true}).Return(nil)
+		},
+		Resource: ResourceCatalog(),
+		Delete:   true,
+		ID:       "b",
+		HCL: `
+		name = "b"
+		force_destroy = true
+		cascade_acknowledged = true
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestCatalogCascadeAcknowledgedRequiresForceDestroy(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "b"
+		cascade_acknowledged = true
+		`,
+	}.ExpectError(t, "cascade_acknowledged could only be set together with force_destroy")
+}
+
 func TestCatalogCreateDeltaSharing(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
@@ -571,6 +652,10 @@ func TestCatalogCreateDeltaSharing(t *testing.T) {
 func TestCatalogCreateForeign(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockConnectionsAPI().EXPECT().GetByName(mock.Anything, "foo").Return(&catalog.ConnectionInfo{
+				Name:           "foo",
+				ConnectionType: catalog.ConnectionTypePostgresql,
+			}, nil)
 			e := w.GetMockCatalogsAPI().EXPECT()
 			e.Create(mock.Anything, catalog.CreateCatalog{
 				Name:           "foreign_catalog",
@@ -612,6 +697,77 @@ func TestCatalogCreateForeign(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"database": "abcd"}, d.Get("options"))
 }
 
+func TestCatalogCreateForeignMissingDatabase(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockConnectionsAPI().EXPECT().GetByName(mock.Anything, "foo").Return(&catalog.ConnectionInfo{
+				Name:           "foo",
+				ConnectionType: catalog.ConnectionTypeSnowflake,
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "foreign_catalog"
+		connection_name = "foo"
+		`,
+	}.ExpectError(t, "options.database is required for foreign catalogs of SNOWFLAKE connection foo")
+}
+
+func TestCatalogCreateForeignDatabricksRequiresCatalog(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockConnectionsAPI().EXPECT().GetByName(mock.Anything, "foo").Return(&catalog.ConnectionInfo{
+				Name:           "foo",
+				ConnectionType: catalog.ConnectionTypeDatabricks,
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "foreign_catalog"
+		connection_name = "foo"
+		options = {
+			database = "abcd"
+		}
+		`,
+	}.ExpectError(t, "options.catalog is required for foreign catalogs of DATABRICKS connection foo")
+}
+
+func TestCatalogCreateForeignMySQLWithoutOptions(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockConnectionsAPI().EXPECT().GetByName(mock.Anything, "foo").Return(&catalog.ConnectionInfo{
+				Name:           "foo",
+				ConnectionType: catalog.ConnectionTypeMysql,
+			}, nil)
+			e := w.GetMockCatalogsAPI().EXPECT()
+			e.Create(mock.Anything, catalog.CreateCatalog{
+				Name:           "foreign_catalog",
+				ConnectionName: "foo",
+			}).Return(&catalog.CatalogInfo{
+				Name:           "foreign_catalog",
+				ConnectionName: "foo",
+			}, nil)
+			e.GetByName(mock.Anything, "foreign_catalog").Return(&catalog.CatalogInfo{
+				Name:           "foreign_catalog",
+				ConnectionName: "foo",
+				MetastoreId:    "e",
+				Owner:          "f",
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "foreign_catalog"
+		connection_name = "foo"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "foreign_catalog",
+		"connection_name": "foo",
+	})
+}
+
 func TestCatalogCreateIsolated(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
@@ -684,6 +840,212 @@ func TestCatalogCreateIsolated(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestCatalogCreateIsolatedWithWorkspaceBindings(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockCatalogsAPI().EXPECT()
+			e.Create(mock.Anything, catalog.CreateCatalog{
+				Name: "a",
+			}).Return(&catalog.CatalogInfo{
+				Name:        "a",
+				MetastoreId: "e",
+			}, nil)
+			w.GetMockSchemasAPI().EXPECT().DeleteByFullName(mock.Anything, "a.default").Return(nil)
+			e.Update(mock.Anything, catalog.UpdateCatalog{
+				Name:          "a",
+				IsolationMode: "ISOLATED",
+			}).Return(&catalog.CatalogInfo{
+				Name:          "a",
+				IsolationMode: "ISOLATED",
+				MetastoreId:   "e",
+			}, nil)
+			b := w.GetMockWorkspaceBindingsAPI().EXPECT()
+			b.GetBindingsAll(mock.Anything, catalog.GetBindingsRequest{
+				SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+				SecurableName: "a",
+			}).Return([]catalog.WorkspaceBinding{
+				{
+					WorkspaceId: 1,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+			}, nil).Once()
+			b.UpdateBindings(mock.Anything, catalog.UpdateWorkspaceBindingsParameters{
+				SecurableName: "a",
+				SecurableType: "catalog",
+				Add: []catalog.WorkspaceBinding{
+					{
+						WorkspaceId: 2,
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+					},
+				},
+			}).Return(&catalog.WorkspaceBindingsResponse{}, nil)
+			b.GetBindingsAll(mock.Anything, catalog.GetBindingsRequest{
+				SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+				SecurableName: "a",
+			}).Return([]catalog.WorkspaceBinding{
+				{
+					WorkspaceId: 2,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+				},
+				{
+					WorkspaceId: 1,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+			}, nil)
+			e.GetByName(mock.Anything, "a").Return(&catalog.CatalogInfo{
+				Name:          "a",
+				IsolationMode: "ISOLATED",
+				MetastoreId:   "e",
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		isolation_mode = "ISOLATED"
+		workspace_binding {
+			workspace_id = 1
+		}
+		workspace_binding {
+			workspace_id = 2
+			binding_type = "BINDING_TYPE_READ_ONLY"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_binding.#": 2,
+	})
+}
+
+func TestUpdateCatalogWorkspaceBindingsOnly(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockMetastoresAPI().EXPECT().Current(mock.Anything).Return(&catalog.MetastoreAssignment{
+				MetastoreId: "d",
+			}, nil)
+			b := w.GetMockWorkspaceBindingsAPI().EXPECT()
+			b.GetBindingsAll(mock.Anything, catalog.GetBindingsRequest{
+				SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+				SecurableName: "a",
+			}).Return([]catalog.WorkspaceBinding{
+				{
+					WorkspaceId: 1,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+				{
+					WorkspaceId: 2,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+			}, nil).Once()
+			b.UpdateBindings(mock.Anything, catalog.UpdateWorkspaceBindingsParameters{
+				SecurableName: "a",
+				SecurableType: "catalog",
+				Remove: []catalog.WorkspaceBinding{
+					{
+						WorkspaceId: 2,
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					},
+				},
+			}).Return(&catalog.WorkspaceBindingsResponse{}, nil)
+			b.GetBindingsAll(mock.Anything, catalog.GetBindingsRequest{
+				SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+				SecurableName: "a",
+			}).Return([]catalog.WorkspaceBinding{
+				{
+					WorkspaceId: 1,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+			}, nil)
+			w.GetMockCatalogsAPI().EXPECT().GetByName(mock.Anything, "a").Return(&catalog.CatalogInfo{
+				Name:          "a",
+				IsolationMode: "ISOLATED",
+				MetastoreId:   "d",
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"metastore_id":   "d",
+			"name":           "a",
+			"isolation_mode": "ISOLATED",
+		},
+		HCL: `
+		name = "a"
+		isolation_mode = "ISOLATED"
+		workspace_binding {
+			workspace_id = 1
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_binding.#": 1,
+	})
+}
+
+func TestCatalogImportIsolatedWithWorkspaceBindings(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockCatalogsAPI().EXPECT().GetByName(mock.Anything, "a").Return(&catalog.CatalogInfo{
+				Name:          "a",
+				IsolationMode: "ISOLATED",
+				MetastoreId:   "d",
+			}, nil)
+			w.GetMockWorkspaceBindingsAPI().EXPECT().GetBindingsAll(mock.Anything, catalog.GetBindingsRequest{
+				SecurableType: catalog.GetBindingsSecurableTypeCatalog,
+				SecurableName: "a",
+			}).Return([]catalog.WorkspaceBinding{
+				{
+					WorkspaceId: 2,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+				},
+				{
+					WorkspaceId: 1,
+					BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+				},
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Read:     true,
+		New:      true,
+		ID:       "a",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":                "a",
+		"workspace_binding.#": 2,
+	})
+}
+
+func TestCatalogImportOpenSkipsWorkspaceBindings(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockCatalogsAPI().EXPECT().GetByName(mock.Anything, "a").Return(&catalog.CatalogInfo{
+				Name:          "a",
+				IsolationMode: "OPEN",
+				MetastoreId:   "d",
+			}, nil)
+		},
+		Resource: ResourceCatalog(),
+		Read:     true,
+		New:      true,
+		ID:       "a",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":                "a",
+		"workspace_binding.#": 0,
+	})
+}
+
+func TestCatalogWorkspaceBindingsRequireIsolation(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalog(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		isolation_mode = "OPEN"
+		workspace_binding {
+			workspace_id = 1
+		}
+		`,
+	}.ExpectError(t, "workspace_binding could only be used with isolation_mode = \"ISOLATED\"")
+}
+
 func TestUcDirectoryPathSuppressDiff(t *testing.T) {
 	assert.True(t, ucDirectoryPathSlashOnlySuppressDiff("", "abfss://test@test.dfs.core.windows.net/TF_DIR_WITH_SLASH",
 		"abfss://test@test.dfs.core.windows.net/TF_DIR_WITH_SLASH/", nil))
@@ -2,10 +2,19 @@ package catalog
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/pbkdf2"
 	"golang.org/x/exp/slices"
 )
 
@@ -34,12 +43,172 @@ type ConnectionInfo struct {
 	ReadOnly bool `json:"read_only,omitempty" tf:"force_new,computed"`
 }
 
-var sensitiveOptions = []string{"user", "password", "personalAccessToken", "access_token", "client_secret", "OAuthPvtKey", "GoogleServiceAccountKeyJson"}
+var sensitiveOptions = []string{"user", "password", "personalAccessToken", "access_token", "client_secret", "OAuthPvtKey",
+	"GoogleServiceAccountKeyJson", "pem_private_key", "pem_private_key_password"}
+
+// sensitive options aren't returned by the API, so only their salted hashes are kept in the state
+const (
+	hashedOptionPrefix   = "pbkdf2-sha256:"
+	optionHashIterations = 100000
+	optionHashSaltLength = 16
+)
+
+func isHashedConnectionOption(value string) bool {
+	return strings.HasPrefix(value, hashedOptionPrefix)
+}
+
+func hashConnectionOptionWithSalt(value string, salt []byte) string {
+	key := pbkdf2.Key([]byte(value), salt, optionHashIterations, sha256.Size, sha256.New)
+	return hashedOptionPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(key)
+}
+
+func hashConnectionOption(value string) (string, error) {
+	if isHashedConnectionOption(value) {
+		return value, nil
+	}
+	salt := make([]byte, optionHashSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hashConnectionOptionWithSalt(value, salt), nil
+}
+
+// connectionOptionMatches checks, if the hash from the state was computed from the given value
+func connectionOptionMatches(hash, value string) bool {
+	salted, ok := strings.CutPrefix(hash, hashedOptionPrefix)
+	if !ok {
+		return false
+	}
+	saltHex, _, ok := strings.Cut(salted, ":")
+	if !ok {
+		return false
+	}
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(hashConnectionOptionWithSalt(value, salt))) == 1
+}
+
+func suppressHashedOptionDiff(k, old, new string, d *schema.ResourceData) bool {
+	key := strings.TrimPrefix(k, "options.")
+	if !slices.Contains(sensitiveOptions, key) || new == "" {
+		return false
+	}
+	return connectionOptionMatches(old, new)
+}
+
+// hashSensitiveOptions replaces values of sensitive options with their hashes, so that they never land in the state.
+// It has to be called once the request is built, but before it's sent, as the state is saved even if the request fails.
+func hashSensitiveOptions(d *schema.ResourceData) error {
+	options := map[string]any{}
+	for key, value := range d.Get("options").(map[string]any) {
+		if slices.Contains(sensitiveOptions, key) {
+			hash, err := hashConnectionOption(value.(string))
+			if err != nil {
+				return err
+			}
+			value = hash
+		}
+		options[key] = value
+	}
+	return d.Set("options", options)
+}
+
+// unhashConnectionOptions replaces hashes of unchanged sensitive options with their values from the configuration
+func unhashConnectionOptions(d *schema.ResourceData, options map[string]string) error {
+	var configured map[string]cty.Value
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr("options"); !v.IsNull() && v.IsKnown() {
+			configured = v.AsValueMap()
+		}
+	}
+	for key, value := range options {
+		if !isHashedConnectionOption(value) {
+			continue
+		}
+		v, ok := configured[key]
+		if !ok || v.IsNull() || !v.IsKnown() {
+			return fmt.Errorf("value of the sensitive option %s isn't available, please specify it again", key)
+		}
+		options[key] = v.AsString()
+	}
+	return nil
+}
+
+// connectionRequiredOptions are the alternative sets of options, one of which is required by the connection type,
+// e.g. for different authentication methods. Connection types, that aren't listed here, aren't validated.
+var connectionRequiredOptions = map[string][][]string{
+	"MYSQL":      {{"host", "port", "user", "password"}},
+	"POSTGRESQL": {{"host", "port", "user", "password"}},
+	"REDSHIFT":   {{"host", "port", "user", "password"}},
+	"TERADATA":   {{"host", "user", "password"}},
+	"SQLSERVER":  {{"host"}},
+	"SQLDW":      {{"host"}},
+	"SNOWFLAKE": {
+		{"host", "user", "password"},
+		{"host", "user", "pem_private_key"},
+		{"host", "client_id", "client_secret", "oauth_scope"},
+	},
+	"DATABRICKS":            {{"host", "httpPath"}},
+	"SALESFORCE_DATA_CLOUD": {{"client_id", "client_secret"}},
+}
+
+// validateBigQueryOptions checks the service account key, which is optional, as BigQuery connections
+// could be authenticated with workload identity instead
+func validateBigQueryOptions(options map[string]any) error {
+	key, _ := options["GoogleServiceAccountKeyJson"].(string)
+	if key == "" {
+		return nil
+	}
+	var serviceAccount struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(key), &serviceAccount); err != nil {
+		return fmt.Errorf("GoogleServiceAccountKeyJson is not a valid JSON: %w", err)
+	}
+	if serviceAccount.Type != "service_account" {
+		return fmt.Errorf("GoogleServiceAccountKeyJson must be a key of a service account, not %s", serviceAccount.Type)
+	}
+	return nil
+}
+
+func validateConnectionOptions(connectionType string, options map[string]any) error {
+	if connectionType == "BIGQUERY" {
+		return validateBigQueryOptions(options)
+	}
+	alternatives, ok := connectionRequiredOptions[connectionType]
+	if !ok {
+		return nil
+	}
+	var missing []string
+	for _, required := range alternatives {
+		missing = nil
+		for _, key := range required {
+			if _, ok := options[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+	}
+	if len(alternatives) == 1 {
+		return fmt.Errorf("%s connection requires options: %s", connectionType, strings.Join(missing, ", "))
+	}
+	variants := []string{}
+	for _, required := range alternatives {
+		variants = append(variants, strings.Join(required, ", "))
+	}
+	return fmt.Errorf("%s connection requires one of the following sets of options: [%s]",
+		connectionType, strings.Join(variants, "], ["))
+}
 
 func ResourceConnection() common.Resource {
 	s := common.StructToSchema(ConnectionInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
 			m["name"].DiffSuppressFunc = common.EqualFoldDiffSuppress
+			m["options"].DiffSuppressFunc = suppressHashedOptionDiff
 			return m
 		})
 	pi := common.NewPairID("metastore_id", "name").Schema(
@@ -59,14 +228,18 @@ func ResourceConnection() common.Resource {
 			}
 			var createConnectionRequest catalog.CreateConnection
 			common.DataToStructPointer(d, s, &createConnectionRequest)
+			var updateConnectionRequest catalog.UpdateConnection
+			common.DataToStructPointer(d, s, &updateConnectionRequest)
+			err = hashSensitiveOptions(d)
+			if err != nil {
+				return err
+			}
 			conn, err := w.Connections.Create(ctx, createConnectionRequest)
 			if err != nil {
 				return err
 			}
 			// Update owner if it is provided
 			if d.Get("owner") != "" {
-				var updateConnectionRequest catalog.UpdateConnection
-				common.DataToStructPointer(d, s, &updateConnectionRequest)
 				updateConnectionRequest.Name = createConnectionRequest.Name
 				conn, err = w.Connections.Update(ctx, updateConnectionRequest)
 				if err != nil {
@@ -99,7 +272,10 @@ func ResourceConnection() common.Resource {
 			}
 			for key, element := range cOrig.Options {
 				if slices.Contains(sensitiveOptions, key) {
-					conn.Options[key] = element
+					conn.Options[key], err = hashConnectionOption(element)
+					if err != nil {
+						return err
+					}
 				}
 			}
 			return common.StructToData(conn, s, d)
@@ -120,6 +296,14 @@ func ResourceConnection() common.Resource {
 				return err
 			}
 			updateConnectionRequest.Name = connName
+			err = unhashConnectionOptions(d, updateConnectionRequest.Options)
+			if err != nil {
+				return err
+			}
+			err = hashSensitiveOptions(d)
+			if err != nil {
+				return err
+			}
 
 			if d.HasChange("owner") {
 				_, err = w.Connections.Update(ctx, catalog.UpdateConnection{
@@ -149,6 +333,12 @@ func ResourceConnection() common.Resource {
 			}
 			return nil
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if !d.NewValueKnown("connection_type") || !d.NewValueKnown("options") {
+				return nil
+			}
+			return validateConnectionOptions(d.Get("connection_type").(string), d.Get("options").(map[string]any))
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -0,0 +1,175 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CredentialsAPI is the Unity Catalog API for credentials, that could be used either to access
+// cloud storage or, with the SERVICE purpose, cloud services like secret managers or LLM APIs
+type CredentialsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewCredentialsAPI(ctx context.Context, m any) CredentialsAPI {
+	return CredentialsAPI{m.(*common.DatabricksClient), context.WithValue(ctx, common.Api, common.API_2_1)}
+}
+
+type CredentialInfo struct {
+	Name                        string                                       `json:"name" tf:"force_new"`
+	Purpose                     string                                       `json:"purpose,omitempty" tf:"force_new,default:SERVICE"`
+	Owner                       string                                       `json:"owner,omitempty" tf:"computed"`
+	Comment                     string                                       `json:"comment,omitempty"`
+	Aws                         *catalog.AwsIamRoleResponse                  `json:"aws_iam_role,omitempty"`
+	AzMI                        *catalog.AzureManagedIdentityResponse        `json:"azure_managed_identity,omitempty"`
+	DatabricksGcpServiceAccount *catalog.DatabricksGcpServiceAccountResponse `json:"databricks_gcp_service_account,omitempty"`
+	ReadOnly                    bool                                         `json:"read_only,omitempty"`
+	SkipValidation              bool                                         `json:"skip_validation,omitempty"`
+	IsolationMode               string                                       `json:"isolation_mode,omitempty" tf:"computed"`
+	MetastoreID                 string                                       `json:"metastore_id,omitempty" tf:"computed"`
+	ID                          string                                       `json:"id,omitempty" tf:"alias:credential_id,computed"`
+}
+
+// updateCredential has only fields, that could be changed after the credential is created
+type updateCredential struct {
+	Comment                     string                                       `json:"comment"`
+	Owner                       string                                       `json:"owner,omitempty"`
+	Aws                         *catalog.AwsIamRoleResponse                  `json:"aws_iam_role,omitempty"`
+	AzMI                        *catalog.AzureManagedIdentityResponse        `json:"azure_managed_identity,omitempty"`
+	DatabricksGcpServiceAccount *catalog.DatabricksGcpServiceAccountResponse `json:"databricks_gcp_service_account,omitempty"`
+	ReadOnly                    bool                                         `json:"read_only"`
+	SkipValidation              bool                                         `json:"skip_validation,omitempty"`
+	Force                       bool                                         `json:"force,omitempty"`
+}
+
+func (a CredentialsAPI) create(ci *CredentialInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/credentials", ci, ci)
+}
+
+func credentialPath(name string) string {
+	return "/unity-catalog/credentials/" + url.PathEscape(name)
+}
+
+func (a CredentialsAPI) get(name string) (ci CredentialInfo, err error) {
+	err = a.client.Get(a.context, credentialPath(name), nil, &ci)
+	return
+}
+
+func (a CredentialsAPI) update(name string, uc updateCredential) error {
+	return a.client.Patch(a.context, credentialPath(name), uc)
+}
+
+func (a CredentialsAPI) delete(name string, force bool) error {
+	return a.client.Delete(a.context, credentialPath(name), map[string]any{
+		"force": force,
+	})
+}
+
+var credentialIdentities = []string{"aws_iam_role", "azure_managed_identity", "databricks_gcp_service_account"}
+
+func (ci CredentialInfo) validate() error {
+	if ci.Purpose == "SERVICE" && ci.ReadOnly {
+		return fmt.Errorf("read_only is only supported for credentials with the STORAGE purpose")
+	}
+	return nil
+}
+
+func ResourceCredential() common.Resource {
+	s := common.StructToSchema(CredentialInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+		common.CustomizeSchemaPath(m, "purpose").SetValidateFunc(validation.StringInSlice([]string{"SERVICE", "STORAGE"}, false))
+		for _, identity := range credentialIdentities {
+			common.CustomizeSchemaPath(m, identity).SetExactlyOneOf(credentialIdentities)
+		}
+		common.MustSchemaPath(m, "aws_iam_role", "external_id").Computed = true
+		common.MustSchemaPath(m, "aws_iam_role", "unity_catalog_iam_arn").Computed = true
+		common.MustSchemaPath(m, "azure_managed_identity", "credential_id").Computed = true
+		common.MustSchemaPath(m, "databricks_gcp_service_account", "email").Computed = true
+		common.MustSchemaPath(m, "databricks_gcp_service_account", "credential_id").Computed = true
+		m["skip_validation"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return old == "false" && new == "true"
+		}
+		m["force_destroy"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+		m["force_update"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var ci CredentialInfo
+			common.DiffToStructPointer(d, s, &ci)
+			return ci.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ci CredentialInfo
+			common.DataToStructPointer(d, s, &ci)
+			owner := ci.Owner
+			ci.Owner = ""
+			api := NewCredentialsAPI(ctx, c)
+			if err := api.create(&ci); err != nil {
+				return err
+			}
+			d.SetId(ci.Name)
+			if owner == "" {
+				return nil
+			}
+			return api.update(ci.Name, updateCredential{
+				Comment: ci.Comment,
+				Owner:   owner,
+			})
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ci, err := NewCredentialsAPI(ctx, c).get(d.Id())
+			if err != nil {
+				return err
+			}
+			// skip_validation isn't returned by the API
+			ci.SkipValidation = d.Get("skip_validation").(bool)
+			return common.StructToData(ci, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ci CredentialInfo
+			common.DataToStructPointer(d, s, &ci)
+			uc := updateCredential{
+				Comment:                     ci.Comment,
+				Aws:                         ci.Aws,
+				AzMI:                        ci.AzMI,
+				DatabricksGcpServiceAccount: ci.DatabricksGcpServiceAccount,
+				ReadOnly:                    ci.ReadOnly,
+				SkipValidation:              ci.SkipValidation,
+				Force:                       d.Get("force_update").(bool),
+			}
+			if d.HasChange("owner") {
+				uc.Owner = ci.Owner
+			}
+			// computed attributes of identities can't be sent back
+			if uc.Aws != nil {
+				uc.Aws.ExternalId = ""
+				uc.Aws.UnityCatalogIamArn = ""
+			}
+			if uc.AzMI != nil {
+				uc.AzMI.CredentialId = ""
+			}
+			if uc.DatabricksGcpServiceAccount != nil {
+				uc.DatabricksGcpServiceAccount = &catalog.DatabricksGcpServiceAccountResponse{}
+			}
+			return NewCredentialsAPI(ctx, c).update(d.Id(), uc)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewCredentialsAPI(ctx, c).delete(d.Id(), d.Get("force_destroy").(bool))
+		},
+	}
+}
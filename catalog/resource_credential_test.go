@@ -0,0 +1,257 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestCredentialCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCredential())
+}
+
+func TestCreateServiceCredentialAws(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/credentials",
+				ExpectedRequest: CredentialInfo{
+					Name:    "secrets",
+					Purpose: "SERVICE",
+					Comment: "access to AWS Secrets Manager",
+					Aws: &catalog.AwsIamRoleResponse{
+						RoleArn: "arn:aws:iam::1234:role/secrets",
+					},
+				},
+				Response: CredentialInfo{
+					Name: "secrets",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/credentials/secrets",
+				ExpectedRequest: updateCredential{
+					Comment: "access to AWS Secrets Manager",
+					Owner:   "platform",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/secrets",
+				Response: CredentialInfo{
+					Name:    "secrets",
+					Purpose: "SERVICE",
+					Owner:   "platform",
+					Comment: "access to AWS Secrets Manager",
+					Aws: &catalog.AwsIamRoleResponse{
+						RoleArn:            "arn:aws:iam::1234:role/secrets",
+						ExternalId:         "abc",
+						UnityCatalogIamArn: "arn:aws:iam::5678:role/uc",
+					},
+					IsolationMode: "ISOLATION_MODE_OPEN",
+					MetastoreID:   "m",
+					ID:            "1234-5678",
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name    = "secrets"
+		comment = "access to AWS Secrets Manager"
+		owner   = "platform"
+		aws_iam_role {
+			role_arn = "arn:aws:iam::1234:role/secrets"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                   "secrets",
+		"purpose":                              "SERVICE",
+		"credential_id":                        "1234-5678",
+		"aws_iam_role.0.external_id":           "abc",
+		"aws_iam_role.0.unity_catalog_iam_arn": "arn:aws:iam::5678:role/uc",
+	})
+}
+
+func TestCreateServiceCredentialGcp(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/credentials",
+				ExpectedRequest: CredentialInfo{
+					Name:                        "vertex",
+					Purpose:                     "SERVICE",
+					DatabricksGcpServiceAccount: &catalog.DatabricksGcpServiceAccountResponse{},
+				},
+				Response: CredentialInfo{
+					Name: "vertex",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/vertex",
+				Response: CredentialInfo{
+					Name:    "vertex",
+					Purpose: "SERVICE",
+					DatabricksGcpServiceAccount: &catalog.DatabricksGcpServiceAccountResponse{
+						Email:        "sa@project.iam.gserviceaccount.com",
+						CredentialId: "c",
+					},
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name = "vertex"
+		databricks_gcp_service_account {}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"databricks_gcp_service_account.0.email": "sa@project.iam.gserviceaccount.com",
+	})
+}
+
+func TestCreateServiceCredentialReadOnly(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name      = "secrets"
+		read_only = true
+		aws_iam_role {
+			role_arn = "arn:aws:iam::1234:role/secrets"
+		}`,
+	}.ExpectError(t, "read_only is only supported for credentials with the STORAGE purpose")
+}
+
+func TestCreateCredentialWithoutIdentity(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCredential(),
+		Create:   true,
+		HCL: `
+		name = "secrets"`,
+	}.ExpectError(t, "invalid config supplied. [aws_iam_role] Invalid combination of arguments. "+
+		"[azure_managed_identity] Invalid combination of arguments. "+
+		"[databricks_gcp_service_account] Invalid combination of arguments")
+}
+
+func TestUpdateServiceCredentialAzure(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/credentials/openai",
+				ExpectedRequest: updateCredential{
+					Comment: "Azure OpenAI",
+					AzMI: &catalog.AzureManagedIdentityResponse{
+						AccessConnectorId: "/subscriptions/s/resourceGroups/g/providers/Microsoft.Databricks/accessConnectors/new",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/openai",
+				Response: CredentialInfo{
+					Name:    "openai",
+					Purpose: "SERVICE",
+					Comment: "Azure OpenAI",
+					AzMI: &catalog.AzureManagedIdentityResponse{
+						AccessConnectorId: "/subscriptions/s/resourceGroups/g/providers/Microsoft.Databricks/accessConnectors/new",
+						CredentialId:      "c",
+					},
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Update:   true,
+		ID:       "openai",
+		InstanceState: map[string]string{
+			"name":                     "openai",
+			"purpose":                  "SERVICE",
+			"owner":                    "admins",
+			"azure_managed_identity.#": "1",
+			"azure_managed_identity.0.access_connector_id": "/subscriptions/s/resourceGroups/g/providers/Microsoft.Databricks/accessConnectors/old",
+			"azure_managed_identity.0.credential_id":       "c",
+		},
+		HCL: `
+		name    = "openai"
+		comment = "Azure OpenAI"
+		azure_managed_identity {
+			access_connector_id = "/subscriptions/s/resourceGroups/g/providers/Microsoft.Databricks/accessConnectors/new"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"comment": "Azure OpenAI",
+	})
+}
+
+func TestUpdateServiceCredentialAws(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/credentials/team%23secrets",
+				ExpectedRequest: updateCredential{
+					Comment: "Secrets",
+					Aws: &catalog.AwsIamRoleResponse{
+						RoleArn: "arn:aws:iam::1234:role/new",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/credentials/team%23secrets",
+				Response: CredentialInfo{
+					Name:    "team#secrets",
+					Purpose: "SERVICE",
+					Comment: "Secrets",
+					Aws: &catalog.AwsIamRoleResponse{
+						RoleArn:            "arn:aws:iam::1234:role/new",
+						ExternalId:         "abc",
+						UnityCatalogIamArn: "arn:aws:iam::5678:role/uc",
+					},
+				},
+			},
+		},
+		Resource: ResourceCredential(),
+		Update:   true,
+		ID:       "team#secrets",
+		InstanceState: map[string]string{
+			"name":                                 "team#secrets",
+			"purpose":                              "SERVICE",
+			"owner":                                "admins",
+			"aws_iam_role.#":                       "1",
+			"aws_iam_role.0.role_arn":              "arn:aws:iam::1234:role/old",
+			"aws_iam_role.0.external_id":           "abc",
+			"aws_iam_role.0.unity_catalog_iam_arn": "arn:aws:iam::5678:role/uc",
+		},
+		HCL: `
+		name    = "team#secrets"
+		comment = "Secrets"
+		aws_iam_role {
+			role_arn = "arn:aws:iam::1234:role/new"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"aws_iam_role.0.role_arn": "arn:aws:iam::1234:role/new",
+	})
+}
+
+func TestDeleteCredentialForce(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/credentials/secrets?force=true",
+			},
+		},
+		Resource: ResourceCredential(),
+		Delete:   true,
+		ID:       "secrets",
+		HCL: `
+		name          = "secrets"
+		force_destroy = true
+		aws_iam_role {
+			role_arn = "arn:aws:iam::1234:role/secrets"
+		}`,
+	}.ApplyNoError(t)
+}
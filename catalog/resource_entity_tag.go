@@ -0,0 +1,237 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// entityTagType describes how tags of the Unity Catalog securable are set and read
+type entityTagType struct {
+	// securable is used in ALTER statements, e.g. ALTER SCHEMA
+	securable string
+	// view of the information schema, that has tags of the securable
+	view string
+	// columns of the view, that identify the securable, in the same order as parts of its full name
+	keys []string
+}
+
+var entityTagTypes = map[string]entityTagType{
+	"catalog": {"CATALOG", "catalog_tags", []string{"catalog_name"}},
+	"schema":  {"SCHEMA", "schema_tags", []string{"catalog_name", "schema_name"}},
+	"table":   {"TABLE", "table_tags", []string{"catalog_name", "schema_name", "table_name"}},
+	"volume":  {"VOLUME", "volume_tags", []string{"catalog_name", "schema_name", "volume_name"}},
+	"column":  {"TABLE", "column_tags", []string{"catalog_name", "schema_name", "table_name", "column_name"}},
+}
+
+type EntityTag struct {
+	EntityType  string            `json:"entity_type" tf:"force_new"`
+	EntityName  string            `json:"entity_name" tf:"force_new"`
+	Tags        map[string]string `json:"tags"`
+	WarehouseID string            `json:"warehouse_id"`
+}
+
+func (et EntityTag) validate() error {
+	tt, ok := entityTagTypes[et.EntityType]
+	if !ok {
+		// unknown types are reported by validation of entity_type
+		return nil
+	}
+	if len(strings.Split(et.EntityName, ".")) != len(tt.keys) {
+		names := make([]string, 0, len(tt.keys))
+		for _, key := range tt.keys {
+			names = append(names, "<"+strings.TrimSuffix(key, "_name")+">")
+		}
+		return fmt.Errorf("entity_name of %s must be in form of %s, got %s",
+			et.EntityType, strings.Join(names, "."), et.EntityName)
+	}
+	return nil
+}
+
+// alterPrefix returns the beginning of ALTER statements, that set or unset tags of the entity
+func (et EntityTag) alterPrefix() string {
+	tt := entityTagTypes[et.EntityType]
+	parts := strings.Split(et.EntityName, ".")
+	if et.EntityType == "column" {
+		return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s",
+			quoteSQLIdentifiers(parts[:3], "."), QuoteSQLIdentifier(parts[3]))
+	}
+	return fmt.Sprintf("ALTER %s %s", tt.securable, quoteSQLIdentifiers(parts, "."))
+}
+
+// newEntityTagExecutor returns a SqlTableInfo, that only runs statements on the SQL warehouse
+func newEntityTagExecutor(w *databricks.WorkspaceClient, warehouseID string) *SqlTableInfo {
+	return &SqlTableInfo{
+		WarehouseID: warehouseID,
+		sqlExec:     w.StatementExecution,
+		warehouses:  w.Warehouses,
+	}
+}
+
+// readTags reads all tags of the entity from the information schema of its catalog
+func (et EntityTag) readTags(ctx context.Context, exec *SqlTableInfo) (map[string]string, error) {
+	tt := entityTagTypes[et.EntityType]
+	parts := strings.Split(et.EntityName, ".")
+	conditions := make([]string, 0, len(tt.keys))
+	parameters := make([]sql.StatementParameterListItem, 0, len(tt.keys))
+	for i, key := range tt.keys {
+		conditions = append(conditions, fmt.Sprintf("%s = :%s", key, key))
+		// names of securables are stored in lower case
+		parameters = append(parameters, sql.StatementParameterListItem{Name: key, Value: strings.ToLower(parts[i])})
+	}
+	rows, err := exec.querySql(ctx, fmt.Sprintf("SELECT tag_name, tag_value FROM %s.information_schema.%s WHERE %s",
+		QuoteSQLIdentifier(parts[0]), tt.view, strings.Join(conditions, " AND ")), parameters)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read tags of %s %s: %w", et.EntityType, et.EntityName, err)
+	}
+	tags := map[string]string{}
+	for _, row := range rows {
+		if len(row) != 2 {
+			continue
+		}
+		tags[row[0]] = row[1]
+	}
+	return tags, nil
+}
+
+// checkExists returns a not found error for removed entities, so that the resource is removed from the state,
+// as the information schema has no rows both for removed entities and for entities without tags
+func (et EntityTag) checkExists(ctx context.Context, w *databricks.WorkspaceClient) error {
+	var err error
+	switch et.EntityType {
+	case "catalog":
+		_, err = w.Catalogs.GetByName(ctx, et.EntityName)
+	case "schema":
+		_, err = w.Schemas.GetByFullName(ctx, et.EntityName)
+	case "table":
+		_, err = w.Tables.GetByFullName(ctx, et.EntityName)
+	case "volume":
+		_, err = w.Volumes.ReadByName(ctx, et.EntityName)
+	case "column":
+		parts := strings.Split(et.EntityName, ".")
+		table, err := w.Tables.GetByFullName(ctx, strings.Join(parts[:3], "."))
+		if err != nil {
+			return err
+		}
+		for _, column := range table.Columns {
+			if strings.EqualFold(column.Name, parts[3]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("column %s doesn't exist: %w", et.EntityName, apierr.ErrNotFound)
+	}
+	return err
+}
+
+// applyTags makes tags of the entity equal to the desired ones, removing all other tags
+func (et EntityTag) applyTags(ctx context.Context, exec *SqlTableInfo, current, desired map[string]string) error {
+	for _, statement := range getTagStatements(et.alterPrefix(), current, desired) {
+		if err := exec.applySql(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ResourceEntityTag() common.Resource {
+	entityTypes := make([]string, 0, len(entityTagTypes))
+	for entityType := range entityTagTypes {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	s := common.StructToSchema(EntityTag{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "entity_type").SetValidateFunc(validation.StringInSlice(entityTypes, false))
+		common.CustomizeSchemaPath(m, "entity_name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+		return m
+	})
+	p := common.NewPairID("entity_type", "entity_name")
+	sync := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		var et EntityTag
+		common.DataToStructPointer(d, s, &et)
+		exec := newEntityTagExecutor(w, et.WarehouseID)
+		current, err := et.readTags(ctx, exec)
+		if err != nil {
+			return err
+		}
+		return et.applyTags(ctx, exec, current, et.Tags)
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if !d.NewValueKnown("entity_name") {
+				return nil
+			}
+			var et EntityTag
+			common.DiffToStructPointer(d, s, &et)
+			return et.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := sync(ctx, d, c); err != nil {
+				return err
+			}
+			p.Pack(d)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			entityType, entityName, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			et := EntityTag{
+				EntityType:  entityType,
+				EntityName:  entityName,
+				WarehouseID: d.Get("warehouse_id").(string),
+			}
+			if et.WarehouseID == "" {
+				return fmt.Errorf("warehouse_id is required to read tags of %s %s", entityType, entityName)
+			}
+			err = et.checkExists(ctx, w)
+			if err != nil {
+				return err
+			}
+			et.Tags, err = et.readTags(ctx, newEntityTagExecutor(w, et.WarehouseID))
+			if err != nil {
+				return err
+			}
+			return common.StructToData(et, s, d)
+		},
+		Update: sync,
+		Importer: &schema.ResourceImporter{
+			// tags could only be read with a warehouse, so its ID is a part of the import ID
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+				parts := strings.SplitN(d.Id(), "|", 3)
+				if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+					return nil, fmt.Errorf("import ID must be in form of <entity_type>|<entity_name>|<warehouse_id>, got %s", d.Id())
+				}
+				d.Set("warehouse_id", parts[2])
+				d.SetId(parts[0] + "|" + parts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var et EntityTag
+			common.DataToStructPointer(d, s, &et)
+			return et.applyTags(ctx, newEntityTagExecutor(w, et.WarehouseID), et.Tags, nil)
+		},
+	}
+}
@@ -0,0 +1,243 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func entityTagStatement(statement string, parameters []sql.StatementParameterListItem, rows ...[]string) qa.HTTPFixture {
+	response := sql.StatementResponse{
+		Status: &sql.StatementStatus{State: "SUCCEEDED"},
+	}
+	if parameters != nil {
+		response.Result = &sql.ResultData{DataArray: rows}
+	}
+	return qa.HTTPFixture{
+		Method:   "POST",
+		Resource: "/api/2.0/sql/statements/",
+		ExpectedRequest: sql.ExecuteStatementRequest{
+			Statement:     statement,
+			Parameters:    parameters,
+			WaitTimeout:   "50s",
+			WarehouseId:   "abc",
+			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+		},
+		Response: response,
+	}
+}
+
+var columnTagParameters = []sql.StatementParameterListItem{
+	{Name: "catalog_name", Value: "main"},
+	{Name: "schema_name", Value: "sales"},
+	{Name: "table_name", Value: "orders"},
+	{Name: "column_name", Value: "email"},
+}
+
+const columnTagQuery = "SELECT tag_name, tag_value FROM `main`.information_schema.column_tags " +
+	"WHERE catalog_name = :catalog_name AND schema_name = :schema_name AND table_name = :table_name AND column_name = :column_name"
+
+func TestResourceEntityTagCreateColumn(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			// tags, that were set outside of Terraform, are removed
+			entityTagStatement(columnTagQuery, columnTagParameters, []string{"owner", "sales"}),
+			entityTagStatement("ALTER TABLE `main`.`sales`.`orders` ALTER COLUMN `email` UNSET TAGS ('owner')", nil),
+			entityTagStatement("ALTER TABLE `main`.`sales`.`orders` ALTER COLUMN `email` "+
+				"SET TAGS ('class' = 'pii', 'sensitivity' = 'high')", nil),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders?",
+				Response: catalog.TableInfo{
+					Columns: []catalog.ColumnInfo{{Name: "id"}, {Name: "email"}},
+				},
+			},
+			entityTagStatement(columnTagQuery, columnTagParameters,
+				[]string{"class", "pii"}, []string{"sensitivity", "high"}),
+		},
+		Resource: ResourceEntityTag(),
+		Create:   true,
+		HCL: `
+		entity_type  = "column"
+		entity_name  = "main.sales.orders.email"
+		warehouse_id = "abc"
+		tags = {
+			class       = "pii"
+			sensitivity = "high"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "column|main.sales.orders.email",
+		"tags.%":           "2",
+		"tags.class":       "pii",
+		"tags.sensitivity": "high",
+	})
+}
+
+func TestResourceEntityTagReadCatalog(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/catalogs/Main?",
+				Response: catalog.CatalogInfo{Name: "main"},
+			},
+			entityTagStatement("SELECT tag_name, tag_value FROM `Main`.information_schema.catalog_tags "+
+				"WHERE catalog_name = :catalog_name", []sql.StatementParameterListItem{
+				{Name: "catalog_name", Value: "main"},
+			}, []string{"env", "prod"}),
+		},
+		Resource: ResourceEntityTag(),
+		Read:     true,
+		ID:       "catalog|Main",
+		InstanceState: map[string]string{
+			"entity_type":  "catalog",
+			"entity_name":  "Main",
+			"warehouse_id": "abc",
+		},
+		HCL: `
+		entity_type  = "catalog"
+		entity_name  = "Main"
+		warehouse_id = "abc"
+		tags = {
+			env = "prod"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"entity_type": "catalog",
+		"entity_name": "Main",
+		"tags.env":    "prod",
+	})
+}
+
+func TestResourceEntityTagUpdateSchema(t *testing.T) {
+	schemaTagQuery := "SELECT tag_name, tag_value FROM `main`.information_schema.schema_tags " +
+		"WHERE catalog_name = :catalog_name AND schema_name = :schema_name"
+	schemaTagParameters := []sql.StatementParameterListItem{
+		{Name: "catalog_name", Value: "main"},
+		{Name: "schema_name", Value: "sales"},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			entityTagStatement(schemaTagQuery, schemaTagParameters, []string{"env", "dev"}, []string{"team", "sales"}),
+			entityTagStatement("ALTER SCHEMA `main`.`sales` UNSET TAGS ('team')", nil),
+			entityTagStatement("ALTER SCHEMA `main`.`sales` SET TAGS ('env' = 'prod')", nil),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/schemas/main.sales?",
+				Response: catalog.SchemaInfo{FullName: "main.sales"},
+			},
+			entityTagStatement(schemaTagQuery, schemaTagParameters, []string{"env", "prod"}),
+		},
+		Resource: ResourceEntityTag(),
+		Update:   true,
+		ID:       "schema|main.sales",
+		InstanceState: map[string]string{
+			"entity_type":  "schema",
+			"entity_name":  "main.sales",
+			"warehouse_id": "abc",
+			"tags.%":       "2",
+			"tags.env":     "dev",
+			"tags.team":    "sales",
+		},
+		HCL: `
+		entity_type  = "schema"
+		entity_name  = "main.sales"
+		warehouse_id = "abc"
+		tags = {
+			env = "prod"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"tags.%":   "1",
+		"tags.env": "prod",
+	})
+}
+
+func TestResourceEntityTagDeleteVolume(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			entityTagStatement("ALTER VOLUME `main`.`raw`.`files` UNSET TAGS ('class', 'env')", nil),
+		},
+		Resource: ResourceEntityTag(),
+		Delete:   true,
+		ID:       "volume|main.raw.files",
+		InstanceState: map[string]string{
+			"entity_type":  "volume",
+			"entity_name":  "main.raw.files",
+			"warehouse_id": "abc",
+			"tags.%":       "2",
+			"tags.env":     "prod",
+			"tags.class":   "internal",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestEntityTagCheckExists_RemovedColumn(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+		Return(&catalog.TableInfo{Columns: []catalog.ColumnInfo{{Name: "id"}}}, nil)
+	err := EntityTag{EntityType: "column", EntityName: "main.sales.orders.Email"}.
+		checkExists(context.Background(), w.WorkspaceClient)
+	assert.True(t, apierr.IsMissing(err))
+}
+
+func TestEntityTagCheckExists_RemovedTable(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+		Return(nil, apierr.ErrNotFound)
+	err := EntityTag{EntityType: "table", EntityName: "main.sales.orders"}.
+		checkExists(context.Background(), w.WorkspaceClient)
+	assert.True(t, apierr.IsMissing(err))
+}
+
+func TestResourceEntityTagImportWithWarehouse(t *testing.T) {
+	d := ResourceEntityTag().ToResource().TestResourceData()
+	d.SetId("table|main.sales.orders|abc")
+	res, err := ResourceEntityTag().ToResource().Importer.StateContext(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "table|main.sales.orders", res[0].Id())
+	assert.Equal(t, "abc", res[0].Get("warehouse_id"))
+}
+
+func TestResourceEntityTagImportWithoutWarehouse(t *testing.T) {
+	d := ResourceEntityTag().ToResource().TestResourceData()
+	d.SetId("table|main.sales.orders")
+	_, err := ResourceEntityTag().ToResource().Importer.StateContext(context.Background(), d, nil)
+	assert.EqualError(t, err, "import ID must be in form of <entity_type>|<entity_name>|<warehouse_id>, got table|main.sales.orders")
+}
+
+func TestResourceEntityTagInvalidName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceEntityTag(),
+		Create:   true,
+		HCL: `
+		entity_type  = "table"
+		entity_name  = "main.orders"
+		warehouse_id = "abc"
+		tags = {
+			env = "prod"
+		}`,
+	}.ExpectError(t, "entity_name of table must be in form of <catalog>.<schema>.<table>, got main.orders")
+}
+
+func TestResourceEntityTagInvalidType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceEntityTag(),
+		Create:   true,
+		HCL: `
+		entity_type  = "view"
+		entity_name  = "main.sales.orders"
+		warehouse_id = "abc"
+		tags = {
+			env = "prod"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [entity_type] expected entity_type to be one of "+
+		"[catalog column schema table volume], got view")
+}
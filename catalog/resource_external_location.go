@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/catalog/bindings"
@@ -9,8 +10,66 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// AwsSqsQueue is an SQS queue, that receives file events of an external location on S3
+type AwsSqsQueue struct {
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+	QueueURL          string `json:"queue_url,omitempty" tf:"computed"`
+}
+
+// AzureQueueStorage is an Azure storage queue, that receives file events of an external location on ADLS
+type AzureQueueStorage struct {
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+	QueueURL          string `json:"queue_url,omitempty" tf:"computed"`
+	ResourceGroup     string `json:"resource_group,omitempty"`
+	SubscriptionID    string `json:"subscription_id,omitempty"`
+}
+
+// GcpPubsub is a Pub/Sub subscription, that receives file events of an external location on GCS
+type GcpPubsub struct {
+	ManagedResourceID string `json:"managed_resource_id,omitempty" tf:"computed"`
+	SubscriptionName  string `json:"subscription_name,omitempty" tf:"computed"`
+}
+
+// FileEventQueue is either a queue managed by Databricks or a queue provided by the user.
+// These fields aren't part of the Go SDK yet, so they are sent with separate requests.
+type FileEventQueue struct {
+	ManagedSqs     *AwsSqsQueue       `json:"managed_sqs,omitempty"`
+	ManagedAqs     *AzureQueueStorage `json:"managed_aqs,omitempty"`
+	ManagedPubsub  *GcpPubsub         `json:"managed_pubsub,omitempty"`
+	ProvidedSqs    *AwsSqsQueue       `json:"provided_sqs,omitempty"`
+	ProvidedAqs    *AzureQueueStorage `json:"provided_aqs,omitempty"`
+	ProvidedPubsub *GcpPubsub         `json:"provided_pubsub,omitempty"`
+}
+
+type externalLocationFileEvents struct {
+	EnableFileEvents bool            `json:"enable_file_events,omitempty"`
+	FileEventQueue   *FileEventQueue `json:"file_event_queue,omitempty"`
+}
+
+func (fe externalLocationFileEvents) isConfigured() bool {
+	return fe.EnableFileEvents || fe.FileEventQueue != nil
+}
+
+func externalLocationFileEventsPath(name string) string {
+	return "/unity-catalog/external-locations/" + url.PathEscape(name)
+}
+
+// updateExternalLocationFileEvents sets file events of the external location, also when they are turned off
+func updateExternalLocationFileEvents(ctx context.Context, c *common.DatabricksClient, name string,
+	fe externalLocationFileEvents) error {
+	patch := map[string]any{
+		"enable_file_events": fe.EnableFileEvents,
+	}
+	if fe.FileEventQueue != nil {
+		patch["file_event_queue"] = fe.FileEventQueue
+	}
+	return c.Patch(context.WithValue(ctx, common.Api, common.API_2_1), externalLocationFileEventsPath(name), patch)
+}
+
 // This structure contains the fields of both catalog.UpdateExternalLocation and catalog.CreateExternalLocation
 type ExternalLocationInfo struct {
+	externalLocationFileEvents
+
 	Name           string                     `json:"name" tf:"force_new"`
 	URL            string                     `json:"url"`
 	CredentialName string                     `json:"credential_name"`
@@ -19,6 +78,7 @@ type ExternalLocationInfo struct {
 	Owner          string                     `json:"owner,omitempty" tf:"computed"`
 	MetastoreID    string                     `json:"metastore_id,omitempty" tf:"computed"`
 	ReadOnly       bool                       `json:"read_only,omitempty"`
+	Fallback       bool                       `json:"fallback,omitempty"`
 	AccessPoint    string                     `json:"access_point,omitempty"`
 	EncDetails     *catalog.EncryptionDetails `json:"encryption_details,omitempty"`
 	IsolationMode  string                     `json:"isolation_mode,omitempty" tf:"computed"`
@@ -40,6 +100,14 @@ func ResourceExternalLocation() common.Resource {
 			}
 			m["url"].DiffSuppressFunc = ucDirectoryPathSlashOnlySuppressDiff
 			m["name"].DiffSuppressFunc = common.EqualFoldDiffSuppress
+			queues := []string{"managed_sqs", "managed_aqs", "managed_pubsub", "provided_sqs", "provided_aqs", "provided_pubsub"}
+			exactlyOneOf := []string{}
+			for _, queue := range queues {
+				exactlyOneOf = append(exactlyOneOf, "file_event_queue.0."+queue)
+			}
+			for _, queue := range queues {
+				common.CustomizeSchemaPath(m, "file_event_queue", queue).SetExactlyOneOf(exactlyOneOf)
+			}
 			return m
 		})
 	return common.Resource{
@@ -61,6 +129,15 @@ func ResourceExternalLocation() common.Resource {
 			}
 			d.SetId(el.Name)
 
+			var info ExternalLocationInfo
+			common.DataToStructPointer(d, s, &info)
+			if info.externalLocationFileEvents.isConfigured() {
+				err = updateExternalLocationFileEvents(ctx, c, el.Name, info.externalLocationFileEvents)
+				if err != nil {
+					return err
+				}
+			}
+
 			// Update owner or isolation mode if it is provided
 			if !updateRequired(d, []string{"owner", "isolation_mode"}) {
 				return nil
@@ -86,7 +163,34 @@ func ResourceExternalLocation() common.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(el, s, d)
+			err = common.StructToData(el, s, d)
+			if err != nil {
+				return err
+			}
+			// file events are only read, if they are managed by this resource
+			var info ExternalLocationInfo
+			common.DataToStructPointer(d, s, &info)
+			if !info.externalLocationFileEvents.isConfigured() {
+				return nil
+			}
+			var fe externalLocationFileEvents
+			err = c.Get(context.WithValue(ctx, common.Api, common.API_2_1), externalLocationFileEventsPath(d.Id()), nil, &fe)
+			if err != nil {
+				return err
+			}
+			err = common.StructToData(fe, s, d)
+			if err != nil {
+				return err
+			}
+			// StructToData skips false values and empty blocks, so that file events turned off outside of
+			// Terraform wouldn't be detected
+			if !fe.EnableFileEvents {
+				d.Set("enable_file_events", false)
+			}
+			if fe.FileEventQueue == nil {
+				d.Set("file_event_queue", []any{})
+			}
+			return nil
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			force := d.Get("force_update").(bool)
@@ -102,6 +206,12 @@ func ResourceExternalLocation() common.Resource {
 			common.DataToStructPointer(d, s, &updateExternalLocationRequest)
 			updateExternalLocationRequest.Name = d.Id()
 			updateExternalLocationRequest.Force = force
+			// read_only and fallback have to be sent, when they are turned off
+			for _, field := range []string{"read_only", "fallback"} {
+				if d.HasChange(field) {
+					common.SetForceSendFields(&updateExternalLocationRequest, d, []string{field})
+				}
+			}
 
 			if d.HasChange("owner") {
 				_, err = w.ExternalLocations.Update(ctx, catalog.UpdateExternalLocation{
@@ -113,7 +223,18 @@ func ResourceExternalLocation() common.Resource {
 				}
 			}
 
-			if !d.HasChangeExcept("owner") {
+			if d.HasChanges("enable_file_events", "file_event_queue") {
+				var info ExternalLocationInfo
+				common.DataToStructPointer(d, s, &info)
+				err = updateExternalLocationFileEvents(ctx, c, d.Id(), info.externalLocationFileEvents)
+				if err != nil {
+					return err
+				}
+			}
+
+			// skip_validation and force_* only affect requests, so they aren't updated on their own
+			if !d.HasChangesExcept("owner", "skip_validation", "force_update", "force_destroy",
+				"enable_file_events", "file_event_queue") {
 				return nil
 			}
 
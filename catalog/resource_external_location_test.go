@@ -596,3 +596,243 @@ func TestUpdateExternalLocationForce(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestUpdateExternalLocationTurnOffReadOnlyAndFallback(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: catalog.UpdateExternalLocation{
+					Url:             "s3://foo/bar",
+					CredentialName:  "bcd",
+					ForceSendFields: []string{"ReadOnly", "Fallback"},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":            "abc",
+			"url":             "s3://foo/bar",
+			"credential_name": "bcd",
+			"read_only":       "true",
+			"fallback":        "true",
+		},
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"read_only": false,
+		"fallback":  false,
+	})
+}
+
+func TestUpdateExternalLocationOnlySkipValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":            "abc",
+			"url":             "s3://foo/bar",
+			"credential_name": "bcd",
+			"skip_validation": "true",
+		},
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		skip_validation = false
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestCreateExternalLocationWithFileEvents(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/external-locations",
+				ExpectedRequest: catalog.CreateExternalLocation{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: map[string]any{
+					"enable_file_events": true,
+					"file_event_queue": map[string]any{
+						"managed_sqs": map[string]any{},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				Response: externalLocationFileEvents{
+					EnableFileEvents: true,
+					FileEventQueue: &FileEventQueue{
+						ManagedSqs: &AwsSqsQueue{
+							ManagedResourceID: "arn:aws:sqs:us-east-1:123456789012:queue",
+							QueueURL:          "https://sqs.us-east-1.amazonaws.com/123456789012/queue",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		enable_file_events = true
+		file_event_queue {
+			managed_sqs {}
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"enable_file_events":                                   true,
+		"file_event_queue.0.managed_sqs.0.queue_url":           "https://sqs.us-east-1.amazonaws.com/123456789012/queue",
+		"file_event_queue.0.managed_sqs.0.managed_resource_id": "arn:aws:sqs:us-east-1:123456789012:queue",
+	})
+}
+
+func TestUpdateExternalLocationDisableFileEvents(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: map[string]any{
+					"enable_file_events": false,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":               "abc",
+			"url":                "s3://foo/bar",
+			"credential_name":    "bcd",
+			"enable_file_events": "true",
+		},
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"enable_file_events": false,
+	})
+}
+
+func TestReadExternalLocationFileEventsTurnedOff(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				Response: externalLocationFileEvents{},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Read:     true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":                             "abc",
+			"url":                              "s3://foo/bar",
+			"credential_name":                  "bcd",
+			"enable_file_events":               "true",
+			"file_event_queue.#":               "1",
+			"file_event_queue.0.managed_sqs.#": "1",
+		},
+	}.ApplyAndExpectData(t, map[string]any{
+		"enable_file_events": false,
+		"file_event_queue.#": 0,
+	})
+}
+
+func TestExternalLocationFileEventQueueExactlyOneOf(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		file_event_queue {
+			managed_sqs {}
+			provided_sqs {
+				queue_url = "https://sqs.us-east-1.amazonaws.com/123456789012/queue"
+			}
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[file_event_queue.#.managed_aqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.managed_pubsub] Invalid combination of arguments. "+
+		"[file_event_queue.#.managed_sqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_aqs] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_pubsub] Invalid combination of arguments. "+
+		"[file_event_queue.#.provided_sqs] Invalid combination of arguments")
+}
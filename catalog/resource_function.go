@@ -0,0 +1,187 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type SqlFunctionParameter struct {
+	Name string `json:"name"`
+	// Type is the SQL type of the parameter, e.g. STRING or ARRAY<INT>
+	Type string `json:"type"`
+	// DefaultValue is a SQL expression, e.g. 'EMEA' or 0
+	DefaultValue string `json:"default_value,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+}
+
+type SqlFunctionInfo struct {
+	Name        string                 `json:"name" tf:"force_new"`
+	CatalogName string                 `json:"catalog_name" tf:"force_new"`
+	SchemaName  string                 `json:"schema_name" tf:"force_new"`
+	Parameters  []SqlFunctionParameter `json:"parameters,omitempty" tf:"alias:parameter"`
+	// ReturnType is the SQL type of the result, e.g. STRING, or TABLE (id INT, name STRING) for table functions
+	ReturnType string `json:"return_type"`
+	Language   string `json:"language,omitempty" tf:"default:SQL"`
+	// Body is the SQL expression or query for SQL functions, or the code for Python functions
+	Body          string `json:"body"`
+	Deterministic bool   `json:"deterministic,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	Owner         string `json:"owner,omitempty" tf:"computed"`
+	FullName      string `json:"full_name,omitempty" tf:"computed"`
+	// ClusterID, WarehouseID and UseServerlessWarehouse select the compute, like for databricks_sql_table
+	ClusterID              string `json:"cluster_id,omitempty" tf:"computed"`
+	WarehouseID            string `json:"warehouse_id,omitempty"`
+	UseServerlessWarehouse bool   `json:"use_serverless_warehouse,omitempty"`
+	// RoutineDefinition is the definition, as it's returned by the API after the last apply
+	RoutineDefinition string `json:"routine_definition,omitempty" tf:"computed"`
+}
+
+func (fi SqlFunctionInfo) fullName() string {
+	return fmt.Sprintf("%s.%s.%s", fi.CatalogName, fi.SchemaName, fi.Name)
+}
+
+// createStatement returns the CREATE OR REPLACE FUNCTION statement, that defines the function
+func (fi SqlFunctionInfo) createStatement() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("CREATE OR REPLACE FUNCTION %s(",
+		quoteSQLIdentifiers([]string{fi.CatalogName, fi.SchemaName, fi.Name}, ".")))
+	for i, p := range fi.Parameters {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%s %s", QuoteSQLIdentifier(p.Name), p.Type))
+		if p.DefaultValue != "" {
+			sb.WriteString(" DEFAULT " + p.DefaultValue)
+		}
+		if p.Comment != "" {
+			sb.WriteString(" COMMENT " + QuoteSQLString(p.Comment))
+		}
+	}
+	sb.WriteString(")\nRETURNS " + fi.ReturnType)
+	if fi.Language == "PYTHON" {
+		sb.WriteString("\nLANGUAGE PYTHON")
+	}
+	if fi.Deterministic {
+		sb.WriteString("\nDETERMINISTIC")
+	} else {
+		sb.WriteString("\nNOT DETERMINISTIC")
+	}
+	if fi.Comment != "" {
+		sb.WriteString("\nCOMMENT " + QuoteSQLString(fi.Comment))
+	}
+	if fi.Language == "PYTHON" {
+		sb.WriteString("\nAS $$\n" + strings.TrimSpace(fi.Body) + "\n$$")
+	} else {
+		sb.WriteString("\nRETURN " + strings.TrimSpace(fi.Body))
+	}
+	return sb.String()
+}
+
+func ResourceFunction() common.Resource {
+	s := common.StructToSchema(SqlFunctionInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, field := range []string{"name", "catalog_name", "schema_name"} {
+			common.CustomizeSchemaPath(m, field).SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+		}
+		common.CustomizeSchemaPath(m, "language").SetValidateFunc(validation.StringInSlice([]string{"SQL", "PYTHON"}, false))
+		common.CustomizeSchemaPath(m, "cluster_id").SetConflictsWith([]string{"warehouse_id", "use_serverless_warehouse"})
+		common.CustomizeSchemaPath(m, "warehouse_id").SetConflictsWith([]string{"cluster_id", "use_serverless_warehouse"})
+		common.CustomizeSchemaPath(m, "use_serverless_warehouse").SetConflictsWith([]string{"cluster_id", "warehouse_id"})
+		return m
+	})
+	// createOrReplace defines the function on the cluster or the SQL warehouse
+	createOrReplace := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, fi SqlFunctionInfo) error {
+		exec := new(SqlTableInfo)
+		if err := exec.initCluster(ctx, d, c); err != nil {
+			return err
+		}
+		if err := exec.applySql(ctx, fi.createStatement()); err != nil {
+			return err
+		}
+		// the new definition is read after apply, so it isn't reported as a change made outside of Terraform
+		d.Set("routine_definition", "")
+		return d.Set("cluster_id", exec.ClusterID)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var fi SqlFunctionInfo
+			common.DataToStructPointer(d, s, &fi)
+			if err := createOrReplace(ctx, d, c, fi); err != nil {
+				return err
+			}
+			d.SetId(fi.fullName())
+			if owner := c.OwnerOrDefault(fi.Owner); owner != "" {
+				w, err := c.WorkspaceClient()
+				if err != nil {
+					return err
+				}
+				_, err = w.Functions.Update(ctx, catalog.UpdateFunction{
+					Name:  fi.fullName(),
+					Owner: owner,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			f, err := w.Functions.GetByName(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			// the definition isn't returned in the same form as it's configured, so it's compared with the one,
+			// that was read after the last apply. If it's changed outside of Terraform, the body is reported as drift.
+			if applied := d.Get("routine_definition").(string); applied != "" && applied != f.RoutineDefinition {
+				d.Set("body", f.RoutineDefinition)
+			}
+			d.Set("routine_definition", f.RoutineDefinition)
+			d.Set("name", f.Name)
+			d.Set("catalog_name", f.CatalogName)
+			d.Set("schema_name", f.SchemaName)
+			d.Set("full_name", f.FullName)
+			d.Set("owner", f.Owner)
+			d.Set("comment", f.Comment)
+			d.Set("deterministic", f.IsDeterministic)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var fi SqlFunctionInfo
+			common.DataToStructPointer(d, s, &fi)
+			if d.HasChangesExcept("owner", "cluster_id", "warehouse_id", "use_serverless_warehouse") {
+				if err := createOrReplace(ctx, d, c, fi); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("owner") {
+				return nil
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			_, err = w.Functions.Update(ctx, catalog.UpdateFunction{
+				Name:  d.Id(),
+				Owner: fi.Owner,
+			})
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.Functions.DeleteByName(ctx, d.Id())
+		},
+	}
+}
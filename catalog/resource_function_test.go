@@ -0,0 +1,317 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func functionStatement(statement string) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "POST",
+		Resource: "/api/2.0/sql/statements/",
+		ExpectedRequest: sql.ExecuteStatementRequest{
+			Statement:     statement,
+			WaitTimeout:   "50s",
+			WarehouseId:   "abc",
+			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+		},
+		Response: sql.StatementResponse{
+			Status: &sql.StatementStatus{State: "SUCCEEDED"},
+		},
+	}
+}
+
+var getMaskEmailFunction = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.1/unity-catalog/functions/main.sales.mask_email?",
+	Response: catalog.FunctionInfo{
+		Name:              "mask_email",
+		CatalogName:       "main",
+		SchemaName:        "sales",
+		FullName:          "main.sales.mask_email",
+		Owner:             "data-engineers",
+		Comment:           "masks emails",
+		IsDeterministic:   true,
+		RoutineDefinition: "regexp_replace(email, '^[^@]+', '***')",
+	},
+}
+
+func TestFunctionCreateStatement(t *testing.T) {
+	assert.Equal(t, "CREATE OR REPLACE FUNCTION `main`.`sales`.`region_of`(`country` STRING DEFAULT 'DE' COMMENT 'ISO code', `fallback` STRING)\n"+
+		"RETURNS STRING\n"+
+		"NOT DETERMINISTIC\n"+
+		"COMMENT 'it\\'s a lookup'\n"+
+		"RETURN SELECT region FROM main.sales.countries WHERE code = country",
+		SqlFunctionInfo{
+			Name:        "region_of",
+			CatalogName: "main",
+			SchemaName:  "sales",
+			Parameters: []SqlFunctionParameter{
+				{Name: "country", Type: "STRING", DefaultValue: "'DE'", Comment: "ISO code"},
+				{Name: "fallback", Type: "STRING"},
+			},
+			ReturnType: "STRING",
+			Language:   "SQL",
+			Body:       "SELECT region FROM main.sales.countries WHERE code = country\n",
+			Comment:    "it's a lookup",
+		}.createStatement())
+	assert.Equal(t, "CREATE OR REPLACE FUNCTION `main`.`ml`.`score`(`x` DOUBLE)\n"+
+		"RETURNS DOUBLE\n"+
+		"LANGUAGE PYTHON\n"+
+		"DETERMINISTIC\n"+
+		"AS $$\n"+
+		"return x * 2\n"+
+		"$$",
+		SqlFunctionInfo{
+			Name:          "score",
+			CatalogName:   "main",
+			SchemaName:    "ml",
+			Parameters:    []SqlFunctionParameter{{Name: "x", Type: "DOUBLE"}},
+			ReturnType:    "DOUBLE",
+			Language:      "PYTHON",
+			Body:          "\nreturn x * 2\n",
+			Deterministic: true,
+		}.createStatement())
+}
+
+func TestResourceFunctionCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			functionStatement("CREATE OR REPLACE FUNCTION `main`.`sales`.`mask_email`(`email` STRING)\n" +
+				"RETURNS STRING\n" +
+				"DETERMINISTIC\n" +
+				"COMMENT 'masks emails'\n" +
+				"RETURN regexp_replace(email, '^[^@]+', '***')"),
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/functions/main.sales.mask_email",
+				ExpectedRequest: catalog.UpdateFunction{
+					Owner: "data-engineers",
+				},
+			},
+			getMaskEmailFunction,
+		},
+		// statements are executed on the SQL warehouse, not on a cluster
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name          = "mask_email"
+		catalog_name  = "main"
+		schema_name   = "sales"
+		return_type   = "STRING"
+		body          = "regexp_replace(email, '^[^@]+', '***')"
+		deterministic = true
+		comment       = "masks emails"
+		owner         = "data-engineers"
+		warehouse_id  = "abc"
+		parameter {
+			name = "email"
+			type = "STRING"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "main.sales.mask_email",
+		"full_name": "main.sales.mask_email",
+		"owner":     "data-engineers",
+		"language":  "SQL",
+	})
+}
+
+func TestResourceFunctionUpdateBody(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			functionStatement("CREATE OR REPLACE FUNCTION `main`.`sales`.`mask_email`(`email` STRING)\n" +
+				"RETURNS STRING\n" +
+				"DETERMINISTIC\n" +
+				"COMMENT 'masks emails'\n" +
+				"RETURN regexp_replace(email, '.+@', '***@')"),
+			getMaskEmailFunction,
+		},
+		// statements are executed on the SQL warehouse, not on a cluster
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		Resource: ResourceFunction(),
+		Update:   true,
+		ID:       "main.sales.mask_email",
+		InstanceState: map[string]string{
+			"name":             "mask_email",
+			"catalog_name":     "main",
+			"schema_name":      "sales",
+			"return_type":      "STRING",
+			"language":         "SQL",
+			"body":             "regexp_replace(email, '^[^@]+', '***')",
+			"deterministic":    "true",
+			"comment":          "masks emails",
+			"owner":            "data-engineers",
+			"warehouse_id":     "abc",
+			"parameter.#":      "1",
+			"parameter.0.name": "email",
+			"parameter.0.type": "STRING",
+			"full_name":        "main.sales.mask_email",
+		},
+		HCL: `
+		name          = "mask_email"
+		catalog_name  = "main"
+		schema_name   = "sales"
+		return_type   = "STRING"
+		body          = "regexp_replace(email, '.+@', '***@')"
+		deterministic = true
+		comment       = "masks emails"
+		owner         = "data-engineers"
+		warehouse_id  = "abc"
+		parameter {
+			name = "email"
+			type = "STRING"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"body": "regexp_replace(email, '.+@', '***@')",
+	})
+}
+
+func TestResourceFunctionRead_DefinitionChangedOutside(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{getMaskEmailFunction},
+		Resource: ResourceFunction(),
+		Read:     true,
+		ID:       "main.sales.mask_email",
+		InstanceState: map[string]string{
+			"name":               "mask_email",
+			"catalog_name":       "main",
+			"schema_name":        "sales",
+			"body":               "regexp_replace(email, '.+@', '***@')",
+			"routine_definition": "regexp_replace(email, '.+@', '***@')",
+		},
+		HCL: `
+		name          = "mask_email"
+		catalog_name  = "main"
+		schema_name   = "sales"
+		return_type   = "STRING"
+		body          = "regexp_replace(email, '.+@', '***@')"
+		warehouse_id  = "abc"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"body":               "regexp_replace(email, '^[^@]+', '***')",
+		"routine_definition": "regexp_replace(email, '^[^@]+', '***')",
+	})
+}
+
+func TestResourceFunctionRead_DefinitionUnchanged(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{getMaskEmailFunction},
+		Resource: ResourceFunction(),
+		Read:     true,
+		ID:       "main.sales.mask_email",
+		InstanceState: map[string]string{
+			"name":               "mask_email",
+			"catalog_name":       "main",
+			"schema_name":        "sales",
+			"body":               "lower(regexp_replace(email, '^[^@]+', '***'))",
+			"routine_definition": "regexp_replace(email, '^[^@]+', '***')",
+		},
+		HCL: `
+		name          = "mask_email"
+		catalog_name  = "main"
+		schema_name   = "sales"
+		return_type   = "STRING"
+		body          = "lower(regexp_replace(email, '^[^@]+', '***'))"
+		warehouse_id  = "abc"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"body": "lower(regexp_replace(email, '^[^@]+', '***'))",
+	})
+}
+
+func TestResourceFunctionServerlessConflictsWithWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name                     = "mask_email"
+		catalog_name             = "main"
+		schema_name              = "sales"
+		return_type              = "STRING"
+		body                     = "email"
+		warehouse_id             = "abc"
+		use_serverless_warehouse = true
+		`,
+	}.ExpectError(t, "invalid config supplied. [use_serverless_warehouse] Conflicting configuration arguments. "+
+		"[warehouse_id] Conflicting configuration arguments")
+}
+
+func TestResourceFunctionUpdateOwnerOnly(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/functions/main.sales.mask_email",
+				ExpectedRequest: catalog.UpdateFunction{
+					Owner: "data-engineers",
+				},
+			},
+			getMaskEmailFunction,
+		},
+		Resource: ResourceFunction(),
+		Update:   true,
+		ID:       "main.sales.mask_email",
+		InstanceState: map[string]string{
+			"name":          "mask_email",
+			"catalog_name":  "main",
+			"schema_name":   "sales",
+			"return_type":   "STRING",
+			"language":      "SQL",
+			"body":          "email",
+			"deterministic": "true",
+			"comment":       "masks emails",
+			"owner":         "admins",
+			"warehouse_id":  "abc",
+			"full_name":     "main.sales.mask_email",
+		},
+		HCL: `
+		name          = "mask_email"
+		catalog_name  = "main"
+		schema_name   = "sales"
+		return_type   = "STRING"
+		body          = "email"
+		deterministic = true
+		comment       = "masks emails"
+		owner         = "data-engineers"
+		warehouse_id  = "abc"`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceFunctionDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/functions/main.sales.mask_email?",
+			},
+		},
+		Resource: ResourceFunction(),
+		Delete:   true,
+		ID:       "main.sales.mask_email",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFunctionInvalidLanguage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name         = "f"
+		catalog_name = "main"
+		schema_name  = "sales"
+		return_type  = "STRING"
+		body         = "1"
+		language     = "SCALA"
+		warehouse_id = "abc"`,
+	}.ExpectError(t, "invalid config supplied. [language] expected language to be one of [SQL PYTHON], got SCALA")
+}
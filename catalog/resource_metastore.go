@@ -2,7 +2,9 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -28,7 +30,7 @@ type MetastoreInfo struct {
 	UpdatedAt                                   int64  `json:"updated_at,omitempty" tf:"computed"`
 	UpdatedBy                                   string `json:"updated_by,omitempty" tf:"computed"`
 	DeltaSharingScope                           string `json:"delta_sharing_scope,omitempty" tf:"suppress_diff"`
-	DeltaSharingRecipientTokenLifetimeInSeconds int64  `json:"delta_sharing_recipient_token_lifetime_in_seconds,omitempty"`
+	DeltaSharingRecipientTokenLifetimeInSeconds int64  `json:"delta_sharing_recipient_token_lifetime_in_seconds,omitempty" tf:"computed"`
 	DeltaSharingOrganizationName                string `json:"delta_sharing_organization_name,omitempty"`
 }
 
@@ -38,6 +40,57 @@ func updateForceSendFields(req *catalog.UpdateMetastore) {
 	}
 }
 
+// validateDeltaSharingChange checks that changes of the Delta Sharing configuration could be applied in place
+func validateDeltaSharingChange(d *schema.ResourceDiff) error {
+	// otherwise the empty value isn't sent to the API and the configuration would drift forever
+	if old, new := d.GetChange("delta_sharing_organization_name"); old.(string) != "" && new.(string) == "" &&
+		d.NewValueKnown("delta_sharing_organization_name") {
+		return fmt.Errorf("delta_sharing_organization_name can't be removed once it's set, " +
+			"change it to another value or recreate the metastore")
+	}
+	return nil
+}
+
+// metastoreRegions are the formats of regions of each cloud, e.g. us-east-1 on AWS, eastus on Azure and us-east1 on GCP
+var metastoreRegions = map[string]*regexp.Regexp{
+	"aws":   regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-\d+$`),
+	"azure": regexp.MustCompile(`^[a-z][a-z0-9]*$`),
+	"gcp":   regexp.MustCompile(`^[a-z]+-[a-z]+\d+$`),
+}
+
+// metastoreCloud returns the cloud of the metastore from the state, or guesses it from the scheme of the storage root
+func metastoreCloud(d *schema.ResourceDiff) string {
+	if cloud := d.Get("cloud").(string); cloud != "" {
+		return strings.ToLower(cloud)
+	}
+	storageRoot := d.Get("storage_root").(string)
+	switch {
+	case strings.HasPrefix(storageRoot, "s3://"), strings.HasPrefix(storageRoot, "s3a://"):
+		return "aws"
+	case strings.HasPrefix(storageRoot, "abfss://"), strings.HasPrefix(storageRoot, "abfs://"):
+		return "azure"
+	case strings.HasPrefix(storageRoot, "gs://"):
+		return "gcp"
+	}
+	return ""
+}
+
+func validateMetastoreRegion(cloud, region string) error {
+	region = strings.ToLower(region)
+	if pattern, ok := metastoreRegions[cloud]; ok {
+		if !pattern.MatchString(region) {
+			return fmt.Errorf("region %s isn't a valid region for %s", region, cloud)
+		}
+		return nil
+	}
+	for _, pattern := range metastoreRegions {
+		if pattern.MatchString(region) {
+			return nil
+		}
+	}
+	return fmt.Errorf("region %s isn't a valid AWS, Azure or GCP region", region)
+}
+
 func ResourceMetastore() common.Resource {
 	s := common.StructToSchema(MetastoreInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -48,6 +101,7 @@ func ResourceMetastore() common.Resource {
 			m["delta_sharing_scope"].RequiredWith = []string{"delta_sharing_recipient_token_lifetime_in_seconds"}
 			m["delta_sharing_scope"].ValidateFunc = validation.StringInSlice([]string{"INTERNAL", "INTERNAL_AND_EXTERNAL"}, false)
 			m["delta_sharing_recipient_token_lifetime_in_seconds"].RequiredWith = []string{"delta_sharing_scope"}
+			m["delta_sharing_recipient_token_lifetime_in_seconds"].ValidateFunc = validation.IntAtLeast(0)
 			m["storage_root"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
 				if strings.HasPrefix(old, new) {
 					log.Printf("[DEBUG] Ignoring configuration drift from %s to %s", old, new)
@@ -56,11 +110,30 @@ func ResourceMetastore() common.Resource {
 				return false
 			}
 			m["name"].DiffSuppressFunc = common.EqualFoldDiffSuppress
+			m["region"].DiffSuppressFunc = common.EqualFoldDiffSuppress
 			return m
 		})
 
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if err := validateDeltaSharingChange(d); err != nil {
+				return err
+			}
+			region := d.Get("region").(string)
+			if region == "" || !d.NewValueKnown("region") {
+				return nil
+			}
+			old, _ := d.GetChange("region")
+			if old.(string) == "" {
+				return validateMetastoreRegion(metastoreCloud(d), region)
+			}
+			// the region can't be updated, and recreating the metastore would drop all catalogs in it
+			if d.HasChange("region") {
+				return fmt.Errorf("region of the metastore can't be changed from %s to %s", old, region)
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var create catalog.CreateMetastore
 			var update catalog.UpdateMetastore
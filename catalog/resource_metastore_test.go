@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -8,8 +9,12 @@ import (
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMetastoreCornerCases(t *testing.T) {
@@ -362,6 +367,135 @@ func TestUpdateMetastore_DeltaSharingScopeOnly(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestUpdateMetastore_RemoveDeltaSharingArgumentsNoDiff(t *testing.T) {
+	resource := ResourceMetastore().ToResource()
+	// removing both arguments keeps Delta Sharing settings of the metastore
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"name":         "abc",
+		"storage_root": "s3:/a",
+	})
+	state := &terraform.InstanceState{
+		ID: "abc",
+		Attributes: map[string]string{
+			"id":                  "abc",
+			"name":                "abc",
+			"storage_root":        "s3:/a",
+			"delta_sharing_scope": "INTERNAL_AND_EXTERNAL",
+			"delta_sharing_recipient_token_lifetime_in_seconds": "1002",
+		},
+	}
+	diff, err := resource.Diff(context.Background(), state, config, &common.DatabricksClient{})
+	require.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "delta_sharing_scope")
+		assert.NotContains(t, diff.Attributes, "delta_sharing_recipient_token_lifetime_in_seconds")
+	}
+}
+
+func TestUpdateMetastore_ZeroTokenLifetimeDiff(t *testing.T) {
+	resource := ResourceMetastore().ToResource()
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"name":                "abc",
+		"storage_root":        "s3:/a",
+		"delta_sharing_scope": "INTERNAL_AND_EXTERNAL",
+		"delta_sharing_recipient_token_lifetime_in_seconds": 0,
+	})
+	state := &terraform.InstanceState{
+		ID: "abc",
+		Attributes: map[string]string{
+			"id":                  "abc",
+			"name":                "abc",
+			"storage_root":        "s3:/a",
+			"delta_sharing_scope": "INTERNAL_AND_EXTERNAL",
+			"delta_sharing_recipient_token_lifetime_in_seconds": "1002",
+		},
+	}
+	diff, err := resource.Diff(context.Background(), state, config, &common.DatabricksClient{})
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, "0", diff.Attributes["delta_sharing_recipient_token_lifetime_in_seconds"].New)
+}
+
+func TestUpdateMetastore_DeltaSharingOrganizationName(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockMetastoresAPI().EXPECT()
+			e.Update(mock.Anything, catalog.UpdateMetastore{
+				Id:                "abc",
+				DeltaSharingScope: "INTERNAL_AND_EXTERNAL",
+				DeltaSharingRecipientTokenLifetimeInSeconds: 1002,
+				DeltaSharingOrganizationName:                "acme",
+				ForceSendFields:                             []string{"DeltaSharingRecipientTokenLifetimeInSeconds"},
+			}).Return(&catalog.MetastoreInfo{}, nil)
+			e.GetById(mock.Anything, "abc").Return(&catalog.MetastoreInfo{
+				Name:              "abc",
+				DeltaSharingScope: "INTERNAL_AND_EXTERNAL",
+				DeltaSharingRecipientTokenLifetimeInSeconds: 1002,
+				DeltaSharingOrganizationName:                "acme",
+			}, nil)
+		},
+		Resource: ResourceMetastore(),
+		ID:       "abc",
+		Update:   true,
+		InstanceState: map[string]string{
+			"name":                "abc",
+			"storage_root":        "s3:/a",
+			"owner":               "admin",
+			"delta_sharing_scope": "INTERNAL_AND_EXTERNAL",
+			"delta_sharing_recipient_token_lifetime_in_seconds": "1002",
+			"delta_sharing_organization_name":                   "initech",
+		},
+		HCL: `
+		name = "abc"
+		storage_root = "s3:/a"
+		owner = "admin"
+		delta_sharing_scope = "INTERNAL_AND_EXTERNAL"
+		delta_sharing_recipient_token_lifetime_in_seconds = 1002
+		delta_sharing_organization_name = "acme"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"delta_sharing_organization_name": "acme",
+	})
+}
+
+func TestUpdateMetastore_RemoveDeltaSharingOrganizationName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMetastore(),
+		ID:       "abc",
+		Update:   true,
+		InstanceState: map[string]string{
+			"name":                "abc",
+			"storage_root":        "s3:/a",
+			"owner":               "admin",
+			"delta_sharing_scope": "INTERNAL",
+			"delta_sharing_recipient_token_lifetime_in_seconds": "1002",
+			"delta_sharing_organization_name":                   "initech",
+		},
+		HCL: `
+		name = "abc"
+		storage_root = "s3:/a"
+		owner = "admin"
+		delta_sharing_scope = "INTERNAL"
+		delta_sharing_recipient_token_lifetime_in_seconds = 1002
+		`,
+	}.ExpectError(t, "delta_sharing_organization_name can't be removed once it's set, "+
+		"change it to another value or recreate the metastore")
+}
+
+func TestCreateMetastore_NegativeTokenLifetime(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMetastore(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		storage_root = "s3://b"
+		delta_sharing_scope = "INTERNAL_AND_EXTERNAL"
+		delta_sharing_recipient_token_lifetime_in_seconds = -1
+		`,
+	}.ExpectError(t, "invalid config supplied. [delta_sharing_recipient_token_lifetime_in_seconds] "+
+		"expected delta_sharing_recipient_token_lifetime_in_seconds to be at least (0), got -1")
+}
+
 func TestCreateAccountMetastore(t *testing.T) {
 	qa.ResourceFixture{
 		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
@@ -699,6 +833,113 @@ func TestUpdateAccountMetastore_DeltaSharingScopeOnly(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestUpdateAccountMetastore_TokenLifetimeOnly(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			e := a.GetMockAccountMetastoresAPI().EXPECT()
+			e.Update(mock.Anything, catalog.AccountsUpdateMetastore{
+				MetastoreId: "abc",
+				MetastoreInfo: &catalog.UpdateMetastore{
+					Id:                "abc",
+					DeltaSharingScope: "INTERNAL_AND_EXTERNAL",
+					DeltaSharingRecipientTokenLifetimeInSeconds: 0,
+					ForceSendFields: []string{"DeltaSharingRecipientTokenLifetimeInSeconds"},
+				},
+			}).Return(&catalog.AccountsMetastoreInfo{}, nil)
+			e.GetByMetastoreId(mock.Anything, "abc").Return(&catalog.AccountsMetastoreInfo{
+				MetastoreInfo: &catalog.MetastoreInfo{
+					StorageRoot:       "s3://b/abc",
+					Name:              "abc",
+					Region:            "us-east-1",
+					Cloud:             "aws",
+					DeltaSharingScope: "INTERNAL_AND_EXTERNAL",
+				},
+			}, nil)
+		},
+		Resource:  ResourceMetastore(),
+		AccountID: "100",
+		ID:        "abc",
+		Update:    true,
+		InstanceState: map[string]string{
+			"name":                "abc",
+			"storage_root":        "s3://b/abc",
+			"owner":               "admin",
+			"region":              "us-east-1",
+			"cloud":               "aws",
+			"delta_sharing_scope": "INTERNAL_AND_EXTERNAL",
+			"delta_sharing_recipient_token_lifetime_in_seconds": "1002",
+		},
+		HCL: `
+		name = "abc"
+		storage_root = "s3://b/abc"
+		owner = "admin"
+		region = "us-east-1"
+		delta_sharing_scope = "INTERNAL_AND_EXTERNAL"
+		delta_sharing_recipient_token_lifetime_in_seconds = 0
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"delta_sharing_recipient_token_lifetime_in_seconds": 0,
+	})
+}
+
+func TestUpdateAccountMetastore_RegionChange(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:  ResourceMetastore(),
+		AccountID: "100",
+		ID:        "abc",
+		Update:    true,
+		InstanceState: map[string]string{
+			"name":         "abc",
+			"storage_root": "s3://b/abc",
+			"region":       "us-east-1",
+			"cloud":        "aws",
+		},
+		HCL: `
+		name = "abc"
+		storage_root = "s3://b/abc"
+		region = "us-west-2"
+		`,
+	}.ExpectError(t, "region of the metastore can't be changed from us-east-1 to us-west-2")
+}
+
+func TestCreateAccountMetastore_RegionOfOtherCloud(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:  ResourceMetastore(),
+		AccountID: "100",
+		Create:    true,
+		HCL: `
+		name = "abc"
+		storage_root = "gs://b"
+		region = "us-east-1"
+		`,
+	}.ExpectError(t, "region us-east-1 isn't a valid region for gcp")
+}
+
+func TestCreateAccountMetastore_InvalidRegion(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:  ResourceMetastore(),
+		AccountID: "100",
+		Create:    true,
+		HCL: `
+		name = "abc"
+		region = "us east"
+		`,
+	}.ExpectError(t, "region us east isn't a valid AWS, Azure or GCP region")
+}
+
+func TestValidateMetastoreRegion(t *testing.T) {
+	for cloud, regions := range map[string][]string{
+		"aws":   {"us-east-1", "us-gov-west-1", "ap-southeast-2", "EU-CENTRAL-1"},
+		"azure": {"eastus", "westeurope", "eastus2"},
+		"gcp":   {"us-east1", "europe-west2", "northamerica-northeast1"},
+		"":      {"us-east-1", "eastus", "us-east1"},
+	} {
+		for _, region := range regions {
+			assert.NoError(t, validateMetastoreRegion(cloud, region), "%s %s", cloud, region)
+		}
+	}
+}
+
 func TestReadAccountMetastore(t *testing.T) {
 	qa.ResourceFixture{
 		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
@@ -10,45 +10,48 @@ import (
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const onlineTableDefaultProvisionTimeout = 45 * time.Minute
 
-func waitForOnlineTableCreation(w *databricks.WorkspaceClient, ctx context.Context, onlineTableName string) error {
-	return retry.RetryContext(ctx, onlineTableDefaultProvisionTimeout, func() *retry.RetryError {
+func waitForOnlineTableCreation(w *databricks.WorkspaceClient, ctx context.Context, onlineTableName string, timeout time.Duration) error {
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		endpoint, err := w.OnlineTables.GetByName(ctx, onlineTableName)
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return common.Progress{}, err
 		}
 		if endpoint.Status == nil {
-			return retry.RetryableError(fmt.Errorf("online table status is not available yet"))
+			return common.InProgress("online table %s status is not available yet", onlineTableName), nil
 		}
 		switch endpoint.Status.DetailedState {
 		case catalog.OnlineTableStateOnline, catalog.OnlineTableStateOnlineContinuousUpdate,
 			catalog.OnlineTableStateOnlineNoPendingUpdate, catalog.OnlineTableStateOnlineTriggeredUpdate:
-			return nil
+			return common.Completed(), nil
 
 		// does catalog.OnlineTableStateOffline means that it's failed?
 		case catalog.OnlineTableStateOfflineFailed, catalog.OnlineTableStateOnlinePipelineFailed:
-			return retry.NonRetryableError(fmt.Errorf("online table status returned %s for online table: %s",
-				endpoint.Status.DetailedState.String(), onlineTableName))
+			return common.Progress{}, fmt.Errorf("online table status returned %s for online table: %s",
+				endpoint.Status.DetailedState.String(), onlineTableName)
+		}
+		progress := common.InProgress("online table %s is %s", onlineTableName, endpoint.Status.DetailedState)
+		if ps := endpoint.Status.ProvisioningStatus; ps != nil && ps.InitialPipelineSyncProgress != nil {
+			progress.Percent = ps.InitialPipelineSyncProgress.SyncProgressCompletion * 100
 		}
-		return retry.RetryableError(fmt.Errorf("online table %s is still pending", onlineTableName))
+		return progress, nil
 	})
 }
 
-func waitForOnlineTableDeletion(w *databricks.WorkspaceClient, ctx context.Context, onlineTableName string) error {
-	return retry.RetryContext(ctx, onlineTableDefaultProvisionTimeout, func() *retry.RetryError {
+func waitForOnlineTableDeletion(w *databricks.WorkspaceClient, ctx context.Context, onlineTableName string, timeout time.Duration) error {
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		_, err := w.OnlineTables.GetByName(ctx, onlineTableName)
 		if err == nil {
-			return retry.RetryableError(fmt.Errorf("online table %s is still not deleted", onlineTableName))
+			return common.InProgress("online table %s is still not deleted", onlineTableName), nil
 		}
 		if errors.Is(err, apierr.ErrResourceDoesNotExist) || errors.Is(err, apierr.ErrNotFound) {
-			return nil
+			return common.Completed(), nil
 		}
-		return retry.NonRetryableError(fmt.Errorf("online table status returned %w", err))
+		return common.Progress{}, fmt.Errorf("online table status returned %w", err)
 	})
 }
 
@@ -80,7 +83,7 @@ func ResourceOnlineTable() common.Resource {
 				return err
 			}
 			// this should be specified in the API Spec - filed a ticket to add it
-			err = waitForOnlineTableCreation(w, ctx, res.Name)
+			err = waitForOnlineTableCreation(w, ctx, res.Name, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 
 				return err
@@ -108,13 +111,14 @@ func ResourceOnlineTable() common.Resource {
 			if err != nil {
 				return err
 			}
-			return waitForOnlineTableDeletion(w, ctx, d.Id())
+			return waitForOnlineTableDeletion(w, ctx, d.Id(), d.Timeout(schema.TimeoutDelete))
 		},
 		StateUpgraders: []schema.StateUpgrader{},
 		Schema:         s,
 		SchemaVersion:  0,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(onlineTableDefaultProvisionTimeout),
+			Delete: schema.DefaultTimeout(onlineTableDefaultProvisionTimeout),
 		},
 	}
 }
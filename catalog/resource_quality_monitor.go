@@ -8,29 +8,42 @@ import (
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const qualityMonitorDefaultProvisionTimeout = 15 * time.Minute
 
-func WaitForMonitor(w *databricks.WorkspaceClient, ctx context.Context, monitorName string) error {
-	return retry.RetryContext(ctx, qualityMonitorDefaultProvisionTimeout, func() *retry.RetryError {
+func WaitForMonitor(w *databricks.WorkspaceClient, ctx context.Context, monitorName string, timeout time.Duration) error {
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		endpoint, err := w.QualityMonitors.GetByTableName(ctx, monitorName)
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return common.Progress{}, err
 		}
 
 		switch endpoint.Status {
 		case catalog.MonitorInfoStatusMonitorStatusActive:
-			return nil
+			return common.Completed(), nil
 		case catalog.MonitorInfoStatusMonitorStatusError, catalog.MonitorInfoStatusMonitorStatusFailed:
-			return retry.NonRetryableError(fmt.Errorf("monitor status retrund %s for monitor: %s", endpoint.Status, monitorName))
+			return common.Progress{}, fmt.Errorf("monitor status retrund %s for monitor: %s", endpoint.Status, monitorName)
 		}
-		return retry.RetryableError(fmt.Errorf("monitor %s is still pending", monitorName))
+		return common.InProgress("monitor %s is %s", monitorName, endpoint.Status), nil
 	})
 }
 
+// refreshMonitorOnApply triggers a refresh of metric tables, if `refresh_on_apply` is set, so that they reflect the
+// configuration right after it's applied instead of waiting for the next scheduled refresh
+func refreshMonitorOnApply(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData, tableName string) error {
+	if !d.Get("refresh_on_apply").(bool) {
+		return nil
+	}
+	_, err := w.QualityMonitors.RunRefresh(ctx, catalog.RunRefreshRequest{TableName: tableName})
+	if err != nil {
+		return fmt.Errorf("cannot refresh monitor %s: %w", tableName, err)
+	}
+	return nil
+}
+
 func ResourceQualityMonitor() common.Resource {
 	monitorSchema := common.StructToSchema(
 		catalog.MonitorInfo{},
@@ -48,12 +61,20 @@ func ResourceQualityMonitor() common.Resource {
 				Optional: true,
 				Required: false,
 			})
+			common.CustomizeSchemaPath(m).AddNewField("refresh_on_apply", &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			})
 			common.CustomizeSchemaPath(m, "monitor_version").SetReadOnly()
 			common.CustomizeSchemaPath(m, "drift_metrics_table_name").SetReadOnly()
 			common.CustomizeSchemaPath(m, "profile_metrics_table_name").SetReadOnly()
 			common.CustomizeSchemaPath(m, "status").SetReadOnly()
 			common.CustomizeSchemaPath(m, "dashboard_id").SetReadOnly()
-			common.CustomizeSchemaPath(m, "schedule", "pause_status").SetReadOnly()
+			common.CustomizeSchemaPath(m, "schedule", "pause_status").SetOptional().SetComputed().
+				SetValidateFunc(validation.StringInSlice([]string{
+					string(catalog.MonitorCronSchedulePauseStatusPaused),
+					string(catalog.MonitorCronSchedulePauseStatusUnpaused),
+				}, false))
 			return m
 		},
 	)
@@ -73,12 +94,12 @@ func ResourceQualityMonitor() common.Resource {
 			if err != nil {
 				return err
 			}
-			err = WaitForMonitor(w, ctx, create.TableName)
+			err = WaitForMonitor(w, ctx, create.TableName, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return err
 			}
 			d.SetId(endpoint.TableName)
-			return nil
+			return refreshMonitorOnApply(ctx, w, d, endpoint.TableName)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -104,7 +125,11 @@ func ResourceQualityMonitor() common.Resource {
 			if err != nil {
 				return err
 			}
-			return WaitForMonitor(w, ctx, update.TableName)
+			err = WaitForMonitor(w, ctx, update.TableName, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			return refreshMonitorOnApply(ctx, w, d, update.TableName)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -116,6 +141,7 @@ func ResourceQualityMonitor() common.Resource {
 		Schema: monitorSchema,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(qualityMonitorDefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(qualityMonitorDefaultProvisionTimeout),
 		},
 	}
 }
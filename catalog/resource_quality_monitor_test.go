@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
@@ -54,6 +55,120 @@ func TestQualityMonitorCreateTimeseries(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestQualityMonitorCreatePausedWithRefresh(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockQualityMonitorsAPI().EXPECT()
+			schedule := &catalog.MonitorCronSchedule{
+				QuartzCronExpression: "0 0 12 * * ?",
+				TimezoneId:           "UTC",
+				PauseStatus:          catalog.MonitorCronSchedulePauseStatusPaused,
+			}
+			e.Create(mock.Anything, catalog.CreateMonitor{
+				TableName:        "test_table",
+				OutputSchemaName: "output.schema",
+				AssetsDir:        "sample.dir",
+				Snapshot:         &catalog.MonitorSnapshot{},
+				Schedule:         schedule,
+			}).Return(&catalog.MonitorInfo{
+				AssetsDir:        "sample.dir",
+				OutputSchemaName: "output.schema",
+				TableName:        "test_table",
+				Status:           catalog.MonitorInfoStatusMonitorStatusPending,
+			}, nil)
+			e.GetByTableName(mock.Anything, "test_table").Return(&catalog.MonitorInfo{
+				TableName:        "test_table",
+				Status:           catalog.MonitorInfoStatusMonitorStatusActive,
+				AssetsDir:        "sample.dir",
+				OutputSchemaName: "output.schema",
+				Snapshot:         &catalog.MonitorSnapshot{},
+				Schedule:         schedule,
+				DashboardId:      "dashboard",
+			}, nil)
+			e.RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+				TableName: "test_table",
+			}).Return(&catalog.MonitorRefreshInfo{
+				RefreshId: 1,
+				State:     catalog.MonitorRefreshInfoStatePending,
+			}, nil)
+		},
+		Resource: ResourceQualityMonitor(),
+		HCL: `
+			table_name = "test_table"
+			assets_dir = "sample.dir"
+			output_schema_name = "output.schema"
+			snapshot {}
+			schedule {
+				quartz_cron_expression = "0 0 12 * * ?"
+				timezone_id = "UTC"
+				pause_status = "PAUSED"
+			}
+			refresh_on_apply = true
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schedule.0.pause_status": "PAUSED",
+		"dashboard_id":            "dashboard",
+	})
+}
+
+func TestQualityMonitorCreate_InvalidPauseStatus(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceQualityMonitor(),
+		HCL: `
+			table_name = "test_table"
+			assets_dir = "sample.dir"
+			output_schema_name = "output.schema"
+			snapshot {}
+			schedule {
+				quartz_cron_expression = "0 0 12 * * ?"
+				timezone_id = "UTC"
+				pause_status = "STOPPED"
+			}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [schedule.#.pause_status] expected schedule.0.pause_status to be one of [PAUSED UNPAUSED], got STOPPED")
+}
+
+func TestQualityMonitorUpdate_RefreshError(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockQualityMonitorsAPI().EXPECT()
+			e.Update(mock.Anything, catalog.UpdateMonitor{
+				TableName:        "test_table",
+				OutputSchemaName: "output.schema",
+				Snapshot:         &catalog.MonitorSnapshot{},
+			}).Return(&catalog.MonitorInfo{
+				TableName: "test_table",
+				Status:    catalog.MonitorInfoStatusMonitorStatusActive,
+			}, nil)
+			e.GetByTableName(mock.Anything, "test_table").Return(&catalog.MonitorInfo{
+				TableName:        "test_table",
+				Status:           catalog.MonitorInfoStatusMonitorStatusActive,
+				AssetsDir:        "sample.dir",
+				OutputSchemaName: "output.schema",
+				Snapshot:         &catalog.MonitorSnapshot{},
+			}, nil)
+			e.RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+				TableName: "test_table",
+			}).Return(nil, fmt.Errorf("refresh is already running"))
+		},
+		Resource: ResourceQualityMonitor(),
+		Update:   true,
+		ID:       "test_table",
+		InstanceState: map[string]string{
+			"table_name": "test_table",
+		},
+		HCL: `
+			table_name = "test_table"
+			assets_dir = "sample.dir"
+			output_schema_name = "output.schema"
+			snapshot {}
+			refresh_on_apply = true
+		`,
+	}.ExpectError(t, "cannot refresh monitor test_table: refresh is already running")
+}
+
 func TestQualityMonitorCreateInference(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
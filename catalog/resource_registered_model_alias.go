@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceRegisteredModelAlias manages an alias of a Unity Catalog registered model, e.g. `champion`,
+// that points to a specific model version
+func ResourceRegisteredModelAlias() common.Resource {
+	s := common.StructToSchema(
+		catalog.SetRegisteredModelAliasRequest{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["full_name"].ForceNew = true
+			m["full_name"].DiffSuppressFunc = common.EqualFoldDiffSuppress
+			m["alias"].ForceNew = true
+			m["version_num"].ValidateFunc = validation.IntAtLeast(1)
+			return m
+		})
+	p := common.NewPairID("full_name", "alias").Schema(
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			return s
+		})
+	set := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		var req catalog.SetRegisteredModelAliasRequest
+		common.DataToStructPointer(d, s, &req)
+		_, err = w.RegisteredModels.SetAlias(ctx, req)
+		return err
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := set(ctx, d, c); err != nil {
+				return err
+			}
+			p.Pack(d)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			fullName, alias, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			// the alias could be repointed to another version outside of Terraform, which is reported as a drift
+			version, err := w.ModelVersions.GetByAlias(ctx, catalog.GetByAliasRequest{
+				FullName: fullName,
+				Alias:    alias,
+			})
+			if err != nil {
+				return err
+			}
+			return d.Set("version_num", version.Version)
+		},
+		Update: set,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			fullName, alias, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.RegisteredModels.DeleteAliasByFullNameAndAlias(ctx, fullName, alias)
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisteredModelAliasCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceRegisteredModelAlias(), qa.CornerCaseID("a.b.c|champion"))
+}
+
+func TestRegisteredModelAliasCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRegisteredModelsAPI().EXPECT().SetAlias(mock.Anything, catalog.SetRegisteredModelAliasRequest{
+				FullName:   "a.b.c",
+				Alias:      "champion",
+				VersionNum: 3,
+			}).Return(&catalog.RegisteredModelAlias{
+				AliasName:  "champion",
+				VersionNum: 3,
+			}, nil)
+			w.GetMockModelVersionsAPI().EXPECT().GetByAlias(mock.Anything, catalog.GetByAliasRequest{
+				FullName: "a.b.c",
+				Alias:    "champion",
+			}).Return(&catalog.ModelVersionInfo{
+				Version: 3,
+			}, nil)
+		},
+		Resource: ResourceRegisteredModelAlias(),
+		HCL: `
+		full_name = "a.b.c"
+		alias = "champion"
+		version_num = 3
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "a.b.c|champion",
+		"version_num": 3,
+	})
+}
+
+func TestRegisteredModelAliasRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockModelVersionsAPI().EXPECT().GetByAlias(mock.Anything, catalog.GetByAliasRequest{
+				FullName: "a.b.c",
+				Alias:    "champion",
+			}).Return(&catalog.ModelVersionInfo{
+				Version: 4,
+			}, nil)
+		},
+		Resource: ResourceRegisteredModelAlias(),
+		ID:       "a.b.c|champion",
+		Read:     true,
+		New:      true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"full_name":   "a.b.c",
+		"alias":       "champion",
+		"version_num": 4,
+	})
+}
+
+func TestRegisteredModelAliasRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockModelVersionsAPI().EXPECT().GetByAlias(mock.Anything, mock.Anything).
+				Return(nil, apierr.ErrResourceDoesNotExist)
+		},
+		Resource: ResourceRegisteredModelAlias(),
+		ID:       "a.b.c|champion",
+		Read:     true,
+		Removed:  true,
+	}.ApplyNoError(t)
+}
+
+func TestRegisteredModelAliasUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRegisteredModelsAPI().EXPECT().SetAlias(mock.Anything, catalog.SetRegisteredModelAliasRequest{
+				FullName:   "a.b.c",
+				Alias:      "champion",
+				VersionNum: 5,
+			}).Return(&catalog.RegisteredModelAlias{
+				AliasName:  "champion",
+				VersionNum: 5,
+			}, nil)
+			w.GetMockModelVersionsAPI().EXPECT().GetByAlias(mock.Anything, mock.Anything).Return(&catalog.ModelVersionInfo{
+				Version: 5,
+			}, nil)
+		},
+		Resource: ResourceRegisteredModelAlias(),
+		ID:       "a.b.c|champion",
+		InstanceState: map[string]string{
+			"full_name":   "a.b.c",
+			"alias":       "champion",
+			"version_num": "3",
+		},
+		HCL: `
+		full_name = "a.b.c"
+		alias = "champion"
+		version_num = 5
+		`,
+		Update: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"version_num": 5,
+	})
+}
+
+func TestRegisteredModelAliasDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockRegisteredModelsAPI().EXPECT().DeleteAliasByFullNameAndAlias(mock.Anything, "a.b.c", "champion").Return(nil)
+		},
+		Resource: ResourceRegisteredModelAlias(),
+		ID:       "a.b.c|champion",
+		Delete:   true,
+	}.ApplyNoError(t)
+}
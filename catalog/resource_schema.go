@@ -31,6 +31,7 @@ func ResourceSchema() common.Resource {
 				Optional: true,
 				Default:  false,
 			}
+			s["cascade_acknowledged"] = cascadeAcknowledgedSchema()
 			common.CustomizeSchemaPath(s, "storage_root").SetCustomSuppressDiff(ucDirectoryPathSlashOnlySuppressDiff)
 			s["storage_root"].DiffSuppressFunc = ucDirectoryPathSlashOnlySuppressDiff
 			common.CustomizeSchemaPath(s, "name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
@@ -42,6 +43,9 @@ func ResourceSchema() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			return customizeCascadeDiff(d)
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -60,20 +64,15 @@ func ResourceSchema() common.Resource {
 			d.SetId(schema.FullName)
 
 			// Update owner or predictive optimization if it is provided
-			updateRequired := false
-			for _, key := range []string{"owner", "enable_predictive_optimization"} {
-				if d.Get(key) != "" {
-					updateRequired = true
-					break
-				}
-			}
-			if !updateRequired {
+			owner := c.OwnerOrDefault(d.Get("owner").(string))
+			if owner == "" && d.Get("enable_predictive_optimization") == "" {
 				return nil
 			}
 
 			var updateSchemaRequest catalog.UpdateSchema
 			common.DataToStructPointer(d, s, &updateSchemaRequest)
 			updateSchemaRequest.FullName = d.Id()
+			updateSchemaRequest.Owner = owner
 			_, err = w.Schemas.Update(ctx, updateSchemaRequest)
 			if err != nil {
 				return err
@@ -150,6 +149,12 @@ func ResourceSchema() common.Resource {
 			if err != nil {
 				return err
 			}
+			err = checkCascade(d, "schema", func() ([]string, error) {
+				return schemaDependents(ctx, w, strings.Split(name, ".")[0], strings.Split(name, ".")[1])
+			})
+			if err != nil {
+				return err
+			}
 			if force {
 				// delete all tables & views
 				tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{
@@ -86,6 +86,41 @@ func TestCreateSchemaWithOwner(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestCreateSchema_DefaultOwner(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockSchemasAPI().EXPECT()
+			e.Create(mock.Anything, catalog.CreateSchema{
+				Name:        "a",
+				CatalogName: "b",
+			}).Return(&catalog.SchemaInfo{
+				FullName: "b.a",
+				Owner:    "testers",
+			}, nil)
+			e.Update(mock.Anything, catalog.UpdateSchema{
+				FullName: "b.a",
+				Owner:    "data engineers",
+			}).Return(&catalog.SchemaInfo{
+				FullName: "b.a",
+				Owner:    "data engineers",
+			}, nil)
+			e.GetByFullName(mock.Anything, "b.a").Return(&catalog.SchemaInfo{
+				MetastoreId: "d",
+				Owner:       "data engineers",
+			}, nil)
+		},
+		Resource:     ResourceSchema(),
+		DefaultOwner: "data engineers",
+		Create:       true,
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner": "data engineers",
+	})
+}
+
 func TestUpdateSchema(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
@@ -415,6 +450,84 @@ func TestForceDeleteSchema(t *testing.T) {
 		comment = "c"
 		owner = "administrators"
 		force_destroy = true
+		cascade_acknowledged = true
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestForceDeleteSchema_CascadeNotAcknowledged(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTablesAPI().EXPECT().ListAll(mock.Anything, catalog.ListTablesRequest{
+				CatalogName:    "b",
+				SchemaName:     "a",
+				OmitColumns:    true,
+				OmitProperties: true,
+			}).Return([]catalog.TableInfo{
+				{
+					FullName:  "b.a.c",
+					TableType: catalog.TableTypeManaged,
+				},
+				{
+					FullName:  "b.a.d",
+					TableType: catalog.TableTypeMaterializedView,
+				},
+			}, nil)
+			w.GetMockVolumesAPI().EXPECT().ListAll(mock.Anything, catalog.ListVolumesRequest{
+				CatalogName: "b",
+				SchemaName:  "a",
+			}).Return([]catalog.VolumeInfo{
+				{
+					FullName: "b.a.e",
+				},
+			}, nil)
+			w.GetMockFunctionsAPI().EXPECT().ListAll(mock.Anything, catalog.ListFunctionsRequest{
+				CatalogName: "b",
+				SchemaName:  "a",
+			}).Return(nil, nil)
+			w.GetMockRegisteredModelsAPI().EXPECT().ListAll(mock.Anything, catalog.ListRegisteredModelsRequest{
+				CatalogName: "b",
+				SchemaName:  "a",
+			}).Return([]catalog.RegisteredModelInfo{
+				{
+					FullName: "b.a.f",
+				},
+			}, nil)
+		},
+		Resource: ResourceSchema(),
+		Delete:   true,
+		ID:       "b.a",
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		force_destroy = true
+		cascade_acknowledged = false
+		`,
+	}.ExpectError(t, "schema b.a can't be deleted, because force_destroy would drop the following objects, "+
+		"set cascade_acknowledged = true to drop them:\n"+
+		"- table b.a.c (MANAGED)\n"+
+		"- table b.a.d (MATERIALIZED_VIEW)\n"+
+		"- volume b.a.e\n"+
+		"- model b.a.f")
+}
+
+func TestForceDeleteSchema_CascadeNotAcknowledgedEmpty(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTablesAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+			w.GetMockVolumesAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+			w.GetMockFunctionsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+			w.GetMockRegisteredModelsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+			w.GetMockSchemasAPI().EXPECT().DeleteByFullName(mock.Anything, "b.a").Return(nil)
+		},
+		Resource: ResourceSchema(),
+		Delete:   true,
+		ID:       "b.a",
+		HCL: `
+		name = "a"
+		catalog_name = "b"
+		force_destroy = true
+		cascade_acknowledged = false
 		`,
 	}.ApplyNoError(t)
 }
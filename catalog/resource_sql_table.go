@@ -2,13 +2,19 @@ package catalog
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
@@ -17,40 +23,210 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var MaxSqlExecWaitTimeout = 50
 
+// defaultSqlExecTimeout is used if the resource timeouts aren't set, e.g. when the table is read
+const defaultSqlExecTimeout = 20 * time.Minute
+
+// Table feature, that has to be enabled on Delta tables to use column defaults
+const allowColumnDefaultsProperty = "delta.feature.allowColumnDefaults"
+const columnMappingModeProperty = "delta.columnMapping.mode"
+
+// Table properties, that make Delta tables readable by Iceberg clients (UniForm)
+const (
+	icebergCompatProperty   = "delta.enableIcebergCompatV2"
+	universalFormatProperty = "delta.universalFormat.enabledFormats"
+)
+
+// isIcebergManagedProperty returns true for properties, that are set or upgraded by the server
+// once UniForm is enabled on a table
+func isIcebergManagedProperty(key string) bool {
+	switch key {
+	case "delta.minReaderVersion", "delta.minWriterVersion", "delta.enableDeletionVectors":
+		return true
+	}
+	for _, prefix := range []string{"delta.enableIcebergCompat", "delta.universalFormat.", "delta.columnMapping.", "delta.feature."} {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Column metadata key, that holds the default expression of the column
+const columnDefaultMetadataKey = "CURRENT_DEFAULT"
+
 type SqlColumnInfo struct {
-	Name     string `json:"name"`
-	Type     string `json:"type_text,omitempty" tf:"alias:type,computed"`
-	Comment  string `json:"comment,omitempty"`
-	Nullable bool   `json:"nullable,omitempty" tf:"default:true"`
+	Name     string            `json:"name"`
+	Type     string            `json:"type_text,omitempty" tf:"alias:type,computed"`
+	Comment  string            `json:"comment,omitempty"`
+	Nullable bool              `json:"nullable,omitempty" tf:"default:true"`
+	Default  string            `json:"default,omitempty"`
+	Mask     *SqlColumnMask    `json:"mask,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	// Collation of a STRING column, e.g. UTF8_LCASE. It's returned as a part of the type by the Tables API.
+	Collation string `json:"collation,omitempty" tf:"computed"`
+}
+
+type SqlColumnMask struct {
+	FunctionName     string   `json:"function_name"`
+	UsingColumnNames []string `json:"using_column_names,omitempty"`
+}
+
+func (m *SqlColumnMask) serialize() string {
+	// MASK `main`.`default`.`mask_ssn` USING COLUMNS (`country`)
+	mask := fmt.Sprintf("MASK %s", getWrappedFullName(m.FunctionName))
+	if len(m.UsingColumnNames) > 0 {
+		mask += fmt.Sprintf(" USING COLUMNS (%s)", getWrappedColumnNames(m.UsingColumnNames))
+	}
+	return mask
+}
+
+func (m *SqlColumnMask) equal(other *SqlColumnMask) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return equalObjectNames(m.FunctionName, other.FunctionName) &&
+		slices.Equal(m.UsingColumnNames, other.UsingColumnNames)
+}
+
+// SqlTableSchedule is the refresh schedule of a materialized view or a streaming table
+type SqlTableSchedule struct {
+	CronExpression string `json:"cron_expression,omitempty"`
+	TimeZoneId     string `json:"time_zone_id,omitempty"`
+	Every          string `json:"every,omitempty"`
+}
+
+func (s *SqlTableSchedule) serialize() string {
+	if s.Every != "" {
+		return fmt.Sprintf("SCHEDULE EVERY %s", s.Every) // SCHEDULE EVERY 1 HOUR
+	}
+	schedule := fmt.Sprintf("SCHEDULE CRON %s", QuoteSQLString(s.CronExpression))
+	if s.TimeZoneId != "" {
+		schedule += fmt.Sprintf(" AT TIME ZONE %s", QuoteSQLString(s.TimeZoneId)) // SCHEDULE CRON '0 0 * * * ?' AT TIME ZONE 'UTC'
+	}
+	return schedule
+}
+
+// SqlTableClone is the source of a Delta table clone
+type SqlTableClone struct {
+	SourceTable string `json:"source_table"`
+	Type        string `json:"type,omitempty" tf:"default:DEEP"`
+	Version     int64  `json:"version,omitempty"`
+	Timestamp   string `json:"timestamp,omitempty"`
+}
+
+func (c *SqlTableClone) serialize() string {
+	// DEEP CLONE `main`.`prod`.`events` VERSION AS OF 12
+	clone := fmt.Sprintf("%s CLONE %s", c.Type, getWrappedFullName(c.SourceTable))
+	if c.Version != 0 {
+		clone += fmt.Sprintf(" VERSION AS OF %d", c.Version)
+	} else if c.Timestamp != "" {
+		clone += fmt.Sprintf(" TIMESTAMP AS OF %s", QuoteSQLString(c.Timestamp))
+	}
+	return clone
+}
+
+// SqlBloomFilterIndex is a bloom filter index on the columns of a Delta table
+type SqlBloomFilterIndex struct {
+	Columns  []string `json:"columns"`
+	Fpp      float64  `json:"fpp,omitempty"`
+	NumItems int64    `json:"num_items,omitempty"`
+}
+
+func (i SqlBloomFilterIndex) serializeColumnOptions() string {
+	options := []string{}
+	if i.Fpp != 0 {
+		options = append(options, "fpp="+strconv.FormatFloat(i.Fpp, 'f', -1, 64))
+	}
+	if i.NumItems != 0 {
+		options = append(options, fmt.Sprintf("numItems=%d", i.NumItems))
+	}
+	if len(options) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" OPTIONS (%s)", strings.Join(options, ", ")) // OPTIONS (fpp=0.1, numItems=5000000)
+}
+
+type SqlPrimaryKeyInfo struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+}
+
+type SqlForeignKeyInfo struct {
+	Name          string   `json:"name"`
+	Columns       []string `json:"columns"`
+	ParentTable   string   `json:"parent_table"`
+	ParentColumns []string `json:"parent_columns"`
+}
+
+type SqlCheckConstraintInfo struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
 }
 
 type SqlTableInfo struct {
 	Name                  string            `json:"name"`
 	CatalogName           string            `json:"catalog_name" tf:"force_new"`
 	SchemaName            string            `json:"schema_name" tf:"force_new"`
-	TableType             string            `json:"table_type" tf:"force_new"`
-	DataSourceFormat      string            `json:"data_source_format,omitempty" tf:"force_new"`
+	TableType             string            `json:"table_type"`
+	DataSourceFormat      string            `json:"data_source_format,omitempty"`
 	ColumnInfos           []SqlColumnInfo   `json:"columns,omitempty" tf:"alias:column,computed"`
-	Partitions            []string          `json:"partitions,omitempty" tf:"force_new"`
+	Partitions            []string          `json:"partitions,omitempty"`
 	ClusterKeys           []string          `json:"cluster_keys,omitempty"`
 	StorageLocation       string            `json:"storage_location,omitempty" tf:"suppress_diff"`
-	StorageCredentialName string            `json:"storage_credential_name,omitempty" tf:"force_new"`
+	StorageCredentialName string            `json:"storage_credential_name,omitempty"`
 	ViewDefinition        string            `json:"view_definition,omitempty"`
-	Comment               string            `json:"comment,omitempty"`
-	Properties            map[string]string `json:"properties,omitempty"`
-	Options               map[string]string `json:"options,omitempty" tf:"force_new"`
+	Schedule              *SqlTableSchedule `json:"schedule,omitempty"`
+	// SchemaBinding defines how a view adapts to schema changes of the queried tables. It isn't returned by the Tables API.
+	SchemaBinding string `json:"schema_binding,omitempty"`
+	// Collation is the default collation of STRING columns, that don't specify one. It isn't returned by the Tables API.
+	Collation string `json:"collation,omitempty"`
+	// FullRefreshOnQueryChange reprocesses all data of a streaming table after its query is changed
+	FullRefreshOnQueryChange bool `json:"full_refresh_on_query_change,omitempty"`
+	// AsSelect is the query, which results are used to populate a new table (CREATE TABLE AS SELECT)
+	AsSelect string `json:"as_select,omitempty"`
+	// RecreateOnAsSelectChange recreates the table once AsSelect is changed, otherwise changes are ignored
+	RecreateOnAsSelectChange bool `json:"recreate_on_as_select_change,omitempty"`
+	// ReplaceOnChange applies changes, that require recreation, with CREATE OR REPLACE instead of DROP and CREATE
+	ReplaceOnChange bool `json:"replace_on_change,omitempty"`
+	// PurgeOnDestroy drops the table with PURGE, so that it couldn't be restored with UNDROP
+	PurgeOnDestroy bool `json:"purge_on_destroy,omitempty"`
+	// UndropOnCreate restores the table with the same name, if it was dropped within the retention period
+	UndropOnCreate bool `json:"undrop_on_create,omitempty"`
+	// UniformIceberg enables reading the Delta table by Iceberg clients. It isn't returned by the Tables API.
+	UniformIceberg bool `json:"uniform_iceberg,omitempty"`
+	// Clone creates the table as a Delta clone of another table
+	Clone            *SqlTableClone           `json:"clone,omitempty"`
+	Comment          string                   `json:"comment,omitempty"`
+	Properties       map[string]string        `json:"properties,omitempty"`
+	Options          map[string]string        `json:"options,omitempty"`
+	Tags             map[string]string        `json:"tags,omitempty"`
+	PrimaryKey       *SqlPrimaryKeyInfo       `json:"primary_key,omitempty"`
+	ForeignKeys      []SqlForeignKeyInfo      `json:"foreign_keys,omitempty" tf:"alias:foreign_key,slice_set"`
+	CheckConstraints []SqlCheckConstraintInfo `json:"check_constraints,omitempty" tf:"alias:check_constraint,slice_set"`
+	// BloomFilterIndexes aren't returned by the Tables API, so they are tracked in the state only
+	BloomFilterIndexes []SqlBloomFilterIndex `json:"bloom_filter_indexes,omitempty" tf:"alias:bloom_filter_index"`
 	// EffectiveProperties includes both properties and options. Options are prefixed with `option.`.
 	EffectiveProperties map[string]string `json:"effective_properties" tf:"computed"`
 	ClusterID           string            `json:"cluster_id,omitempty" tf:"computed"`
 	WarehouseID         string            `json:"warehouse_id,omitempty"`
-	Owner               string            `json:"owner,omitempty" tf:"computed"`
-
-	exec    common.CommandExecutor
-	sqlExec sql.StatementExecutionInterface
+	// UseServerlessWarehouse runs statements on a serverless SQL warehouse instead of a cluster,
+	// if neither cluster_id nor warehouse_id is specified
+	UseServerlessWarehouse bool   `json:"use_serverless_warehouse,omitempty"`
+	Owner                  string `json:"owner,omitempty" tf:"computed"`
+	// EffectiveSql previews statements, that are executed to apply the planned changes
+	EffectiveSql string `json:"effective_sql,omitempty" tf:"computed"`
+	// IgnoredProperties are patterns of properties and options, which effective values don't cause a diff
+	IgnoredProperties []string `json:"ignored_properties,omitempty" tf:"slice_set"`
+
+	exec        common.CommandExecutor
+	sqlExec     sql.StatementExecutionInterface
+	clustersAPI *clusters.ClustersAPI
+	warehouses  sql.WarehousesInterface
 }
 
 func (ti SqlTableInfo) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
@@ -67,18 +243,52 @@ func (ti SqlTableInfo) CustomizeSchema(s *common.CustomizableSchema) *common.Cus
 	})
 	s.SchemaPath("storage_location").SetCustomSuppressDiff(ucDirectoryPathSlashAndEmptySuppressDiff)
 	s.SchemaPath("view_definition").SetCustomSuppressDiff(common.SuppressDiffWhitespaceChange)
+	s.SchemaPath("as_select").SetCustomSuppressDiff(func(k, old, new string, d *schema.ResourceData) bool {
+		// the query only populates the table once it's created
+		if old != "" && (new == "" || !d.Get("recreate_on_as_select_change").(bool)) {
+			return true
+		}
+		return common.SuppressDiffWhitespaceChange(k, old, new, d)
+	})
+
+	s.SchemaPath("cluster_id").SetConflictsWith([]string{"warehouse_id", "use_serverless_warehouse"})
+	s.SchemaPath("warehouse_id").SetConflictsWith([]string{"cluster_id", "use_serverless_warehouse"})
+	s.SchemaPath("use_serverless_warehouse").SetConflictsWith([]string{"cluster_id", "warehouse_id"})
 
-	s.SchemaPath("cluster_id").SetConflictsWith([]string{"warehouse_id"})
-	s.SchemaPath("warehouse_id").SetConflictsWith([]string{"cluster_id"})
+	s.SchemaPath("view_definition").SetConflictsWith([]string{"as_select", "clone"})
+	s.SchemaPath("schema_binding").SetValidateFunc(validation.StringInSlice([]string{"BINDING", "COMPENSATION", "EVOLUTION", "TYPE EVOLUTION"}, false))
+	s.SchemaPath("as_select").SetConflictsWith([]string{"view_definition", "clone"})
+	s.SchemaPath("clone").SetConflictsWith([]string{"view_definition", "as_select", "partitions", "cluster_keys"})
+	s.SchemaPath("clone", "source_table").SetCustomSuppressDiff(func(k, old, new string, d *schema.ResourceData) bool {
+		return equalObjectNames(old, new)
+	})
+	s.SchemaPath("clone", "type").SetValidateFunc(validation.StringInSlice([]string{"DEEP", "SHALLOW"}, false))
+	s.SchemaPath("clone", "version").SetConflictsWith([]string{"clone.0.timestamp"})
+	s.SchemaPath("clone", "timestamp").SetConflictsWith([]string{"clone.0.version"})
 
 	s.SchemaPath("partitions").SetConflictsWith([]string{"cluster_keys"})
 	s.SchemaPath("cluster_keys").SetConflictsWith([]string{"partitions"})
 	s.SchemaPath("column", "type").SetCustomSuppressDiff(func(k, old, new string, d *schema.ResourceData) bool {
 		return getColumnType(old) == getColumnType(new)
 	})
+	s.SchemaPath("column", "default").SetCustomSuppressDiff(common.SuppressDiffWhitespaceChange)
+	s.SchemaPath("column", "collation").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+	s.SchemaPath("collation").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+	s.SchemaPath("column", "mask", "function_name").SetCustomSuppressDiff(func(k, old, new string, d *schema.ResourceData) bool {
+		return equalObjectNames(old, new)
+	})
+	s.SchemaPath("foreign_key", "parent_table").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+	s.SchemaPath("bloom_filter_index", "columns").SetMinItems(1)
+	s.SchemaPath("bloom_filter_index", "fpp").SetValidateFunc(validation.FloatBetween(0, 1))
+	s.SchemaPath("bloom_filter_index", "num_items").SetValidateFunc(validation.IntAtLeast(1))
+	s.SchemaPath("schedule", "cron_expression").SetExactlyOneOf([]string{"schedule.0.cron_expression", "schedule.0.every"})
+	s.SchemaPath("schedule", "every").SetExactlyOneOf([]string{"schedule.0.cron_expression", "schedule.0.every"}).SetValidateFunc(
+		validation.StringMatch(scheduleEveryRegex, "must be a number followed by HOUR(S), DAY(S) or WEEK(S), for example `1 DAY`"))
 	return s
 }
 
+var scheduleEveryRegex = regexp.MustCompile(`^\d+ (HOUR|HOURS|DAY|DAYS|WEEK|WEEKS)$`)
+
 type SqlTablesAPI struct {
 	client  *common.DatabricksClient
 	context context.Context
@@ -89,34 +299,191 @@ func NewSqlTablesAPI(ctx context.Context, m any) SqlTablesAPI {
 }
 
 func (a SqlTablesAPI) getTable(name string) (ti SqlTableInfo, err error) {
-	err = a.client.Get(a.context, "/unity-catalog/tables/"+name, nil, &ti)
+	var table struct {
+		SqlTableInfo
+		Columns          []catalog.ColumnInfo      `json:"columns,omitempty"`
+		TableConstraints []catalog.TableConstraint `json:"table_constraints,omitempty"`
+	}
+	err = a.client.Get(a.context, "/unity-catalog/tables/"+name, nil, &table)
+	ti = table.SqlTableInfo
+	ti.setColumns(table.Columns)
+	ti.setPartitions(table.Columns)
 	// Copy returned properties & options to read-only attributes
 	ti.EffectiveProperties = ti.Properties
 	ti.Properties = nil
+	ti.setClusterKeys()
+	ti.setOptions()
+	ti.setConstraints(table.TableConstraints)
+	ti.setCheckConstraints()
 	return
 }
 
+// setColumns converts columns returned by the Tables API, extracting the default values from the column metadata
+func (ti *SqlTableInfo) setColumns(columns []catalog.ColumnInfo) {
+	ti.ColumnInfos = nil
+	for _, col := range columns {
+		ci := SqlColumnInfo{
+			Name:     col.Name,
+			Type:     col.TypeText,
+			Comment:  col.Comment,
+			Nullable: col.Nullable,
+		}
+		// string collate UTF8_LCASE
+		if m := columnCollationRegex.FindStringSubmatch(col.TypeText); m != nil {
+			ci.Type, ci.Collation = m[1], m[2]
+		}
+		if col.Mask != nil {
+			ci.Mask = &SqlColumnMask{
+				FunctionName:     col.Mask.FunctionName,
+				UsingColumnNames: col.Mask.UsingColumnNames,
+			}
+		}
+		if col.TypeJson != "" {
+			var typeJson struct {
+				Metadata map[string]any `json:"metadata,omitempty"`
+			}
+			if err := json.Unmarshal([]byte(col.TypeJson), &typeJson); err != nil {
+				log.Printf("[WARN] Cannot parse type_json of column %s: %s", col.Name, err)
+			} else if v, ok := typeJson.Metadata[columnDefaultMetadataKey].(string); ok {
+				ci.Default = v
+			}
+		}
+		ti.ColumnInfos = append(ti.ColumnInfos, ci)
+	}
+}
+
+var columnCollationRegex = regexp.MustCompile(`(?i)^(.+?)\s+collate\s+(\S+)$`)
+
+// setPartitions extracts partition columns in the order of their partition index
+func (ti *SqlTableInfo) setPartitions(columns []catalog.ColumnInfo) {
+	ti.Partitions = nil
+	partitionColumns := []catalog.ColumnInfo{}
+	for _, col := range columns {
+		// the first partition column has zero index, that is only distinguishable by the field being sent
+		if col.PartitionIndex > 0 || slices.Contains(col.ForceSendFields, "PartitionIndex") {
+			partitionColumns = append(partitionColumns, col)
+		}
+	}
+	slices.SortStableFunc(partitionColumns, func(a, b catalog.ColumnInfo) int {
+		return a.PartitionIndex - b.PartitionIndex
+	})
+	for _, col := range partitionColumns {
+		ti.Partitions = append(ti.Partitions, col.Name)
+	}
+}
+
+// Delta table property, that has liquid clustering columns as a JSON array of column paths, e.g. [["a"],["b","c"]]
+const clusteringColumnsProperty = "clusteringColumns"
+
+// setClusterKeys extracts liquid clustering columns from the table properties
+func (ti *SqlTableInfo) setClusterKeys() {
+	ti.ClusterKeys = nil
+	value, ok := ti.EffectiveProperties[clusteringColumnsProperty]
+	if !ok {
+		return
+	}
+	var paths [][]string
+	if err := json.Unmarshal([]byte(value), &paths); err != nil {
+		log.Printf("[WARN] Cannot parse %s of %s: %s", clusteringColumnsProperty, ti.FullName(), err)
+		return
+	}
+	for _, path := range paths {
+		ti.ClusterKeys = append(ti.ClusterKeys, strings.Join(path, "."))
+	}
+}
+
+const optionPropertyPrefix = "option."
+
+// setOptions extracts options from the `option.*` table properties
+func (ti *SqlTableInfo) setOptions() {
+	ti.Options = nil
+	for key, value := range ti.EffectiveProperties {
+		if !strings.HasPrefix(key, optionPropertyPrefix) {
+			continue
+		}
+		if ti.Options == nil {
+			ti.Options = map[string]string{}
+		}
+		ti.Options[strings.TrimPrefix(key, optionPropertyPrefix)] = value
+	}
+}
+
+const checkConstraintPropertyPrefix = "delta.constraints."
+
+// setCheckConstraints extracts CHECK constraints from the `delta.constraints.*` table properties
+func (ti *SqlTableInfo) setCheckConstraints() {
+	ti.CheckConstraints = nil
+	for key, value := range ti.EffectiveProperties {
+		if strings.HasPrefix(key, checkConstraintPropertyPrefix) {
+			ti.CheckConstraints = append(ti.CheckConstraints, SqlCheckConstraintInfo{
+				Name:       strings.TrimPrefix(key, checkConstraintPropertyPrefix),
+				Expression: value,
+			})
+		}
+	}
+	slices.SortFunc(ti.CheckConstraints, func(a, b SqlCheckConstraintInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// setConstraints converts informational constraints returned by the Tables API into
+// the primary_key & foreign_key blocks
+func (ti *SqlTableInfo) setConstraints(constraints []catalog.TableConstraint) {
+	ti.PrimaryKey = nil
+	ti.ForeignKeys = nil
+	for _, c := range constraints {
+		if c.PrimaryKeyConstraint != nil {
+			ti.PrimaryKey = &SqlPrimaryKeyInfo{
+				Name:    c.PrimaryKeyConstraint.Name,
+				Columns: c.PrimaryKeyConstraint.ChildColumns,
+			}
+		}
+		if c.ForeignKeyConstraint != nil {
+			ti.ForeignKeys = append(ti.ForeignKeys, SqlForeignKeyInfo{
+				Name:          c.ForeignKeyConstraint.Name,
+				Columns:       c.ForeignKeyConstraint.ChildColumns,
+				ParentTable:   c.ForeignKeyConstraint.ParentTable,
+				ParentColumns: c.ForeignKeyConstraint.ParentColumns,
+			})
+		}
+	}
+}
+
 func (ti *SqlTableInfo) FullName() string {
 	return fmt.Sprintf("%s.%s.%s", ti.CatalogName, ti.SchemaName, ti.Name)
 }
 
 func (ti *SqlTableInfo) SQLFullName() string {
-	return fmt.Sprintf("`%s`.`%s`.`%s`", ti.CatalogName, ti.SchemaName, ti.Name)
+	return quoteSQLIdentifiers([]string{ti.CatalogName, ti.SchemaName, ti.Name}, ".")
 }
 
+// parseComment escapes the comment for a string literal, keeping quotes, that are already escaped
 func parseComment(s string) string {
-	return strings.ReplaceAll(strings.ReplaceAll(s, `\'`, `'`), `'`, `\'`)
+	return escapeSQLString(strings.ReplaceAll(s, `\'`, `'`))
 }
 
 func (ti *SqlTableInfo) initCluster(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (err error) {
 	defaultClusterName := "terraform-sql-table"
 	clustersAPI := clusters.NewClustersAPI(ctx, c)
-	// if a cluster id is specified, start the cluster
-	if ci, ok := d.GetOk("cluster_id"); ok {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	// cluster_id of the default cluster could be in the state, so the serverless warehouse is checked first
+	if d.Get("use_serverless_warehouse").(bool) {
+		ti.WarehouseID, err = getOrCreateServerlessWarehouse(ctx, w, defaultClusterName)
+		if err != nil {
+			return
+		}
+		// if a cluster id is specified, start the cluster
+	} else if ci, ok := d.GetOk("cluster_id"); ok {
 		ti.ClusterID = ci.(string)
 		_, err = clustersAPI.StartAndGetInfo(ti.ClusterID)
 		if apierr.IsMissing(err) {
 			// cluster that was previously in a tfstate was deleted
+			if err = ti.checkImplicitCompute(c, defaultClusterName); err != nil {
+				return
+			}
 			ti.ClusterID, err = ti.getOrCreateCluster(defaultClusterName, clustersAPI)
 			if err != nil {
 				return
@@ -131,17 +498,59 @@ func (ti *SqlTableInfo) initCluster(ctx context.Context, d *schema.ResourceData,
 		ti.WarehouseID = wi.(string)
 		// else, create a default cluster
 	} else {
+		if err = ti.checkImplicitCompute(c, defaultClusterName); err != nil {
+			return
+		}
 		ti.ClusterID, err = ti.getOrCreateCluster(defaultClusterName, clustersAPI)
 		if err != nil {
 			return
 		}
 	}
 	ti.exec = c.CommandExecutor(ctx)
-	w, err := c.WorkspaceClient()
+	ti.sqlExec = w.StatementExecution
+	ti.clustersAPI = &clustersAPI
+	ti.warehouses = w.Warehouses
+	return nil
+}
+
+// getOrCreateServerlessWarehouseMutex prevents creation of multiple warehouses with the same name,
+// when many tables are created in parallel
+var getOrCreateServerlessWarehouseMutex sync.Mutex
+
+// getOrCreateServerlessWarehouse returns the id of a serverless SQL warehouse with the given name, creating it if
+// it doesn't exist. Serverless warehouses start within seconds, which is faster and cheaper for DDL statements.
+func getOrCreateServerlessWarehouse(ctx context.Context, w *databricks.WorkspaceClient, name string) (string, error) {
+	getOrCreateServerlessWarehouseMutex.Lock()
+	defer getOrCreateServerlessWarehouseMutex.Unlock()
+	warehouses, err := w.Warehouses.ListAll(ctx, sql.ListWarehousesRequest{})
 	if err != nil {
-		return err
+		return "", err
+	}
+	for _, warehouse := range warehouses {
+		if warehouse.Name == name && warehouse.EnableServerlessCompute {
+			log.Printf("[INFO] Found reusable serverless warehouse '%s'", name)
+			return warehouse.Id, nil
+		}
+	}
+	log.Printf("[INFO] Creating serverless warehouse '%s'", name)
+	wait, err := w.Warehouses.Create(ctx, sql.CreateWarehouseRequest{
+		Name:                    name,
+		ClusterSize:             "2X-Small",
+		MaxNumClusters:          1,
+		AutoStopMins:            10,
+		EnableServerlessCompute: true,
+		WarehouseType:           sql.CreateWarehouseRequestWarehouseTypePro,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot create serverless warehouse %s: %w", name, err)
+	}
+	return wait.Id, nil
+}
+
+func (ti *SqlTableInfo) checkImplicitCompute(c *common.DatabricksClient, clusterName string) error {
+	if err := c.CheckImplicitCompute(clusterName); err != nil {
+		return fmt.Errorf("%w: specify cluster_id, warehouse_id or use_serverless_warehouse", err)
 	}
-	ti.sqlExec = w.StatementExecution
 	return nil
 }
 
@@ -172,16 +581,31 @@ func (ti *SqlTableInfo) getOrCreateCluster(clusterName string, clustersAPI clust
 }
 
 func (ti *SqlTableInfo) serializeColumnInfo(col SqlColumnInfo) string {
+	collation := ""
+	if col.Collation != "" {
+		collation = fmt.Sprintf(" COLLATE %s", col.Collation)
+	}
+
 	notNull := ""
 	if !col.Nullable {
 		notNull = " NOT NULL"
 	}
 
+	defaultValue := ""
+	if col.Default != "" {
+		defaultValue = fmt.Sprintf(" DEFAULT %s", col.Default)
+	}
+
+	mask := ""
+	if col.Mask != nil {
+		mask = " " + col.Mask.serialize()
+	}
+
 	comment := ""
 	if col.Comment != "" {
 		comment = fmt.Sprintf(" COMMENT '%s'", parseComment(col.Comment))
 	}
-	return fmt.Sprintf("%s %s%s%s", col.getWrappedColumnName(), col.Type, notNull, comment) // id INT NOT NULL COMMENT 'something'
+	return fmt.Sprintf("%s %s%s%s%s%s%s", col.getWrappedColumnName(), col.Type, collation, notNull, defaultValue, mask, comment) // id INT NOT NULL DEFAULT 0 MASK f COMMENT 'something'
 }
 
 func (ti *SqlTableInfo) serializeColumnInfos() string {
@@ -189,46 +613,168 @@ func (ti *SqlTableInfo) serializeColumnInfos() string {
 	for i, col := range ti.ColumnInfos {
 		columnFragments[i] = ti.serializeColumnInfo(col)
 	}
+	if !ti.isDefinedByQuery() {
+		columnFragments = append(columnFragments, ti.serializeConstraints()...)
+	}
 	return strings.Join(columnFragments[:], ", ") // id INT NOT NULL, name STRING, age INT
 }
 
+// Wrapping each part of the name with backticks, unless the name is already quoted.
+func getWrappedFullName(name string) string {
+	if strings.Contains(name, "`") {
+		return name
+	}
+	return quoteSQLIdentifiers(strings.Split(name, "."), ".")
+}
+
+func getWrappedColumnNames(columns []string) string {
+	return quoteSQLIdentifiers(columns, ", ")
+}
+
+func (pk SqlPrimaryKeyInfo) serialize() string {
+	// CONSTRAINT `pk` PRIMARY KEY (`id`)
+	return fmt.Sprintf("CONSTRAINT %s PRIMARY KEY (%s)", QuoteSQLIdentifier(pk.Name), getWrappedColumnNames(pk.Columns))
+}
+
+func (fk SqlForeignKeyInfo) serialize() string {
+	// CONSTRAINT `fk` FOREIGN KEY (`parent_id`) REFERENCES `main`.`foo`.`parent` (`id`)
+	return fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", QuoteSQLIdentifier(fk.Name),
+		getWrappedColumnNames(fk.Columns), getWrappedFullName(fk.ParentTable), getWrappedColumnNames(fk.ParentColumns))
+}
+
+func (c SqlCheckConstraintInfo) serialize() string {
+	// CONSTRAINT `valid_id` CHECK (id > 0)
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", QuoteSQLIdentifier(c.Name), c.Expression)
+}
+
+func (ti *SqlTableInfo) serializeConstraints() []string {
+	constraints := make([]string, 0, len(ti.ForeignKeys)+1)
+	if ti.PrimaryKey != nil {
+		constraints = append(constraints, ti.PrimaryKey.serialize())
+	}
+	for _, fk := range ti.ForeignKeys {
+		constraints = append(constraints, fk.serialize())
+	}
+	return constraints
+}
+
 func (ti *SqlTableInfo) serializeProperties() string {
-	propsMap := make([]string, 0, len(ti.Properties))
-	for key, value := range ti.Properties {
-		propsMap = append(propsMap, fmt.Sprintf("'%s'='%s'", key, value))
+	return serializeProperties(ti.Properties)
+}
+
+func serializeProperties(properties map[string]string) string {
+	propsMap := make([]string, 0, len(properties))
+	for key, value := range properties {
+		propsMap = append(propsMap, QuoteSQLString(key)+"="+QuoteSQLString(value))
 	}
+	slices.Sort(propsMap)
 	return strings.Join(propsMap[:], ", ") // 'foo'='bar', 'this'='that'
 }
 
+func (ti *SqlTableInfo) hasColumnDefaults() bool {
+	return slices.ContainsFunc(ti.ColumnInfos, func(ci SqlColumnInfo) bool {
+		return ci.Default != ""
+	})
+}
+
+// getCreateProperties returns table properties including the table features required by the table definition
+func (ti *SqlTableInfo) getCreateProperties() map[string]string {
+	if ti.isDefinedByQuery() {
+		return ti.Properties
+	}
+	properties := map[string]string{}
+	if ti.hasColumnDefaults() {
+		properties[allowColumnDefaultsProperty] = "supported"
+	}
+	if ti.UniformIceberg {
+		properties[icebergCompatProperty] = "true"
+		properties[universalFormatProperty] = "iceberg"
+	}
+	if len(properties) == 0 {
+		return ti.Properties
+	}
+	// explicitly specified properties take precedence
+	for k, v := range ti.Properties {
+		properties[k] = v
+	}
+	return properties
+}
+
 func (ti *SqlTableInfo) serializeOptions() string {
-	optionsMap := make([]string, 0, len(ti.Options))
-	for key, value := range ti.Options {
-		optionsMap = append(optionsMap, fmt.Sprintf("'%s'='%s'", key, value))
+	return serializeProperties(ti.Options)
+}
+
+// Options, that define the data source of the table and could only be changed by recreating it
+var immutableTableOptions = map[string]bool{
+	"path":    true,
+	"url":     true,
+	"dbtable": true,
+	"query":   true,
+}
+
+// optionsRequireRecreation returns true, if options could not be changed with ALTER TABLE ... SET OPTIONS,
+// i.e. when an option is removed or one of the immutable options is changed
+func optionsRequireRecreation(oldOptions, newOptions map[string]any) bool {
+	for k, oldValue := range oldOptions {
+		newValue, ok := newOptions[k]
+		if !ok || (oldValue != newValue && immutableTableOptions[strings.ToLower(k)]) {
+			return true
+		}
 	}
-	return strings.Join(optionsMap[:], ", ") // 'foo'='bar', 'this'='that'
+	for k := range newOptions {
+		if _, ok := oldOptions[k]; !ok && immutableTableOptions[strings.ToLower(k)] {
+			return true
+		}
+	}
+	return false
 }
 
 func (ti *SqlTableInfo) buildLocationStatement() string {
 	statements := make([]string, 0, 10)
-	statements = append(statements, fmt.Sprintf("LOCATION '%s'", ti.StorageLocation)) // LOCATION '/mnt/csv_files'
+	statements = append(statements, fmt.Sprintf("LOCATION %s", QuoteSQLString(ti.StorageLocation))) // LOCATION '/mnt/csv_files'
 
 	if ti.StorageCredentialName != "" {
-		statements = append(statements, fmt.Sprintf(" WITH (CREDENTIAL `%s`)", ti.StorageCredentialName))
+		statements = append(statements, fmt.Sprintf(" WITH (CREDENTIAL %s)", QuoteSQLIdentifier(ti.StorageCredentialName)))
 	}
 	return strings.Join(statements, "")
 }
 
 func (ti *SqlTableInfo) getTableTypeString() string {
-	if ti.TableType == "VIEW" {
+	switch ti.TableType {
+	case "VIEW":
 		return "VIEW"
+	case "MATERIALIZED_VIEW":
+		return "MATERIALIZED VIEW"
+	case "STREAMING_TABLE":
+		return "STREAMING TABLE"
 	}
 	return "TABLE"
 }
 
+// isDefinedByQuery returns true for views, materialized views and streaming tables, that are created from a query
+func (ti *SqlTableInfo) isDefinedByQuery() bool {
+	return ti.TableType == "VIEW" || ti.TableType == "MATERIALIZED_VIEW" || ti.TableType == "STREAMING_TABLE"
+}
+
+// buildTableCloneStatement returns the statement creating the table as a clone of the source table.
+// Columns, partitioning and comment are copied from the source table.
+func (ti *SqlTableInfo) buildTableCloneStatement() string {
+	statements := make([]string, 0, 4)
+	statements = append(statements, fmt.Sprintf("CREATE TABLE %s %s", ti.SQLFullName(), ti.Clone.serialize()))
+	if properties := ti.getCreateProperties(); len(properties) > 0 {
+		statements = append(statements, fmt.Sprintf("\nTBLPROPERTIES (%s)", serializeProperties(properties))) // TBLPROPERTIES ('foo'='bar')
+	}
+	if ti.StorageLocation != "" {
+		statements = append(statements, "\n"+ti.buildLocationStatement())
+	}
+	statements = append(statements, ";")
+	return strings.Join(statements, "")
+}
+
 func (ti *SqlTableInfo) buildTableCreateStatement() string {
 	statements := make([]string, 0, 10)
 
-	isView := ti.TableType == "VIEW"
+	isDefinedByQuery := ti.isDefinedByQuery()
 
 	externalFragment := ""
 	if ti.TableType == "EXTERNAL" {
@@ -243,14 +789,22 @@ func (ti *SqlTableInfo) buildTableCreateStatement() string {
 		statements = append(statements, fmt.Sprintf(" (%s)", ti.serializeColumnInfos()))
 	}
 
-	if !isView {
+	if ti.TableType == "VIEW" && ti.SchemaBinding != "" {
+		statements = append(statements, fmt.Sprintf("\nWITH SCHEMA %s", ti.SchemaBinding)) // WITH SCHEMA EVOLUTION
+	}
+
+	if !isDefinedByQuery {
 		if ti.DataSourceFormat != "" {
 			statements = append(statements, fmt.Sprintf("\nUSING %s", ti.DataSourceFormat)) // USING CSV
 		}
 	}
 
+	if ti.Collation != "" {
+		statements = append(statements, fmt.Sprintf("\nDEFAULT COLLATION %s", ti.Collation)) // DEFAULT COLLATION UTF8_LCASE
+	}
+
 	if len(ti.Partitions) > 0 {
-		statements = append(statements, fmt.Sprintf("\nPARTITIONED BY (%s)", strings.Join(ti.Partitions, ", "))) // PARTITIONED BY (university, major)
+		statements = append(statements, fmt.Sprintf("\nPARTITIONED BY (%s)", ti.getWrappedPartitions())) // PARTITIONED BY (`university`, days(`created_at`))
 	}
 
 	if len(ti.ClusterKeys) > 0 {
@@ -261,18 +815,25 @@ func (ti *SqlTableInfo) buildTableCreateStatement() string {
 		statements = append(statements, fmt.Sprintf("\nCOMMENT '%s'", parseComment(ti.Comment))) // COMMENT 'this is a comment'
 	}
 
-	if len(ti.Properties) > 0 {
-		statements = append(statements, fmt.Sprintf("\nTBLPROPERTIES (%s)", ti.serializeProperties())) // TBLPROPERTIES ('foo'='bar')
+	if properties := ti.getCreateProperties(); len(properties) > 0 {
+		statements = append(statements, fmt.Sprintf("\nTBLPROPERTIES (%s)", serializeProperties(properties))) // TBLPROPERTIES ('foo'='bar')
 	}
 
 	if len(ti.Options) > 0 {
 		statements = append(statements, fmt.Sprintf("\nOPTIONS (%s)", ti.serializeOptions())) // OPTIONS ('foo'='bar')
 	}
 
-	if !isView {
+	if ti.Schedule != nil {
+		statements = append(statements, "\n"+ti.Schedule.serialize())
+	}
+
+	if !isDefinedByQuery {
 		if ti.StorageLocation != "" {
 			statements = append(statements, "\n"+ti.buildLocationStatement())
 		}
+		if ti.AsSelect != "" {
+			statements = append(statements, fmt.Sprintf("\nAS %s", ti.AsSelect)) // AS SELECT * FROM main.foo.bar
+		}
 	} else {
 		statements = append(statements, fmt.Sprintf("\nAS %s", ti.ViewDefinition))
 	}
@@ -284,12 +845,60 @@ func (ti *SqlTableInfo) buildTableCreateStatement() string {
 
 // Wrapping the column name with backticks to avoid special character messing things up.
 func (ci SqlColumnInfo) getWrappedColumnName() string {
-	return fmt.Sprintf("`%s`", ci.Name)
+	return QuoteSQLIdentifier(ci.Name)
 }
 
 // Wrapping column name with backticks to avoid special character messing things up.
 func (ti *SqlTableInfo) getWrappedClusterKeys() string {
-	return "`" + strings.Join(ti.ClusterKeys, "`,`") + "`"
+	return quoteSQLIdentifiers(ti.ClusterKeys, ",")
+}
+
+var partitionTransformRegex = regexp.MustCompile(`^(\w+)\s*\((.*)\)$`)
+var partitionTransformLiteralRegex = regexp.MustCompile(`^\d+$`)
+
+func wrapPartitionColumn(name string) string {
+	if strings.HasPrefix(name, "`") && strings.HasSuffix(name, "`") {
+		return name
+	}
+	return QuoteSQLIdentifier(name)
+}
+
+// serializePartition wraps column names of a partition with backticks. Besides plain column names,
+// partition transforms like `days(event_time)` or `bucket(16, id)` are supported, where numeric
+// arguments are kept as they are.
+func serializePartition(partition string) (string, error) {
+	partition = strings.TrimSpace(partition)
+	if match := partitionTransformRegex.FindStringSubmatch(partition); match != nil {
+		args := strings.Split(match[2], ",")
+		for i, arg := range args {
+			arg = strings.TrimSpace(arg)
+			if arg == "" || strings.ContainsAny(arg, "()") {
+				return "", fmt.Errorf("invalid partition transform: %s", partition)
+			}
+			if !partitionTransformLiteralRegex.MatchString(arg) {
+				arg = wrapPartitionColumn(arg)
+			}
+			args[i] = arg
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToLower(match[1]), strings.Join(args, ", ")), nil
+	}
+	if partition == "" || strings.ContainsAny(partition, "()") {
+		return "", fmt.Errorf("invalid partition: %s", partition)
+	}
+	return wrapPartitionColumn(partition), nil
+}
+
+func (ti *SqlTableInfo) getWrappedPartitions() string {
+	partitions := make([]string, 0, len(ti.Partitions))
+	for _, p := range ti.Partitions {
+		serialized, err := serializePartition(p)
+		if err != nil {
+			// invalid partitions are rejected during plan
+			serialized = p
+		}
+		partitions = append(partitions, serialized)
+	}
+	return strings.Join(partitions, ", ")
 }
 
 func (ti *SqlTableInfo) getStatementsForColumnDiffs(oldti *SqlTableInfo, statements []string, typestring string) []string {
@@ -333,7 +942,7 @@ func (ti *SqlTableInfo) addOrRemoveColumnStatements(oldti *SqlTableInfo, stateme
 				statements = append(statements, fmt.Sprintf("ALTER %s %s ADD COLUMN %s FIRST", typestring, ti.SQLFullName(), newCiStatement))
 			} else {
 				// Find out the name of the column before this column and add after the previous one.
-				statements = append(statements, fmt.Sprintf("ALTER %s %s ADD COLUMN %s AFTER %s", typestring, ti.SQLFullName(), newCiStatement, ti.ColumnInfos[i-1].Name))
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ADD COLUMN %s AFTER %s", typestring, ti.SQLFullName(), newCiStatement, ti.ColumnInfos[i-1].getWrappedColumnName()))
 			}
 		}
 	}
@@ -342,11 +951,29 @@ func (ti *SqlTableInfo) addOrRemoveColumnStatements(oldti *SqlTableInfo, stateme
 }
 
 func (ti *SqlTableInfo) alterExistingColumnStatements(oldti *SqlTableInfo, statements []string, typestring string) []string {
+	oldColumns := oldti.ColumnInfos
+	reordered := isColumnReorder(columnNames(oldti.ColumnInfos), columnNames(ti.ColumnInfos))
+	if reordered {
+		// the same columns are compared by name instead of by position
+		oldColumns = alignColumns(oldti.ColumnInfos, ti.ColumnInfos)
+	}
 	for i, ci := range ti.ColumnInfos {
-		oldCi := oldti.ColumnInfos[i]
+		oldCi := oldColumns[i]
 		if ci.Name != oldCi.Name {
 			statements = append(statements, fmt.Sprintf("ALTER %s %s RENAME COLUMN %s to %s", typestring, ti.SQLFullName(), oldCi.getWrappedColumnName(), ci.getWrappedColumnName()))
 		}
+		typeChanged := ci.Type != "" && getColumnType(ci.Type) != getColumnType(oldCi.Type)
+		collationChanged := ci.Collation != "" && !strings.EqualFold(ci.Collation, oldCi.Collation)
+		if typeChanged || collationChanged {
+			columnType := ci.Type
+			if columnType == "" {
+				columnType = oldCi.Type
+			}
+			if ci.Collation != "" {
+				columnType += " COLLATE " + ci.Collation
+			}
+			statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s TYPE %s", typestring, ti.SQLFullName(), ci.getWrappedColumnName(), columnType))
+		}
 		if ci.Comment != oldCi.Comment {
 			statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s COMMENT '%s'", typestring, ti.SQLFullName(), ci.getWrappedColumnName(), parseComment(ci.Comment)))
 		}
@@ -359,32 +986,178 @@ func (ti *SqlTableInfo) alterExistingColumnStatements(oldti *SqlTableInfo, state
 			}
 			statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s %s NOT NULL", typestring, ti.SQLFullName(), ci.getWrappedColumnName(), keyWord))
 		}
+		if ci.Default != oldCi.Default {
+			if ci.Default == "" {
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s DROP DEFAULT", typestring, ti.SQLFullName(), ci.getWrappedColumnName()))
+			} else {
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s SET DEFAULT %s", typestring, ti.SQLFullName(), ci.getWrappedColumnName(), ci.Default))
+			}
+		}
+		if !ci.Mask.equal(oldCi.Mask) {
+			if ci.Mask == nil {
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s DROP MASK", typestring, ti.SQLFullName(), ci.getWrappedColumnName()))
+			} else {
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER COLUMN %s SET %s", typestring, ti.SQLFullName(), ci.getWrappedColumnName(), ci.Mask.serialize()))
+			}
+		}
+	}
+	if reordered && !ti.isDefinedByQuery() {
+		statements = append(statements, ti.reorderColumnStatements(oldti)...)
+	}
+	return statements
+}
+
+func columnNames(columns []SqlColumnInfo) []string {
+	names := make([]string, len(columns))
+	for i, ci := range columns {
+		names[i] = ci.Name
+	}
+	return names
+}
+
+// isColumnReorder checks, if the same columns are only listed in a different order
+func isColumnReorder(oldNames, newNames []string) bool {
+	if len(oldNames) != len(newNames) || slices.Equal(oldNames, newNames) {
+		return false
+	}
+	sortedOld, sortedNew := slices.Clone(oldNames), slices.Clone(newNames)
+	slices.Sort(sortedOld)
+	slices.Sort(sortedNew)
+	return slices.Equal(sortedOld, sortedNew)
+}
+
+// alignColumns returns the old columns in the order of the new columns with the same names
+func alignColumns(oldColumns, newColumns []SqlColumnInfo) []SqlColumnInfo {
+	byName := map[string]SqlColumnInfo{}
+	for _, ci := range oldColumns {
+		byName[ci.Name] = ci
+	}
+	aligned := make([]SqlColumnInfo, len(newColumns))
+	for i, ci := range newColumns {
+		aligned[i] = byName[ci.Name]
+	}
+	return aligned
+}
+
+// checkColumnReorder fails, if columns of a Delta table are reordered without column mapping. Enabling column
+// mapping upgrades the Delta protocol, that older readers and Delta Sharing recipients may not support, so it's
+// never enabled implicitly.
+func (ti *SqlTableInfo) checkColumnReorder(oldti *SqlTableInfo) error {
+	if ti.isDefinedByQuery() || !isDeltaFormat(ti.DataSourceFormat) ||
+		!isColumnReorder(columnNames(oldti.ColumnInfos), columnNames(ti.ColumnInfos)) {
+		return nil
+	}
+	if mode := oldti.EffectiveProperties[columnMappingModeProperty]; mode != "" && mode != "none" {
+		return nil
+	}
+	if mode := ti.Properties[columnMappingModeProperty]; mode != "" && mode != "none" {
+		// column mapping is enabled by the properties before the columns are moved
+		return nil
+	}
+	return fmt.Errorf("columns of %s could only be reordered with column mapping. Set %s = \"name\" in properties "+
+		"first, it upgrades the Delta protocol of the table", ti.SQLFullName(), columnMappingModeProperty)
+}
+
+// reorderColumnStatements moves columns to the positions, in which they're configured, with ALTER COLUMN FIRST
+// or AFTER. Columns of Delta tables could only be moved once column mapping is enabled, see checkColumnReorder.
+func (ti *SqlTableInfo) reorderColumnStatements(oldti *SqlTableInfo) []string {
+	var statements []string
+	current := columnNames(oldti.ColumnInfos)
+	for i, ci := range ti.ColumnInfos {
+		if current[i] == ci.Name {
+			continue
+		}
+		position := "FIRST"
+		if i > 0 {
+			position = "AFTER " + ti.ColumnInfos[i-1].getWrappedColumnName()
+		}
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s", ti.SQLFullName(), ci.getWrappedColumnName(), position))
+		// move the column in the current order, so that the following columns are compared with the result
+		current = slices.Insert(slices.DeleteFunc(current, func(name string) bool { return name == ci.Name }), i, ci.Name)
 	}
 	return statements
 }
 
 func (ti *SqlTableInfo) diff(oldti *SqlTableInfo) ([]string, error) {
+	if err := ti.checkColumnReorder(oldti); err != nil {
+		return nil, err
+	}
 	statements := make([]string, 0)
 	typestring := ti.getTableTypeString()
 
+	replacedView := false
 	if ti.TableType == "VIEW" {
 		// View only attributes
-		if ti.ViewDefinition != oldti.ViewDefinition {
+		if ti.SchemaBinding != oldti.SchemaBinding || !strings.EqualFold(ti.Collation, oldti.Collation) {
+			// the schema binding and the default collation could only be changed by replacing the view,
+			// that also sets its query and comment
+			replacedView = true
+			statements = append(statements, strings.Replace(ti.buildTableCreateStatement(), "CREATE ", "CREATE OR REPLACE ", 1))
+		} else if ti.ViewDefinition != oldti.ViewDefinition {
 			statements = append(statements, fmt.Sprintf("ALTER VIEW %s AS %s", ti.SQLFullName(), ti.ViewDefinition))
 		}
+	} else if ti.TableType == "STREAMING_TABLE" && ti.ViewDefinition != oldti.ViewDefinition {
+		// Redefining a streaming table also sets its schedule. Columns are derived from the new query.
+		redefined := *ti
+		redefined.ColumnInfos = nil
+		statements = append(statements, strings.Replace(redefined.buildTableCreateStatement(), "CREATE ", "CREATE OR REFRESH ", 1))
+		if ti.FullRefreshOnQueryChange {
+			statements = append(statements, fmt.Sprintf("REFRESH STREAMING TABLE %s FULL", ti.SQLFullName()))
+		}
+	} else if ti.TableType == "MATERIALIZED_VIEW" || ti.TableType == "STREAMING_TABLE" {
+		// Refresh schedule of materialized views and streaming tables
+		if !reflect.DeepEqual(ti.Schedule, oldti.Schedule) {
+			switch {
+			case ti.Schedule == nil:
+				statements = append(statements, fmt.Sprintf("ALTER %s %s DROP SCHEDULE", typestring, ti.SQLFullName()))
+			case oldti.Schedule == nil:
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ADD %s", typestring, ti.SQLFullName(), ti.Schedule.serialize()))
+			default:
+				statements = append(statements, fmt.Sprintf("ALTER %s %s ALTER %s", typestring, ti.SQLFullName(), ti.Schedule.serialize()))
+			}
+		}
 	} else {
 		// Table only attributes
-		if ti.StorageLocation != oldti.StorageLocation {
+		convertedToManaged := oldti.TableType == "EXTERNAL" && ti.TableType == "MANAGED"
+		if convertedToManaged {
+			// data is copied to the managed storage, while files at the external location are kept
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET MANAGED", ti.SQLFullName()))
+		}
+		if ti.StorageLocation != oldti.StorageLocation && !convertedToManaged {
 			statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET %s", ti.SQLFullName(), ti.buildLocationStatement()))
 		}
+		// partitioned tables are migrated to liquid clustering by rewriteStatements
 		equal := slices.Equal(ti.ClusterKeys, oldti.ClusterKeys)
-		if !equal {
+		if !equal && len(ti.ClusterKeys) == 0 {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s CLUSTER BY NONE", ti.SQLFullName()))
+		} else if !equal {
 			statements = append(statements, fmt.Sprintf("ALTER TABLE %s CLUSTER BY (%s)", ti.SQLFullName(), ti.getWrappedClusterKeys()))
 		}
+		// the default collation only applies to columns added afterwards
+		if !strings.EqualFold(ti.Collation, oldti.Collation) {
+			collation := ti.Collation
+			if collation == "" {
+				collation = "UTF8_BINARY"
+			}
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s DEFAULT COLLATION %s", ti.SQLFullName(), collation))
+		}
+		// removed and immutable options force recreation of the table
+		if len(ti.Options) > 0 && !reflect.DeepEqual(ti.Options, oldti.Options) {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET OPTIONS (%s)", ti.SQLFullName(), ti.serializeOptions()))
+		}
+	}
+
+	var addConstraints, createIndexes []string
+	if !ti.isDefinedByQuery() {
+		var dropConstraints, dropIndexes []string
+		dropConstraints, addConstraints = ti.getConstraintDiffs(oldti)
+		statements = append(statements, dropConstraints...)
+		dropIndexes, createIndexes = ti.getBloomFilterIndexDiffs(oldti)
+		statements = append(statements, dropIndexes...)
 	}
 
 	// Attributes common to both views and tables
-	if ti.Comment != oldti.Comment {
+	if ti.Comment != oldti.Comment && !replacedView {
 		statements = append(statements, fmt.Sprintf("COMMENT ON %s %s IS '%s'", typestring, ti.SQLFullName(), parseComment(ti.Comment)))
 	}
 
@@ -393,7 +1166,7 @@ func (ti *SqlTableInfo) diff(oldti *SqlTableInfo) ([]string, error) {
 		removeProps := make([]string, 0)
 		for key := range oldti.Properties {
 			if _, ok := ti.Properties[key]; !ok {
-				removeProps = append(removeProps, key)
+				removeProps = append(removeProps, QuoteSQLString(key))
 			}
 		}
 		if len(removeProps) > 0 {
@@ -403,51 +1176,497 @@ func (ti *SqlTableInfo) diff(oldti *SqlTableInfo) ([]string, error) {
 		statements = append(statements, fmt.Sprintf("ALTER %s %s SET TBLPROPERTIES (%s)", typestring, ti.SQLFullName(), ti.serializeProperties()))
 	}
 
+	if !ti.isDefinedByQuery() && ti.UniformIceberg != oldti.UniformIceberg {
+		if ti.UniformIceberg {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET TBLPROPERTIES (%s=%s, %s=%s)",
+				ti.SQLFullName(), QuoteSQLString(icebergCompatProperty), QuoteSQLString("true"),
+				QuoteSQLString(universalFormatProperty), QuoteSQLString("iceberg")))
+		} else {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s UNSET TBLPROPERTIES IF EXISTS (%s)",
+				ti.SQLFullName(), QuoteSQLString(universalFormatProperty)))
+		}
+	}
+
+	// Column defaults require a table feature, that has to be enabled before defaults are set
+	if !ti.isDefinedByQuery() && ti.hasColumnDefaults() && oldti.EffectiveProperties[allowColumnDefaultsProperty] != "supported" {
+		if _, ok := ti.Properties[allowColumnDefaultsProperty]; !ok {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET TBLPROPERTIES (%s=%s)", ti.SQLFullName(),
+				QuoteSQLString(allowColumnDefaultsProperty), QuoteSQLString("supported")))
+		}
+	}
+
 	statements = ti.getStatementsForColumnDiffs(oldti, statements, typestring)
 
+	// Constraints and indexes are added after columns, as they may reference newly added columns
+	statements = append(statements, addConstraints...)
+	statements = append(statements, createIndexes...)
+
+	// Tags are updated last, as new columns have to exist before they could be tagged
+	statements = append(statements, ti.getTagDiffs(oldti)...)
+
 	return statements, nil
 }
 
-func (ti *SqlTableInfo) updateTable(oldti *SqlTableInfo) error {
-	statements, err := ti.diff(oldti)
-	if err != nil {
-		return err
+// getConstraintDiffs returns statements to drop removed or changed constraints and
+// statements to add new or changed ones
+func (ti *SqlTableInfo) getConstraintDiffs(oldti *SqlTableInfo) (drop []string, add []string) {
+	dropConstraint := func(name string) {
+		drop = append(drop, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s", ti.SQLFullName(), QuoteSQLIdentifier(name)))
 	}
-	for _, statement := range statements {
-		err = ti.applySql(statement)
-		if err != nil {
-			return err
-		}
+	addConstraint := func(constraint string) {
+		add = append(add, fmt.Sprintf("ALTER TABLE %s ADD %s", ti.SQLFullName(), constraint))
+	}
+	if !reflect.DeepEqual(ti.PrimaryKey, oldti.PrimaryKey) {
+		if oldti.PrimaryKey != nil {
+			dropConstraint(oldti.PrimaryKey.Name)
+		}
+		if ti.PrimaryKey != nil {
+			addConstraint(ti.PrimaryKey.serialize())
+		}
+	}
+	oldChecks := map[string]string{}
+	for _, c := range oldti.CheckConstraints {
+		oldChecks[strings.ToLower(c.Name)] = c.Expression
+	}
+	newChecks := map[string]string{}
+	for _, c := range ti.CheckConstraints {
+		newChecks[strings.ToLower(c.Name)] = c.Expression
+	}
+	for _, c := range oldti.CheckConstraints {
+		if expression, ok := newChecks[strings.ToLower(c.Name)]; !ok || expression != c.Expression {
+			dropConstraint(c.Name)
+		}
+	}
+	for _, c := range ti.CheckConstraints {
+		if expression, ok := oldChecks[strings.ToLower(c.Name)]; !ok || expression != c.Expression {
+			addConstraint(c.serialize())
+		}
+	}
+	oldForeignKeys := map[string]SqlForeignKeyInfo{}
+	for _, fk := range oldti.ForeignKeys {
+		oldForeignKeys[fk.Name] = fk
+	}
+	newForeignKeys := map[string]SqlForeignKeyInfo{}
+	for _, fk := range ti.ForeignKeys {
+		newForeignKeys[fk.Name] = fk
+	}
+	for _, oldFk := range oldti.ForeignKeys {
+		if newFk, ok := newForeignKeys[oldFk.Name]; !ok || !oldFk.equal(newFk) {
+			dropConstraint(oldFk.Name)
+		}
+	}
+	for _, newFk := range ti.ForeignKeys {
+		if oldFk, ok := oldForeignKeys[newFk.Name]; !ok || !oldFk.equal(newFk) {
+			addConstraint(newFk.serialize())
+		}
+	}
+	return
+}
+
+// bloomFilterIndexedColumns returns the options of the bloom filter index for each indexed column
+func (ti *SqlTableInfo) bloomFilterIndexedColumns() map[string]SqlBloomFilterIndex {
+	columns := map[string]SqlBloomFilterIndex{}
+	for _, index := range ti.BloomFilterIndexes {
+		for _, column := range index.Columns {
+			columns[column] = SqlBloomFilterIndex{Columns: []string{column}, Fpp: index.Fpp, NumItems: index.NumItems}
+		}
+	}
+	return columns
+}
+
+// getBloomFilterIndexDiffs returns statements to drop removed or changed bloom filter indexes and
+// statements to create new or changed ones. Indexes are managed per column, as options of an existing
+// index could only be changed by recreating it.
+func (ti *SqlTableInfo) getBloomFilterIndexDiffs(oldti *SqlTableInfo) (drop []string, create []string) {
+	oldColumns := oldti.bloomFilterIndexedColumns()
+	newColumns := ti.bloomFilterIndexedColumns()
+	names := make([]string, 0, len(oldColumns)+len(newColumns))
+	for name := range oldColumns {
+		names = append(names, name)
+	}
+	for name := range newColumns {
+		if _, ok := oldColumns[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		oldIndex, wasIndexed := oldColumns[name]
+		newIndex, isIndexed := newColumns[name]
+		if wasIndexed && isIndexed && oldIndex.Fpp == newIndex.Fpp && oldIndex.NumItems == newIndex.NumItems {
+			continue
+		}
+		column := QuoteSQLIdentifier(name)
+		if wasIndexed {
+			drop = append(drop, fmt.Sprintf("DROP BLOOMFILTER INDEX ON TABLE %s FOR COLUMNS(%s)", ti.SQLFullName(), column))
+		}
+		if isIndexed {
+			create = append(create, fmt.Sprintf("CREATE BLOOMFILTER INDEX ON TABLE %s FOR COLUMNS(%s%s)",
+				ti.SQLFullName(), column, newIndex.serializeColumnOptions()))
+		}
+	}
+	return
+}
+
+// equalObjectNames compares full names of Unity Catalog objects, ignoring case and backticks
+func equalObjectNames(a, b string) bool {
+	return strings.EqualFold(strings.ReplaceAll(a, "`", ""), strings.ReplaceAll(b, "`", ""))
+}
+
+func (fk SqlForeignKeyInfo) equal(other SqlForeignKeyInfo) bool {
+	return slices.Equal(fk.Columns, other.Columns) &&
+		equalObjectNames(fk.ParentTable, other.ParentTable) &&
+		slices.Equal(fk.ParentColumns, other.ParentColumns)
+}
+
+func serializeTags(tags map[string]string) string {
+	tagsList := make([]string, 0, len(tags))
+	for key, value := range tags {
+		tagsList = append(tagsList, QuoteSQLString(key)+" = "+QuoteSQLString(value))
+	}
+	slices.Sort(tagsList)
+	return strings.Join(tagsList, ", ") // 'foo' = 'bar', 'this' = 'that'
+}
+
+// getTagStatements returns statements to unset removed tags and to set new or changed tags of the securable
+func getTagStatements(alterPrefix string, oldTags, newTags map[string]string) []string {
+	statements := make([]string, 0)
+	removeTags := make([]string, 0)
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removeTags = append(removeTags, QuoteSQLString(key))
+		}
+	}
+	if len(removeTags) > 0 {
+		slices.Sort(removeTags)
+		statements = append(statements, fmt.Sprintf("%s UNSET TAGS (%s)", alterPrefix, strings.Join(removeTags, ", ")))
+	}
+	setTags := make(map[string]string)
+	for key, value := range newTags {
+		if oldValue, ok := oldTags[key]; !ok || oldValue != value {
+			setTags[key] = value
+		}
+	}
+	if len(setTags) > 0 {
+		statements = append(statements, fmt.Sprintf("%s SET TAGS (%s)", alterPrefix, serializeTags(setTags)))
+	}
+	return statements
+}
+
+// getTagDiffs returns statements to update tags of the table and its columns
+func (ti *SqlTableInfo) getTagDiffs(oldti *SqlTableInfo) []string {
+	typestring := ti.getTableTypeString()
+	statements := getTagStatements(fmt.Sprintf("ALTER %s %s", typestring, ti.SQLFullName()), oldti.Tags, ti.Tags)
+	oldColumnTags := make(map[string]map[string]string)
+	for _, ci := range oldti.ColumnInfos {
+		oldColumnTags[ci.Name] = ci.Tags
+	}
+	for _, ci := range ti.ColumnInfos {
+		statements = append(statements, getTagStatements(fmt.Sprintf("ALTER %s %s ALTER COLUMN %s", typestring, ti.SQLFullName(), ci.getWrappedColumnName()),
+			oldColumnTags[ci.Name], ci.Tags)...)
+	}
+	return statements
+}
+
+// hasTags returns true if the table or any of its columns have tags in the given state values
+func hasTags(tags any, columns any) bool {
+	if m, ok := tags.(map[string]any); ok && len(m) > 0 {
+		return true
+	}
+	cols, _ := columns.([]any)
+	for _, col := range cols {
+		colMap, ok := col.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m, ok := colMap["tags"].(map[string]any); ok && len(m) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func tagsFromState(v any) map[string]string {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) == 0 {
+		return nil
+	}
+	tags := make(map[string]string, len(m))
+	for key, value := range m {
+		tags[key] = value.(string)
+	}
+	return tags
+}
+
+// setTagsFromState copies table & column tags from the state, as they could only be read through a SQL warehouse
+func (ti *SqlTableInfo) setTagsFromState(tags any, columns any) {
+	ti.Tags = tagsFromState(tags)
+	columnTags := make(map[string]map[string]string)
+	cols, _ := columns.([]any)
+	for _, col := range cols {
+		colMap, ok := col.(map[string]any)
+		if !ok {
+			continue
+		}
+		columnTags[colMap["name"].(string)] = tagsFromState(colMap["tags"])
+	}
+	for i := range ti.ColumnInfos {
+		ti.ColumnInfos[i].Tags = columnTags[ti.ColumnInfos[i].Name]
+	}
+}
+
+// readTags reads table & column tags from the information schema, as they aren't returned by the Tables API
+func (ti *SqlTableInfo) readTags(ctx context.Context) error {
+	parameters := []sql.StatementParameterListItem{
+		{Name: "schema", Value: ti.SchemaName},
+		{Name: "table", Value: ti.Name},
+	}
+	tableTags, err := ti.querySql(ctx, fmt.Sprintf("SELECT tag_name, tag_value FROM %s.information_schema.table_tags "+
+		"WHERE schema_name = :schema AND table_name = :table", QuoteSQLIdentifier(ti.CatalogName)), parameters)
+	if err != nil {
+		return fmt.Errorf("cannot read tags of %s: %w", ti.FullName(), err)
+	}
+	ti.Tags = nil
+	for _, row := range tableTags {
+		if len(row) != 2 {
+			continue
+		}
+		if ti.Tags == nil {
+			ti.Tags = make(map[string]string)
+		}
+		ti.Tags[row[0]] = row[1]
+	}
+	columnTags, err := ti.querySql(ctx, fmt.Sprintf("SELECT column_name, tag_name, tag_value FROM %s.information_schema.column_tags "+
+		"WHERE schema_name = :schema AND table_name = :table", QuoteSQLIdentifier(ti.CatalogName)), parameters)
+	if err != nil {
+		return fmt.Errorf("cannot read column tags of %s: %w", ti.FullName(), err)
+	}
+	tagsByColumn := make(map[string]map[string]string)
+	for _, row := range columnTags {
+		if len(row) != 3 {
+			continue
+		}
+		if tagsByColumn[row[0]] == nil {
+			tagsByColumn[row[0]] = make(map[string]string)
+		}
+		tagsByColumn[row[0]][row[1]] = row[2]
+	}
+	for i := range ti.ColumnInfos {
+		ti.ColumnInfos[i].Tags = tagsByColumn[ti.ColumnInfos[i].Name]
 	}
 	return nil
 }
 
-func (ti *SqlTableInfo) createTable() error {
-	return ti.applySql(ti.buildTableCreateStatement())
+// loadTags sets tags of the table read from the Tables API. Tags are read from the information schema
+// if a SQL warehouse is configured, otherwise the values from the state are kept.
+func (ti *SqlTableInfo) loadTags(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, tags any, columns any) error {
+	wi, ok := d.GetOk("warehouse_id")
+	if !ok {
+		log.Printf("[DEBUG] Tags of %s could only be read with warehouse_id, keeping values from the state", ti.FullName())
+		ti.setTagsFromState(tags, columns)
+		return nil
+	}
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	ti.WarehouseID = wi.(string)
+	ti.sqlExec = w.StatementExecution
+	return ti.readTags(ctx)
 }
 
-func (ti *SqlTableInfo) deleteTable() error {
-	return ti.applySql(fmt.Sprintf("DROP %s %s", ti.getTableTypeString(), ti.SQLFullName()))
+func (ti *SqlTableInfo) updateTable(ctx context.Context, oldti *SqlTableInfo) error {
+	statements, err := ti.diff(oldti)
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		err = ti.applySql(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (ti *SqlTableInfo) applySql(sqlQuery string) error {
-	log.Printf("[INFO] Executing Sql: %s", sqlQuery)
-	if ti.WarehouseID != "" {
-		execCtx, cancel := context.WithTimeout(context.Background(), time.Duration(MaxSqlExecWaitTimeout)*time.Second)
-		defer cancel()
-		sqlRes, err := ti.sqlExec.ExecuteStatement(execCtx, sql.ExecuteStatementRequest{
-			Statement:     sqlQuery,
-			WaitTimeout:   fmt.Sprintf("%ds", MaxSqlExecWaitTimeout), //max allowed by sql exec
-			WarehouseId:   ti.WarehouseID,
-			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
-		})
+func (ti *SqlTableInfo) replaceTable(ctx context.Context, oldti *SqlTableInfo) error {
+	statements, err := ti.replaceStatements(oldti)
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		if err := ti.applySql(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ti *SqlTableInfo) createTable(ctx context.Context) error {
+	statements, err := ti.createStatements()
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		if err := ti.applySql(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createStatements returns statements, that are executed to create the table
+func (ti *SqlTableInfo) createStatements() ([]string, error) {
+	return ti.buildCreateStatements(false, &SqlTableInfo{})
+}
+
+// replaceStatements returns statements, that replace the existing table with CREATE OR REPLACE.
+// Unlike DROP and CREATE, this keeps history of Delta tables and grants.
+func (ti *SqlTableInfo) replaceStatements(oldti *SqlTableInfo) ([]string, error) {
+	return ti.buildCreateStatements(true, oldti)
+}
+
+func (ti *SqlTableInfo) buildCreateStatements(replace bool, oldti *SqlTableInfo) ([]string, error) {
+	statements := []string{ti.buildTableCreateStatement()}
+	if ti.Clone != nil {
+		if len(ti.ColumnInfos) > 0 {
+			return nil, fmt.Errorf("column blocks can't be specified together with clone, columns are copied from %s", ti.Clone.SourceTable)
+		}
+		statements = []string{ti.buildTableCloneStatement()}
+		if ti.Comment != "" {
+			statements = append(statements, fmt.Sprintf("COMMENT ON TABLE %s IS '%s'", ti.SQLFullName(), parseComment(ti.Comment)))
+		}
+	}
+	// CHECK constraints could only be added to an existing table
+	for _, c := range ti.CheckConstraints {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD %s", ti.SQLFullName(), c.serialize()))
+	}
+	_, createIndexes := ti.getBloomFilterIndexDiffs(&SqlTableInfo{})
+	statements = append(statements, createIndexes...)
+	if replace {
+		statements[0] = strings.Replace(statements[0], "CREATE ", "CREATE OR REPLACE ", 1)
+	}
+	statements = append(statements, ti.getTagDiffs(oldti)...)
+	return statements, nil
+}
+
+// rewriteStatements replaces the existing table with a table, that is populated with the existing data,
+// so that the data is kept, while the layout of the table changes
+func (ti *SqlTableInfo) rewriteStatements(oldti *SqlTableInfo) ([]string, error) {
+	if ti.TableType != "MANAGED" {
+		return nil, errExternalTableRewrite(ti.FullName())
+	}
+	rewritten := *ti
+	rewritten.AsSelect = fmt.Sprintf("SELECT * FROM %s", ti.SQLFullName())
+	return rewritten.replaceStatements(oldti)
+}
+
+func (ti *SqlTableInfo) rewriteTable(ctx context.Context, oldti *SqlTableInfo) error {
+	statements, err := ti.rewriteStatements(oldti)
+	if err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		if err := ti.applySql(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// noDroppedTableErrors are error classes of UNDROP TABLE, when there is no dropped table with the name
+var noDroppedTableErrors = []string{"TABLE_OR_VIEW_NOT_FOUND", "UC_TABLE_NOT_FOUND", "UNDROP_TABLE_NOT_FOUND"}
+
+func isNoDroppedTable(err error) bool {
+	if apierr.IsMissing(err) {
+		return true
+	}
+	for _, errorClass := range noDroppedTableErrors {
+		if strings.Contains(err.Error(), errorClass) {
+			return true
+		}
+	}
+	return false
+}
+
+// undropTable restores the table, that was dropped within the retention period, and applies the configuration
+// to it. It returns false, if there is no dropped table to restore.
+func (ti *SqlTableInfo) undropTable(ctx context.Context, c *common.DatabricksClient) (bool, error) {
+	if err := ti.applySql(ctx, fmt.Sprintf("UNDROP TABLE %s", ti.SQLFullName())); err != nil {
+		if !isNoDroppedTable(err) {
+			return false, fmt.Errorf("cannot undrop %s: %w", ti.FullName(), err)
+		}
+		log.Printf("[INFO] Creating table %s, as there is no dropped table to restore: %s", ti.FullName(), err)
+		return false, nil
+	}
+	oldti, err := NewSqlTablesAPI(ctx, c).getTable(ti.FullName())
+	if err != nil {
+		return true, err
+	}
+	return true, ti.updateTable(ctx, &oldti)
+}
+
+// updateOwner transfers the ownership of the table to the owner, or to the current user, if the owner isn't specified
+func (ti *SqlTableInfo) updateOwner(ctx context.Context, w *databricks.WorkspaceClient) error {
+	if ti.Owner == "" {
+		currentUser, err := w.CurrentUser.Me(ctx)
 		if err != nil {
 			return err
 		}
-		if sqlRes.Status.State != "SUCCEEDED" {
-			return fmt.Errorf("statement failed to execute: %s", sqlRes.Status.State)
+		ti.Owner = currentUser.UserName
+	}
+	return w.Tables.Update(ctx, catalog.UpdateTableRequest{
+		FullName: ti.FullName(),
+		Owner:    ti.Owner,
+	})
+}
+
+func (ti *SqlTableInfo) deleteTable(ctx context.Context) error {
+	statement := fmt.Sprintf("DROP %s %s", ti.getTableTypeString(), ti.SQLFullName())
+	if ti.PurgeOnDestroy {
+		statement += " PURGE"
+	}
+	return ti.applySql(ctx, statement)
+}
+
+// ExecuteWarehouseStatement executes the statement on the SQL warehouse, starting it if needed. Long-running
+// statements are polled until the deadline of the context, so it should come from the resource timeouts.
+func ExecuteWarehouseStatement(ctx context.Context, w *databricks.WorkspaceClient, warehouseID, statement string) error {
+	exec := &SqlTableInfo{
+		WarehouseID: warehouseID,
+		sqlExec:     w.StatementExecution,
+		warehouses:  w.Warehouses,
+	}
+	return exec.applySql(ctx, statement)
+}
+
+// maxSqlRetries is the number of times a statement is re-submitted after the compute became ready again
+const maxSqlRetries = 2
+
+// applySql executes the statement. If it fails, because the cluster was terminated or the SQL warehouse
+// is still starting, it waits for the compute to become ready and re-submits the statement.
+func (ti *SqlTableInfo) applySql(ctx context.Context, sqlQuery string) error {
+	for attempt := 0; ; attempt++ {
+		err := ti.applySqlOnce(ctx, sqlQuery)
+		if err == nil || attempt >= maxSqlRetries {
+			return err
 		}
-		return nil
+		restarted, waitErr := ti.waitForCompute(ctx)
+		if waitErr != nil {
+			return fmt.Errorf("%w (cannot wait for compute to be ready: %s)", err, waitErr)
+		}
+		if !restarted {
+			return err
+		}
+		log.Printf("[INFO] Re-submitting statement after compute became ready: %s", err)
+	}
+}
+
+func (ti *SqlTableInfo) applySqlOnce(ctx context.Context, sqlQuery string) error {
+	log.Printf("[INFO] Executing Sql: %s", sqlQuery)
+	if ti.WarehouseID != "" {
+		_, err := ti.executeStatement(ctx, sqlQuery, nil)
+		return err
 	}
 
 	r := ti.exec.Execute(ti.ClusterID, "sql", sqlQuery)
@@ -457,13 +1676,139 @@ func (ti *SqlTableInfo) applySql(sqlQuery string) error {
 	return nil
 }
 
-func columnChangesCustomizeDiff(d *schema.ResourceDiff, newTable *SqlTableInfo) error {
+// waitForCompute makes sure that the cluster or the SQL warehouse is running. It returns true, if
+// the compute wasn't ready, so that the failed statement could be re-submitted.
+func (ti *SqlTableInfo) waitForCompute(ctx context.Context) (bool, error) {
+	if ti.WarehouseID != "" {
+		if ti.warehouses == nil {
+			return false, nil
+		}
+		warehouse, err := ti.warehouses.GetById(ctx, ti.WarehouseID)
+		if err != nil {
+			return false, err
+		}
+		switch warehouse.State {
+		case sql.StateRunning:
+			return false, nil
+		case sql.StateStarting:
+			log.Printf("[INFO] Waiting for SQL warehouse %s to start", ti.WarehouseID)
+		default:
+			log.Printf("[INFO] Starting SQL warehouse %s, that is %s", ti.WarehouseID, warehouse.State)
+			_, err = ti.warehouses.Start(ctx, sql.StartRequest{Id: ti.WarehouseID})
+			if err != nil {
+				return false, err
+			}
+		}
+		_, err = ti.warehouses.WaitGetWarehouseRunning(ctx, ti.WarehouseID, sqlExecTimeout(ctx), nil)
+		return err == nil, err
+	}
+	if ti.clustersAPI == nil {
+		return false, nil
+	}
+	cluster, err := ti.clustersAPI.Get(ti.ClusterID)
+	if err != nil {
+		return false, err
+	}
+	if cluster.IsRunningOrResizing() {
+		return false, nil
+	}
+	log.Printf("[INFO] Starting cluster %s, that is %s", ti.ClusterID, cluster.State)
+	_, err = ti.clustersAPI.StartAndGetInfo(ti.ClusterID)
+	return err == nil, err
+}
+
+// querySql executes a query on the SQL warehouse and returns the resulting rows
+func (ti *SqlTableInfo) querySql(ctx context.Context, sqlQuery string, parameters []sql.StatementParameterListItem) ([][]string, error) {
+	log.Printf("[INFO] Executing Sql: %s", sqlQuery)
+	sqlRes, err := ti.executeStatement(ctx, sqlQuery, parameters)
+	if err != nil {
+		return nil, err
+	}
+	if sqlRes.Result == nil {
+		return nil, nil
+	}
+	return sqlRes.Result.DataArray, nil
+}
+
+// executeStatement runs the statement on the SQL warehouse. Statements that don't finish within
+// MaxSqlExecWaitTimeout are polled until the deadline of the context, which comes from the resource timeouts.
+func (ti *SqlTableInfo) executeStatement(ctx context.Context, sqlQuery string, parameters []sql.StatementParameterListItem) (*sql.StatementResponse, error) {
+	sqlRes, err := ti.sqlExec.ExecuteStatement(ctx, sql.ExecuteStatementRequest{
+		Statement:     sqlQuery,
+		Parameters:    parameters,
+		WaitTimeout:   fmt.Sprintf("%ds", MaxSqlExecWaitTimeout), //max allowed by sql exec
+		WarehouseId:   ti.WarehouseID,
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if isStatementInProgress(sqlRes) {
+		statementId := sqlRes.StatementId
+		err = common.WaitForProgress(ctx, sqlExecTimeout(ctx), func(ctx context.Context) (common.Progress, error) {
+			sqlRes, err = ti.sqlExec.GetStatementByStatementId(ctx, statementId)
+			if err != nil {
+				return common.Progress{}, err
+			}
+			if isStatementInProgress(sqlRes) {
+				return common.InProgress("statement %s is %s", statementId, sqlRes.Status.State), nil
+			}
+			return common.Completed(), nil
+		})
+		if err != nil {
+			// don't leave the statement running on the warehouse after the timeout
+			cancelErr := ti.sqlExec.CancelExecution(context.Background(), sql.CancelExecutionRequest{
+				StatementId: statementId,
+			})
+			if cancelErr != nil {
+				log.Printf("[WARN] cannot cancel statement %s: %s", statementId, cancelErr)
+			}
+			return nil, err
+		}
+	}
+	if sqlRes.Status.State != "SUCCEEDED" {
+		if sqlRes.Status.Error != nil && sqlRes.Status.Error.Message != "" {
+			return nil, fmt.Errorf("statement failed to execute: %s: %s", sqlRes.Status.State, sqlRes.Status.Error.Message)
+		}
+		return nil, fmt.Errorf("statement failed to execute: %s", sqlRes.Status.State)
+	}
+	return sqlRes, nil
+}
+
+func isStatementInProgress(res *sql.StatementResponse) bool {
+	return res.Status != nil && (res.Status.State == sql.StatementStatePending || res.Status.State == sql.StatementStateRunning)
+}
+
+// sqlExecTimeout returns the time left until the deadline of the context
+func sqlExecTimeout(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return defaultSqlExecTimeout
+}
+
+func columnChangesCustomizeDiff(d tableChanges, newTable *SqlTableInfo) error {
 	// Using plain type casting for oldCols because DiffToStructPointer does not support old value in the diff.
 	old, _ := d.GetChange("column")
 	oldCols := old.([]interface{})
 	newColumnInfos := newTable.ColumnInfos
 
 	if len(oldCols) == len(newColumnInfos) {
+		oldNames := make([]string, len(oldCols))
+		for i, oldCol := range oldCols {
+			oldNames[i], _ = oldCol.(map[string]any)["name"].(string)
+		}
+		if isColumnReorder(oldNames, columnNames(newColumnInfos)) {
+			// reordered columns are compared by name
+			byName := map[string]any{}
+			for i, oldCol := range oldCols {
+				byName[oldNames[i]] = oldCol
+			}
+			oldCols = make([]any, len(newColumnInfos))
+			for i, ci := range newColumnInfos {
+				oldCols[i] = byName[ci.Name]
+			}
+		}
 		err := assertNoColumnTypeDiff(oldCols, newColumnInfos)
 		if err != nil {
 			return err
@@ -486,9 +1831,26 @@ var columnTypeAliases = map[string]string{
 	"decimal": "decimal(10,0)",
 	"dec":     "decimal(10,0)",
 	"numeric": "decimal(10,0)",
+	// TIMESTAMP is the timestamp with local time zone
+	"timestamp_ltz":                  "timestamp",
+	"timestamp with local time zone": "timestamp",
+	"timestamp without time zone":    "timestamp_ntz",
+	// GEOGRAPHY without SRID is always WGS 84
+	"geography": "geography(4326)",
+	// interval types with the same start and end field
+	"interval year to year":     "interval year",
+	"interval month to month":   "interval month",
+	"interval day to day":       "interval day",
+	"interval hour to hour":     "interval hour",
+	"interval minute to minute": "interval minute",
+	"interval second to second": "interval second",
 }
 
+// getColumnType returns the normalized column type, so that nested types are compared structurally
 func getColumnType(columnType string) string {
+	if normalized, err := normalizeColumnType(columnType); err == nil {
+		return normalized
+	}
 	caseInsensitiveColumnType := strings.ToLower(columnType)
 	if alias, ok := columnTypeAliases[caseInsensitiveColumnType]; ok {
 		return alias
@@ -496,16 +1858,115 @@ func getColumnType(columnType string) string {
 	return caseInsensitiveColumnType
 }
 
+// Type changes that Delta Lake can apply in place with `ALTER COLUMN ... TYPE ...`
+// once type widening is enabled on the table.
+var columnTypeWidenings = map[string][]string{
+	"tinyint":  {"smallint", "int", "bigint", "double"},
+	"smallint": {"int", "bigint", "double"},
+	"int":      {"bigint", "double"},
+	"float":    {"double"},
+	"date":     {"timestamp_ntz"},
+}
+
+var decimalTypeRegex = regexp.MustCompile(`^decimal\((\d+),(\d+)\)$`)
+
+func parseDecimalType(columnType string) (precision int, scale int, ok bool) {
+	m := decimalTypeRegex.FindStringSubmatch(strings.ReplaceAll(columnType, " ", ""))
+	if m == nil {
+		return 0, 0, false
+	}
+	precision, _ = strconv.Atoi(m[1])
+	scale, _ = strconv.Atoi(m[2])
+	return precision, scale, true
+}
+
+// isColumnTypeWidening returns true if the change from the old to the new column type
+// doesn't lose any information and could be applied without rewriting the table.
+func isColumnTypeWidening(oldType, newType string) bool {
+	oldType = strings.ReplaceAll(getColumnType(oldType), " ", "")
+	newType = strings.ReplaceAll(getColumnType(newType), " ", "")
+	if slices.Contains(columnTypeWidenings[oldType], newType) {
+		return true
+	}
+	newPrecision, newScale, ok := parseDecimalType(newType)
+	if !ok {
+		return false
+	}
+	oldPrecision, oldScale, ok := parseDecimalType(oldType)
+	if !ok {
+		// integral types could be widened to decimals with enough integer digits
+		integerDigits := map[string]int{"tinyint": 3, "smallint": 5, "int": 10, "bigint": 20}
+		digits, isIntegral := integerDigits[oldType]
+		return isIntegral && newPrecision-newScale >= digits
+	}
+	return newPrecision >= oldPrecision && newScale >= oldScale &&
+		newPrecision-newScale >= oldPrecision-oldScale
+}
+
 func assertNoColumnTypeDiff(oldCols []interface{}, newColumnInfos []SqlColumnInfo) error {
 	for i, oldCol := range oldCols {
 		oldColMap := oldCol.(map[string]interface{})
-		if getColumnType(oldColMap["type"].(string)) != getColumnType(newColumnInfos[i].Type) {
-			return fmt.Errorf("changing the 'type' of an existing column is not supported")
+		oldType := oldColMap["type"].(string)
+		newType := newColumnInfos[i].Type
+		if getColumnType(oldType) == getColumnType(newType) || isColumnTypeWidening(oldType, newType) {
+			continue
 		}
+		return fmt.Errorf("changing the 'type' of an existing column is not supported")
 	}
 	return nil
 }
 
+// columnMaskFromState converts the mask block of a column in the state into the struct
+func columnMaskFromState(v any) *SqlColumnMask {
+	masks, ok := v.([]any)
+	if !ok || len(masks) == 0 || masks[0] == nil {
+		return nil
+	}
+	m := masks[0].(map[string]any)
+	mask := &SqlColumnMask{FunctionName: m["function_name"].(string)}
+	if columns, ok := m["using_column_names"].([]any); ok {
+		for _, c := range columns {
+			mask.UsingColumnNames = append(mask.UsingColumnNames, c.(string))
+		}
+	}
+	return mask
+}
+
+// scheduleFromState converts the schedule block in the state into the struct
+func scheduleFromState(v any) *SqlTableSchedule {
+	schedules, ok := v.([]any)
+	if !ok || len(schedules) == 0 || schedules[0] == nil {
+		return nil
+	}
+	m := schedules[0].(map[string]any)
+	return &SqlTableSchedule{
+		CronExpression: m["cron_expression"].(string),
+		TimeZoneId:     m["time_zone_id"].(string),
+		Every:          m["every"].(string),
+	}
+}
+
+// bloomFilterIndexesFromState converts the bloom_filter_index blocks in the state into structs
+func bloomFilterIndexesFromState(v any) []SqlBloomFilterIndex {
+	blocks, _ := v.([]any)
+	indexes := make([]SqlBloomFilterIndex, 0, len(blocks))
+	for _, block := range blocks {
+		m, ok := block.(map[string]any)
+		if !ok {
+			continue
+		}
+		index := SqlBloomFilterIndex{
+			Fpp:      m["fpp"].(float64),
+			NumItems: int64(m["num_items"].(int)),
+		}
+		for _, c := range m["columns"].([]any) {
+			index.Columns = append(index.Columns, c.(string))
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
 // This function will throw if column addition or removal is happening together with column info field values.
 func assertNoColumnMembershipAndFieldValueUpdate(oldCols []interface{}, newColumnInfos []SqlColumnInfo) error {
 	oldColsNameToMap := make(map[string]map[string]interface{})
@@ -519,7 +1980,8 @@ func assertNoColumnMembershipAndFieldValueUpdate(oldCols []interface{}, newColum
 	}
 	for name, oldColMap := range oldColsNameToMap {
 		if newCol, exists := newColsNameToMap[name]; exists {
-			if getColumnType(oldColMap["type"].(string)) != getColumnType(newCol.Type) || oldColMap["nullable"] != newCol.Nullable || oldColMap["comment"] != newCol.Comment {
+			if getColumnType(oldColMap["type"].(string)) != getColumnType(newCol.Type) || oldColMap["nullable"] != newCol.Nullable || oldColMap["comment"] != newCol.Comment || oldColMap["default"] != newCol.Default ||
+				!columnMaskFromState(oldColMap["mask"]).equal(newCol.Mask) {
 				return fmt.Errorf("detected changes in both number of columns and existing column field values, please do not change number of columns and update column values at the same time")
 			}
 		}
@@ -527,6 +1989,230 @@ func assertNoColumnMembershipAndFieldValueUpdate(oldCols []interface{}, newColum
 	return nil
 }
 
+// priorState reads values of the resource diff, that are recorded in the state before the change
+type priorState struct {
+	d *schema.ResourceDiff
+}
+
+func (p priorState) GetOk(key string) (any, bool) {
+	old, _ := p.d.GetChange(key)
+	if old == nil {
+		return nil, false
+	}
+	switch v := old.(type) {
+	case *schema.Set:
+		return v, v.Len() > 0
+	case []any:
+		return v, len(v) > 0
+	case map[string]any:
+		return v, len(v) > 0
+	}
+	return old, !reflect.ValueOf(old).IsZero()
+}
+
+func (p priorState) GetOkExists(key string) (any, bool) {
+	old, _ := p.d.GetChange(key)
+	return old, old != nil
+}
+
+// tableChanges is implemented by both schema.ResourceDiff and schema.ResourceData, so that the same statements
+// are generated for the plan preview and for the update
+type tableChanges interface {
+	Get(key string) any
+	GetChange(key string) (any, any)
+	HasChange(key string) bool
+}
+
+// validateDeltaFeatures checks that Delta table properties and features are only used with Delta tables,
+// so that the configuration fails on plan instead of in the middle of executing SQL statements
+func validateDeltaFeatures(d tableChanges) error {
+	tableType := d.Get("table_type").(string)
+	format := d.Get("data_source_format").(string)
+	deltaProperties := []string{}
+	for k := range d.Get("properties").(map[string]any) {
+		if strings.HasPrefix(k, "delta.") {
+			deltaProperties = append(deltaProperties, k)
+		}
+	}
+	sort.Strings(deltaProperties)
+	switch tableType {
+	case "VIEW":
+		if len(deltaProperties) > 0 {
+			return fmt.Errorf("%s is only supported for Delta tables, not VIEW", deltaProperties[0])
+		}
+	case "MANAGED", "EXTERNAL":
+		if isDeltaFormat(format) {
+			return nil
+		}
+		if len(deltaProperties) > 0 {
+			return fmt.Errorf("%s is only supported for DELTA tables, not %s", deltaProperties[0], format)
+		}
+		if len(d.Get("cluster_keys").([]any)) > 0 {
+			return fmt.Errorf("cluster_keys are only supported for DELTA tables, not %s", format)
+		}
+		for _, c := range d.Get("column").([]any) {
+			if column, ok := c.(map[string]any); ok && column["default"] != "" && column["default"] != nil {
+				return fmt.Errorf("column defaults are only supported for DELTA tables, not %s", format)
+			}
+		}
+	}
+	return nil
+}
+
+// attributesRequiringRecreation returns attributes, which changes couldn't be applied with ALTER statements
+func attributesRequiringRecreation(d tableChanges) []string {
+	var attributes []string
+	if oldType, _ := d.GetChange("table_type"); oldType.(string) != "" && d.HasChange("table_type") && !isConvertedToManaged(d) {
+		attributes = append(attributes, "table_type")
+	}
+	for _, k := range []string{"data_source_format", "storage_credential_name", "clone"} {
+		// the storage credential of the external location isn't used by the converted managed table
+		if k == "storage_credential_name" && d.Get(k).(string) == "" && isConvertedToManaged(d) {
+			continue
+		}
+		if d.HasChange(k) {
+			attributes = append(attributes, k)
+		}
+	}
+	tableType := d.Get("table_type").(string)
+	isView := tableType == "VIEW" || tableType == "MATERIALIZED_VIEW" || tableType == "STREAMING_TABLE"
+	// partitioned tables could be migrated to liquid clustering in place, but not the other way around
+	if d.HasChange("partitions") && !migratedToLiquidClustering(d) {
+		attributes = append(attributes, "partitions")
+	}
+	if d.HasChange("options") {
+		oldOptions, newOptions := d.GetChange("options")
+		if isView || optionsRequireRecreation(oldOptions.(map[string]any), newOptions.(map[string]any)) {
+			attributes = append(attributes, "options")
+		}
+	}
+	// Comments of views are changed with COMMENT ON VIEW, but not of materialized views and streaming tables
+	if d.HasChange("comment") && isView && tableType != "VIEW" {
+		attributes = append(attributes, "comment")
+	}
+	// The default collation of materialized views and streaming tables could only be changed by recreating them
+	if d.HasChange("collation") && isView && tableType != "VIEW" {
+		attributes = append(attributes, "collation")
+	}
+	// The query of a materialized view could only be changed by recreating it
+	if d.HasChange("view_definition") && tableType == "MATERIALIZED_VIEW" {
+		attributes = append(attributes, "view_definition")
+	}
+	// Changes of as_select are suppressed, unless recreate_on_as_select_change is set
+	if oldAsSelect, _ := d.GetChange("as_select"); oldAsSelect.(string) != "" && d.HasChange("as_select") &&
+		d.Get("recreate_on_as_select_change").(bool) {
+		attributes = append(attributes, "as_select")
+	}
+	return attributes
+}
+
+// isConvertedToManaged checks, if an external Delta table is converted to a managed one with ALTER TABLE ... SET MANAGED
+func isConvertedToManaged(d tableChanges) bool {
+	oldType, newType := d.GetChange("table_type")
+	oldFormat, newFormat := d.GetChange("data_source_format")
+	return oldType.(string) == "EXTERNAL" && newType.(string) == "MANAGED" && isDeltaFormat(oldFormat) && isDeltaFormat(newFormat)
+}
+
+func isDeltaFormat(format any) bool {
+	return format.(string) == "" || strings.EqualFold(format.(string), "DELTA")
+}
+
+// canReplaceInPlace checks, if replace_on_change is set and the existing table could be replaced
+// with CREATE OR REPLACE. It's supported for Delta tables, views and materialized views.
+func canReplaceInPlace(d tableChanges) bool {
+	if !d.Get("replace_on_change").(bool) {
+		return false
+	}
+	oldType, newType := d.GetChange("table_type")
+	if oldType.(string) != newType.(string) {
+		return false
+	}
+	switch newType {
+	case "VIEW", "MATERIALIZED_VIEW":
+		return true
+	case "MANAGED", "EXTERNAL":
+		oldFormat, newFormat := d.GetChange("data_source_format")
+		return isDeltaFormat(oldFormat) && isDeltaFormat(newFormat)
+	}
+	return false
+}
+
+// partitionsReplacedWithClusterKeys checks, if partitions of the table are replaced with cluster_keys
+func partitionsReplacedWithClusterKeys(d tableChanges) bool {
+	oldPartitions, newPartitions := d.GetChange("partitions")
+	return len(oldPartitions.([]any)) > 0 && len(newPartitions.([]any)) == 0 &&
+		len(d.Get("cluster_keys").([]any)) > 0
+}
+
+// migratedToLiquidClustering checks, if partitions of the managed table are replaced with cluster_keys. Delta doesn't
+// support liquid clustering of partitioned tables, so the data is rewritten into a clustered table.
+func migratedToLiquidClustering(d tableChanges) bool {
+	oldType, newType := d.GetChange("table_type")
+	return partitionsReplacedWithClusterKeys(d) && oldType.(string) == "MANAGED" && newType.(string) == "MANAGED"
+}
+
+// errExternalTableRewrite is returned for external tables, as rewriting them replaces the data files
+// at the external location, that could be read by other systems
+func errExternalTableRewrite(name string) error {
+	return fmt.Errorf("partitions of %s could be replaced with cluster_keys only for MANAGED tables, as it rewrites "+
+		"all data files of the table. Migrate the EXTERNAL table to liquid clustering outside of Terraform", name)
+}
+
+// replacedInPlace checks, if the existing table is replaced with CREATE OR REPLACE instead of being altered
+func replacedInPlace(d tableChanges, newti *SqlTableInfo) bool {
+	if !canReplaceInPlace(d) {
+		return false
+	}
+	if len(attributesRequiringRecreation(d)) > 0 {
+		return true
+	}
+	return d.HasChange("column") && columnChangesCustomizeDiff(d, newti) != nil
+}
+
+// customizeEffectiveSql previews statements, that are executed to create the table or to apply changes
+// of the existing table. The preview is based on the state, so it doesn't include changes made outside of
+// Terraform. It's not set, if the configuration has values, that are known only after apply.
+func customizeEffectiveSql(d *schema.ResourceDiff, tableSchema map[string]*schema.Schema) error {
+	changed := false
+	for k := range tableSchema {
+		if k != "effective_sql" && d.HasChange(k) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		// nothing is executed, so the previous preview is kept
+		return d.Clear("effective_sql")
+	}
+	if config := d.GetRawConfig(); !config.IsNull() && !config.IsWhollyKnown() {
+		return d.SetNewComputed("effective_sql")
+	}
+	var newti, oldti SqlTableInfo
+	common.DiffToStructPointer(d, tableSchema, &newti)
+	common.DiffToStructPointer(priorState{d}, tableSchema, &oldti)
+	var statements []string
+	var err error
+	// replaced tables are diffed against the empty state
+	if oldName, _ := d.GetChange("name"); oldName.(string) == "" {
+		statements, err = newti.createStatements()
+	} else if migratedToLiquidClustering(d) {
+		statements, err = newti.rewriteStatements(&oldti)
+	} else if replacedInPlace(d, &newti) {
+		statements, err = newti.replaceStatements(&oldti)
+	} else {
+		statements, err = newti.diff(&oldti)
+	}
+	if err != nil {
+		// the same error is returned on apply
+		log.Printf("[WARN] cannot preview SQL statements: %s", err)
+		return nil
+	}
+	if len(statements) == 0 && oldti.EffectiveSql == "" {
+		return d.Clear("effective_sql")
+	}
+	return d.SetNew("effective_sql", strings.Join(statements, ";\n"))
+}
+
 func ResourceSqlTable() common.Resource {
 	tableSchema := common.StructToSchema(SqlTableInfo{}, nil)
 	return common.Resource{
@@ -536,9 +2222,17 @@ func ResourceSqlTable() common.Resource {
 				var newTableStruct SqlTableInfo
 				common.DiffToStructPointer(d, tableSchema, &newTableStruct)
 				err := columnChangesCustomizeDiff(d, &newTableStruct)
-				if err != nil {
+				if err != nil && !canReplaceInPlace(d) {
 					return err
 				}
+				if oldName, _ := d.GetChange("name"); oldName.(string) != "" &&
+					!migratedToLiquidClustering(d) && !replacedInPlace(d, &newTableStruct) {
+					var oldTableStruct SqlTableInfo
+					common.DiffToStructPointer(priorState{d}, tableSchema, &oldTableStruct)
+					if err := newTableStruct.checkColumnReorder(&oldTableStruct); err != nil {
+						return err
+					}
+				}
 			}
 			// Compute the new effective property/options.
 			// If the user changed a property or option, the resource will already be considered changed.
@@ -548,13 +2242,32 @@ func ResourceSqlTable() common.Resource {
 			userSpecifiedProperties := d.Get("properties").(map[string]interface{})
 			userSpecifiedOptions := d.Get("options").(map[string]interface{})
 			effectiveProperties := d.Get("effective_properties").(map[string]interface{})
+			uniformIceberg := d.Get("uniform_iceberg").(bool)
+			var ignoredProperties []string
+			for _, pattern := range d.Get("ignored_properties").(*schema.Set).List() {
+				ignoredProperties = append(ignoredProperties, pattern.(string))
+			}
 			diff := make(map[string]interface{})
 			for k, userSpecifiedValue := range userSpecifiedProperties {
-				if effectiveValue, ok := effectiveProperties[k]; !ok || effectiveValue != userSpecifiedValue {
+				if uniformIceberg && isIcebergManagedProperty(k) {
+					// the server upgrades protocol versions and table features required by UniForm
+					continue
+				}
+				if isIgnoredProperty(ignoredProperties, k) {
+					continue
+				}
+				effectiveValue, ok := effectiveProperties[k]
+				if ok && isManagedPropertyValue(k, userSpecifiedValue.(string), effectiveValue.(string)) {
+					continue
+				}
+				if !ok || effectiveValue != userSpecifiedValue {
 					diff[k] = userSpecifiedValue
 				}
 			}
 			for k, userSpecifiedValue := range userSpecifiedOptions {
+				if isIgnoredProperty(ignoredProperties, "option."+k) {
+					continue
+				}
 				if effectiveValue, ok := effectiveProperties["option."+k]; !ok || effectiveValue != userSpecifiedValue {
 					diff["option."+k] = userSpecifiedValue
 				}
@@ -565,12 +2278,54 @@ func ResourceSqlTable() common.Resource {
 				}
 				d.SetNew("effective_properties", effectiveProperties)
 			}
-			// No support yet for changing the COMMENT on a VIEW
-			// Once added this can be removed
-			if d.HasChange("comment") && d.Get("table_type") == "VIEW" {
-				d.ForceNew("comment")
+			tableType := d.Get("table_type").(string)
+			if tableType == "EXTERNAL" && partitionsReplacedWithClusterKeys(d) && !canReplaceInPlace(d) {
+				return errExternalTableRewrite(fmt.Sprintf("%s.%s.%s", d.Get("catalog_name"), d.Get("schema_name"), d.Get("name")))
 			}
-			return nil
+			if recreated := attributesRequiringRecreation(d); len(recreated) > 0 && !canReplaceInPlace(d) {
+				for _, k := range recreated {
+					d.ForceNew(k)
+				}
+			}
+			if tableType == "VIEW" || tableType == "MATERIALIZED_VIEW" || tableType == "STREAMING_TABLE" {
+				if d.Get("as_select").(string) != "" {
+					return fmt.Errorf("as_select is only supported for MANAGED and EXTERNAL tables, use view_definition for %s", tableType)
+				}
+				if len(d.Get("clone").([]any)) > 0 {
+					return fmt.Errorf("clone is only supported for MANAGED and EXTERNAL tables")
+				}
+				if len(d.Get("bloom_filter_index").([]any)) > 0 {
+					return fmt.Errorf("bloom_filter_index is only supported for MANAGED and EXTERNAL tables")
+				}
+				if uniformIceberg {
+					return fmt.Errorf("uniform_iceberg is only supported for MANAGED and EXTERNAL tables")
+				}
+				for _, k := range []string{"purge_on_destroy", "undrop_on_create"} {
+					if d.Get(k).(bool) {
+						return fmt.Errorf("%s is only supported for MANAGED and EXTERNAL tables", k)
+					}
+				}
+			}
+			if d.Get("schema_binding").(string) != "" && tableType != "VIEW" {
+				return fmt.Errorf("schema_binding is only supported for VIEW, not %s", tableType)
+			}
+			if err := validateDeltaFeatures(d); err != nil {
+				return err
+			}
+			if format := d.Get("data_source_format").(string); uniformIceberg && format != "" && !strings.EqualFold(format, "DELTA") {
+				return fmt.Errorf("uniform_iceberg is only supported for DELTA tables, not %s", format)
+			}
+			for _, p := range d.Get("partitions").([]any) {
+				partition, _ := p.(string)
+				if partition == "" {
+					// not known until apply
+					continue
+				}
+				if _, err := serializePartition(partition); err != nil {
+					return err
+				}
+			}
+			return customizeEffectiveSql(d, tableSchema)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ti = new(SqlTableInfo)
@@ -578,23 +2333,32 @@ func ResourceSqlTable() common.Resource {
 			if err := ti.initCluster(ctx, d, c); err != nil {
 				return err
 			}
-			if err := ti.createTable(); err != nil {
-				return err
+			restored := false
+			if ti.UndropOnCreate {
+				var err error
+				if restored, err = ti.undropTable(ctx, c); err != nil {
+					return err
+				}
+			}
+			if !restored {
+				if err := ti.createTable(ctx); err != nil {
+					return err
+				}
 			}
-			if ti.Owner != "" {
+			d.SetId(ti.FullName())
+			if owner := c.OwnerOrDefault(ti.Owner); owner != "" {
 				w, err := c.WorkspaceClient()
 				if err != nil {
 					return err
 				}
 				err = w.Tables.Update(ctx, catalog.UpdateTableRequest{
 					FullName: ti.FullName(),
-					Owner:    ti.Owner,
+					Owner:    owner,
 				})
 				if err != nil {
 					return err
 				}
 			}
-			d.SetId(ti.FullName())
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -602,6 +2366,30 @@ func ResourceSqlTable() common.Resource {
 			if err != nil {
 				return err
 			}
+			tags, columns := d.Get("tags"), d.Get("column")
+			if hasTags(tags, columns) {
+				if err = ti.loadTags(ctx, d, c, tags, columns); err != nil {
+					return err
+				}
+			}
+			// partition transforms aren't returned by the Tables API, so partitions are only read on import
+			if _, ok := d.GetOk("partitions"); ok {
+				ti.Partitions = nil
+			}
+			// options are tracked through effective_properties, so they are only read on import
+			if _, ok := d.GetOk("options"); ok {
+				ti.Options = nil
+			}
+			// flags that aren't returned by the Tables API are kept from the state
+			ti.FullRefreshOnQueryChange = d.Get("full_refresh_on_query_change").(bool)
+			ti.RecreateOnAsSelectChange = d.Get("recreate_on_as_select_change").(bool)
+			ti.ReplaceOnChange = d.Get("replace_on_change").(bool)
+			ti.PurgeOnDestroy = d.Get("purge_on_destroy").(bool)
+			ti.UndropOnCreate = d.Get("undrop_on_create").(bool)
+			ti.UniformIceberg = d.Get("uniform_iceberg").(bool)
+			ti.SchemaBinding = d.Get("schema_binding").(string)
+			ti.Collation = d.Get("collation").(string)
+			ti.UseServerlessWarehouse = d.Get("use_serverless_warehouse").(bool)
 			return common.StructToData(ti, tableSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -611,6 +2399,14 @@ func ResourceSqlTable() common.Resource {
 			}
 			var newti = new(SqlTableInfo)
 			common.DataToStructPointer(d, tableSchema, newti)
+			if !d.HasChangesExcept("ignored_properties", "effective_sql") {
+				// ignored properties only affect the plan
+				return nil
+			}
+			if !d.HasChangesExcept("owner", "effective_sql") {
+				// ownership is transferred with the Tables API, so neither a cluster nor a warehouse is needed
+				return newti.updateOwner(ctx, w)
+			}
 			if err := newti.initCluster(ctx, d, c); err != nil {
 				return err
 			}
@@ -618,23 +2414,38 @@ func ResourceSqlTable() common.Resource {
 			if err != nil {
 				return err
 			}
-			err = newti.updateTable(&oldti)
+			oldTags, newTags := d.GetChange("tags")
+			oldColumns, newColumns := d.GetChange("column")
+			if hasTags(oldTags, oldColumns) || hasTags(newTags, newColumns) {
+				if err = oldti.loadTags(ctx, d, c, oldTags, oldColumns); err != nil {
+					return err
+				}
+			}
+			// Schedule of a materialized view or a streaming table isn't returned by the Tables API
+			oldSchedule, _ := d.GetChange("schedule")
+			oldti.Schedule = scheduleFromState(oldSchedule)
+			// Bloom filter indexes aren't returned by the Tables API either
+			oldIndexes, _ := d.GetChange("bloom_filter_index")
+			oldti.BloomFilterIndexes = bloomFilterIndexesFromState(oldIndexes)
+			// UniForm is enabled through properties, that aren't tracked in the state
+			oldUniformIceberg, _ := d.GetChange("uniform_iceberg")
+			oldti.UniformIceberg = oldUniformIceberg.(bool)
+			oldSchemaBinding, _ := d.GetChange("schema_binding")
+			oldti.SchemaBinding = oldSchemaBinding.(string)
+			oldCollation, _ := d.GetChange("collation")
+			oldti.Collation = oldCollation.(string)
+			if migratedToLiquidClustering(d) {
+				err = newti.rewriteTable(ctx, &oldti)
+			} else if replacedInPlace(d, newti) {
+				err = newti.replaceTable(ctx, &oldti)
+			} else {
+				err = newti.updateTable(ctx, &oldti)
+			}
 			if err != nil {
 				return err
 			}
 			if d.HasChange("owner") {
-				// if new owner is not specified, set it to the current user
-				if newti.Owner == "" {
-					currentUser, err := w.CurrentUser.Me(ctx)
-					if err != nil {
-						return err
-					}
-					newti.Owner = currentUser.UserName
-				}
-				return w.Tables.Update(ctx, catalog.UpdateTableRequest{
-					FullName: newti.FullName(),
-					Owner:    newti.Owner,
-				})
+				return newti.updateOwner(ctx, w)
 			}
 			return nil
 		},
@@ -644,7 +2455,12 @@ func ResourceSqlTable() common.Resource {
 			if err := ti.initCluster(ctx, d, c); err != nil {
 				return err
 			}
-			return ti.deleteTable()
+			return ti.deleteTable(ctx)
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultSqlExecTimeout),
+			Update: schema.DefaultTimeout(defaultSqlExecTimeout),
+			Delete: schema.DefaultTimeout(defaultSqlExecTimeout),
 		},
 	}
 }
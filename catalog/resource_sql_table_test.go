@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
@@ -58,6 +60,572 @@ func TestResourceSqlTableCreateStatement_View(t *testing.T) {
 	assert.Contains(t, stmt, "'one'='two'")
 }
 
+func TestResourceSqlTableCreateStatement_MaterializedView(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "MATERIALIZED_VIEW",
+		DataSourceFormat: "DELTA",
+		Comment:          "terraform managed",
+		ViewDefinition:   "SELECT * FROM main.foo.baz",
+		Schedule: &SqlTableSchedule{
+			CronExpression: "0 0 * * * ?",
+			TimeZoneId:     "UTC",
+		},
+	}
+	assert.Equal(t, "CREATE MATERIALIZED VIEW `main`.`foo`.`bar`\nCOMMENT 'terraform managed'\n"+
+		"SCHEDULE CRON '0 0 * * * ?' AT TIME ZONE 'UTC'\nAS SELECT * FROM main.foo.baz;", ti.buildTableCreateStatement())
+
+	ti.Schedule = &SqlTableSchedule{Every: "2 HOURS"}
+	assert.Contains(t, ti.buildTableCreateStatement(), "\nSCHEDULE EVERY 2 HOURS\nAS SELECT")
+}
+
+func TestResourceSqlTableUpdateMaterializedView_Schedule(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:           "bar",
+		CatalogName:    "main",
+		SchemaName:     "foo",
+		TableType:      "MATERIALIZED_VIEW",
+		ViewDefinition: "SELECT 1",
+	}
+	ti := &SqlTableInfo{
+		Name:           "bar",
+		CatalogName:    "main",
+		SchemaName:     "foo",
+		TableType:      "MATERIALIZED_VIEW",
+		ViewDefinition: "SELECT 1",
+		Schedule:       &SqlTableSchedule{Every: "1 DAY"},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER MATERIALIZED VIEW `main`.`foo`.`bar` ADD SCHEDULE EVERY 1 DAY"}, statements)
+
+	oldti.Schedule = ti.Schedule
+	ti.Schedule = &SqlTableSchedule{CronExpression: "0 0 6 * * ?"}
+	statements, err = ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER MATERIALIZED VIEW `main`.`foo`.`bar` ALTER SCHEDULE CRON '0 0 6 * * ?'"}, statements)
+
+	oldti.Schedule = ti.Schedule
+	ti.Schedule = nil
+	statements, err = ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER MATERIALIZED VIEW `main`.`foo`.`bar` DROP SCHEDULE"}, statements)
+}
+
+func TestResourceSqlTableUpdateMaterializedView_ScheduleFromState(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			assert.Equal(t, "ALTER MATERIALIZED VIEW `main`.`foo`.`bar` ALTER SCHEDULE EVERY 2 HOURS", commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name            = "bar"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "MATERIALIZED_VIEW"
+		view_definition = "SELECT 1"
+		cluster_id      = "existingcluster"
+		schedule {
+		  every = "2 HOURS"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":                    "bar",
+			"catalog_name":            "main",
+			"schema_name":             "foo",
+			"table_type":              "MATERIALIZED_VIEW",
+			"view_definition":         "SELECT 1",
+			"cluster_id":              "existingcluster",
+			"schedule.#":              "1",
+			"schedule.0.every":        "1 HOUR",
+			"schedule.0.time_zone_id": "",
+		},
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:           "bar",
+					CatalogName:    "main",
+					SchemaName:     "foo",
+					TableType:      "MATERIALIZED_VIEW",
+					ViewDefinition: "SELECT 1",
+				},
+			},
+		}, createClusterForSql...),
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schedule.0.every": "2 HOURS",
+	})
+}
+
+func TestResourceSqlTableCreateStatement_StreamingTable(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:           "bar",
+		CatalogName:    "main",
+		SchemaName:     "foo",
+		TableType:      "STREAMING_TABLE",
+		ClusterKeys:    []string{"id"},
+		ViewDefinition: "SELECT * FROM STREAM(main.foo.events)",
+		Schedule:       &SqlTableSchedule{Every: "1 HOUR"},
+	}
+	assert.Equal(t, "CREATE STREAMING TABLE `main`.`foo`.`bar`\nCLUSTER BY (`id`)\n"+
+		"SCHEDULE EVERY 1 HOUR\nAS SELECT * FROM STREAM(main.foo.events);", ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableUpdateStreamingTable(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:           "bar",
+		CatalogName:    "main",
+		SchemaName:     "foo",
+		TableType:      "STREAMING_TABLE",
+		ViewDefinition: "SELECT * FROM STREAM(main.foo.events)",
+		ColumnInfos:    []SqlColumnInfo{{Name: "id", Type: "int", Nullable: true}},
+	}
+	ti := &SqlTableInfo{
+		Name:           "bar",
+		CatalogName:    "main",
+		SchemaName:     "foo",
+		TableType:      "STREAMING_TABLE",
+		ViewDefinition: "SELECT * FROM STREAM(main.foo.events)",
+		ColumnInfos:    []SqlColumnInfo{{Name: "id", Type: "int", Nullable: true}},
+		Schedule:       &SqlTableSchedule{CronExpression: "0 0 * * * ?"},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER STREAMING TABLE `main`.`foo`.`bar` ADD SCHEDULE CRON '0 0 * * * ?'"}, statements)
+
+	ti.ViewDefinition = "SELECT id FROM STREAM(main.foo.events) WHERE id > 0"
+	ti.FullRefreshOnQueryChange = true
+	statements, err = ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"CREATE OR REFRESH STREAMING TABLE `main`.`foo`.`bar`\nSCHEDULE CRON '0 0 * * * ?'\n" +
+			"AS SELECT id FROM STREAM(main.foo.events) WHERE id > 0;",
+		"REFRESH STREAMING TABLE `main`.`foo`.`bar` FULL",
+	}, statements)
+}
+
+func TestResourceSqlTableMaterializedView_InvalidSchedule(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name            = "bar"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "MATERIALIZED_VIEW"
+		view_definition = "SELECT 1"
+		schedule {
+		  every = "2 MINUTES"
+		}
+		`,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "invalid config supplied. [schedule.#.every] invalid value for schedule.0.every (must be a number followed by HOUR(S), DAY(S) or WEEK(S), for example `1 DAY`)")
+}
+
+func TestResourceSqlTableCreateStatement_AsSelect(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "EXTERNAL",
+		DataSourceFormat: "DELTA",
+		StorageLocation:  "s3://ext-main/foo/bar",
+		AsSelect:         "SELECT * FROM main.foo.baz",
+	}
+	assert.Equal(t, "CREATE EXTERNAL TABLE `main`.`foo`.`bar`\nUSING DELTA\n"+
+		"LOCATION 's3://ext-main/foo/bar'\nAS SELECT * FROM main.foo.baz;", ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableAsSelect_View(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "VIEW"
+		as_select    = "SELECT 1"
+		`,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "as_select is only supported for MANAGED and EXTERNAL tables, use view_definition for VIEW")
+}
+
+func TestResourceSqlTableCreateStatement_UniformIceberg(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "MANAGED",
+		DataSourceFormat: "DELTA",
+		UniformIceberg:   true,
+		Properties: map[string]string{
+			"delta.enableIcebergCompatV2": "true",
+			"one":                         "two",
+		},
+	}
+	assert.Equal(t, "CREATE TABLE `main`.`foo`.`bar`\nUSING DELTA\n"+
+		"TBLPROPERTIES ('delta.enableIcebergCompatV2'='true', 'delta.universalFormat.enabledFormats'='iceberg', 'one'='two');",
+		ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableUpdate_UniformIceberg(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+	}
+	ti := *oldti
+	ti.UniformIceberg = true
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `main`.`foo`.`bar` SET TBLPROPERTIES " +
+		"('delta.enableIcebergCompatV2'='true', 'delta.universalFormat.enabledFormats'='iceberg')"}, statements)
+
+	statements, err = oldti.diff(&ti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `main`.`foo`.`bar` UNSET TBLPROPERTIES IF EXISTS " +
+		"('delta.universalFormat.enabledFormats')"}, statements)
+}
+
+func TestResourceSqlTableUpdate_RemovedPropertiesAreQuoted(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		Properties: map[string]string{
+			"it's": "x",
+		},
+	}
+	ti := *oldti
+	ti.Properties = map[string]string{}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE `main`.`foo`.`bar` UNSET TBLPROPERTIES IF EXISTS ('it\\'s')", statements[0])
+}
+
+func TestResourceSqlTableUniformIceberg_View(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name            = "bar"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "VIEW"
+		view_definition = "SELECT 1"
+		uniform_iceberg = true
+		`,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "uniform_iceberg is only supported for MANAGED and EXTERNAL tables")
+}
+
+func TestResourceSqlTableUniformIceberg_NotDelta(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "EXTERNAL"
+		data_source_format = "PARQUET"
+		storage_location   = "s3://ext-main/foo/bar"
+		uniform_iceberg    = true
+		`,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "uniform_iceberg is only supported for DELTA tables, not PARQUET")
+}
+
+func TestResourceSqlTableAsSelect_ChangeIgnored(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		owner        = "testuser"
+		as_select    = "SELECT 2"
+		`,
+		InstanceState: map[string]string{
+			"name":                   "bar",
+			"catalog_name":           "main",
+			"schema_name":            "foo",
+			"table_type":             "MANAGED",
+			"cluster_id":             "existingcluster",
+			"as_select":              "SELECT 1",
+			"column.#":               "0",
+			"owner":                  "testuser",
+			"effective_properties.%": "0",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{},
+		Resource:     ResourceSqlTable(),
+		ID:           "main.foo.bar",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSqlTableAsSelect_ChangeRecreates(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name                         = "bar"
+		catalog_name                 = "main"
+		schema_name                  = "foo"
+		table_type                   = "MANAGED"
+		cluster_id                   = "existingcluster"
+		owner                        = "testuser"
+		as_select                    = "SELECT 2"
+		recreate_on_as_select_change = true
+		`,
+		InstanceState: map[string]string{
+			"name":                         "bar",
+			"catalog_name":                 "main",
+			"schema_name":                  "foo",
+			"table_type":                   "MANAGED",
+			"cluster_id":                   "existingcluster",
+			"as_select":                    "SELECT 1",
+			"recreate_on_as_select_change": "true",
+			"column.#":                     "0",
+			"owner":                        "testuser",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ExpectError(t, "changes require new: as_select")
+}
+
+func TestResourceSqlTableCreateStatement_Clone(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:            "events",
+		CatalogName:     "dev",
+		SchemaName:      "foo",
+		TableType:       "EXTERNAL",
+		StorageLocation: "s3://ext-dev/foo/events",
+		Properties:      map[string]string{"env": "dev"},
+		Clone: &SqlTableClone{
+			SourceTable: "prod.foo.events",
+			Type:        "SHALLOW",
+			Version:     12,
+		},
+	}
+	assert.Equal(t, "CREATE TABLE `dev`.`foo`.`events` SHALLOW CLONE `prod`.`foo`.`events` VERSION AS OF 12\n"+
+		"TBLPROPERTIES ('env'='dev')\nLOCATION 's3://ext-dev/foo/events';", ti.buildTableCloneStatement())
+
+	ti.Clone = &SqlTableClone{SourceTable: "prod.foo.events", Type: "DEEP", Timestamp: "2024-01-01"}
+	assert.Contains(t, ti.buildTableCloneStatement(), "DEEP CLONE `prod`.`foo`.`events` TIMESTAMP AS OF '2024-01-01'")
+}
+
+func TestResourceSqlTableCreateTable_Clone(t *testing.T) {
+	executed := []string{}
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name         = "events"
+		catalog_name = "dev"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		comment      = "copy of prod"
+		clone {
+		  source_table = "prod.foo.events"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/dev.foo.events",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:        "events",
+					CatalogName: "dev",
+					SchemaName:  "foo",
+					TableType:   "MANAGED",
+					Comment:     "copy of prod",
+					ColumnInfos: []SqlColumnInfo{{Name: "id", Type: "int", Nullable: true}},
+				},
+			},
+		}, useExistingClusterForSql...),
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"clone.0.source_table": "prod.foo.events",
+		"clone.0.type":         "DEEP",
+		"column.0.name":        "id",
+	})
+	assert.Equal(t, []string{
+		"CREATE TABLE `dev`.`foo`.`events` DEEP CLONE `prod`.`foo`.`events`;",
+		"COMMENT ON TABLE `dev`.`foo`.`events` IS 'copy of prod'",
+	}, executed)
+}
+
+func TestResourceSqlTableCreateTable_CloneWithColumns(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		HCL: `
+		name         = "events"
+		catalog_name = "dev"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		column {
+		  name = "id"
+		  type = "int"
+		}
+		clone {
+		  source_table = "prod.foo.events"
+		}
+		`,
+		Fixtures: useExistingClusterForSql,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "column blocks can't be specified together with clone, columns are copied from prod.foo.events")
+}
+
+func TestResourceSqlTableBloomFilterIndexDiffs(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		BloomFilterIndexes: []SqlBloomFilterIndex{
+			{Columns: []string{"a", "b"}, Fpp: 0.1},
+			{Columns: []string{"c"}},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		BloomFilterIndexes: []SqlBloomFilterIndex{
+			{Columns: []string{"a"}, Fpp: 0.1},
+			{Columns: []string{"c", "d"}, Fpp: 0.05, NumItems: 1000000},
+		},
+	}
+	drop, create := ti.getBloomFilterIndexDiffs(oldti)
+	assert.Equal(t, []string{
+		"DROP BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`b`)",
+		"DROP BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`c`)",
+	}, drop)
+	assert.Equal(t, []string{
+		"CREATE BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`c` OPTIONS (fpp=0.05, numItems=1000000))",
+		"CREATE BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`d` OPTIONS (fpp=0.05, numItems=1000000))",
+	}, create)
+}
+
+func TestResourceSqlTableCreateTable_BloomFilterIndex(t *testing.T) {
+	executed := []string{}
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		column {
+		  name = "id"
+		  type = "string"
+		}
+		bloom_filter_index {
+		  columns = ["id"]
+		  fpp     = 0.1
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:        "bar",
+					CatalogName: "main",
+					SchemaName:  "foo",
+					TableType:   "MANAGED",
+					ColumnInfos: []SqlColumnInfo{{Name: "id", Type: "string", Nullable: true}},
+				},
+			},
+		}, useExistingClusterForSql...),
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"bloom_filter_index.0.columns": []any{"id"},
+		"bloom_filter_index.0.fpp":     0.1,
+	})
+	assert.Equal(t, []string{
+		"CREATE TABLE `main`.`foo`.`bar` (`id` string);",
+		"CREATE BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`id` OPTIONS (fpp=0.1))",
+	}, executed)
+}
+
+func TestResourceSqlTableUpdateTable_BloomFilterIndexFromState(t *testing.T) {
+	executed := []string{}
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		bloom_filter_index {
+		  columns   = ["id"]
+		  num_items = 1000
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":                           "bar",
+			"catalog_name":                   "main",
+			"schema_name":                    "foo",
+			"table_type":                     "MANAGED",
+			"cluster_id":                     "existingcluster",
+			"bloom_filter_index.#":           "1",
+			"bloom_filter_index.0.columns.#": "1",
+			"bloom_filter_index.0.columns.0": "id",
+			"bloom_filter_index.0.fpp":       "0.1",
+			"bloom_filter_index.0.num_items": "0",
+		},
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:        "bar",
+					CatalogName: "main",
+					SchemaName:  "foo",
+					TableType:   "MANAGED",
+				},
+			},
+		}, createClusterForSql...),
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"bloom_filter_index.0.num_items": 1000,
+	})
+	assert.Equal(t, []string{
+		"DROP BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`id`)",
+		"CREATE BLOOMFILTER INDEX ON TABLE `main`.`foo`.`bar` FOR COLUMNS(`id` OPTIONS (numItems=1000))",
+	}, executed)
+}
+
 func TestResourceSqlTableCreateStatement_ViewWithComments(t *testing.T) {
 	ti := &SqlTableInfo{
 		Name:                  "bar",
@@ -108,7 +676,53 @@ func TestResourceSqlTableCreateStatement_Partition(t *testing.T) {
 	assert.Contains(t, stmt, "USING DELTA")
 	assert.Contains(t, stmt, "LOCATION 's3://ext-main/foo/bar1' WITH (CREDENTIAL `somecred`)")
 	assert.Contains(t, stmt, "COMMENT 'terraform managed'")
-	assert.Contains(t, stmt, "PARTITIONED BY (baz, bazz)")
+	assert.Contains(t, stmt, "PARTITIONED BY (`baz`, `bazz`)")
+}
+
+func TestResourceSqlTableCreateStatement_PartitionTransforms(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "MANAGED",
+		DataSourceFormat: "ICEBERG",
+		Partitions:       []string{"DAYS(event_time)", "bucket(16, id)", "truncate(4, `user name`)", "region"},
+	}
+	stmt := ti.buildTableCreateStatement()
+	assert.Contains(t, stmt, "PARTITIONED BY (days(`event_time`), bucket(16, `id`), truncate(4, `user name`), `region`)")
+}
+
+func TestSerializePartition(t *testing.T) {
+	for partition, expected := range map[string]string{
+		"baz":             "`baz`",
+		"`baz`":           "`baz`",
+		" days(ts) ":      "days(`ts`)",
+		"hours( ts )":     "hours(`ts`)",
+		"bucket(16,id)":   "bucket(16, `id`)",
+		"bucket(16, `a`)": "bucket(16, `a`)",
+	} {
+		serialized, err := serializePartition(partition)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, serialized)
+	}
+	for _, partition := range []string{"days(", "days()", "bucket(16, )", "days(hours(ts))"} {
+		_, err := serializePartition(partition)
+		assert.Error(t, err, partition)
+	}
+}
+
+func TestResourceSqlTablePartitions_Invalid(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		partitions   = ["days(event_time"]
+		`,
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ExpectError(t, "invalid partition: days(event_time")
 }
 
 func TestResourceSqlTableCreateStatement_Liquid(t *testing.T) {
@@ -131,6 +745,1012 @@ func TestResourceSqlTableCreateStatement_Liquid(t *testing.T) {
 	assert.Contains(t, stmt, "CLUSTER BY (`baz`,`bazz`)")
 }
 
+func TestResourceSqlTableCreateStatement_Constraints(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{
+				Name: "id",
+				Type: "int",
+			},
+			{
+				Name:     "parent_id",
+				Type:     "int",
+				Nullable: true,
+			},
+		},
+		PrimaryKey: &SqlPrimaryKeyInfo{
+			Name:    "bar_pk",
+			Columns: []string{"id"},
+		},
+		ForeignKeys: []SqlForeignKeyInfo{
+			{
+				Name:          "bar_parent_fk",
+				Columns:       []string{"parent_id"},
+				ParentTable:   "main.foo.parent",
+				ParentColumns: []string{"id"},
+			},
+		},
+	}
+	stmt := ti.buildTableCreateStatement()
+	assert.Contains(t, stmt, "CREATE TABLE `main`.`foo`.`bar` (`id` int NOT NULL, `parent_id` int, "+
+		"CONSTRAINT `bar_pk` PRIMARY KEY (`id`), "+
+		"CONSTRAINT `bar_parent_fk` FOREIGN KEY (`parent_id`) REFERENCES `main`.`foo`.`parent` (`id`))")
+}
+
+func TestResourceSqlTableDiff_Constraints(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		PrimaryKey: &SqlPrimaryKeyInfo{
+			Name:    "bar_pk",
+			Columns: []string{"id"},
+		},
+		ForeignKeys: []SqlForeignKeyInfo{
+			{
+				Name:          "bar_parent_fk",
+				Columns:       []string{"parent_id"},
+				ParentTable:   "main.foo.parent",
+				ParentColumns: []string{"id"},
+			},
+			{
+				Name:          "bar_other_fk",
+				Columns:       []string{"other_id"},
+				ParentTable:   "main.foo.other",
+				ParentColumns: []string{"id"},
+			},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		PrimaryKey: &SqlPrimaryKeyInfo{
+			Name:    "bar_pk",
+			Columns: []string{"id", "version"},
+		},
+		ForeignKeys: []SqlForeignKeyInfo{
+			{
+				Name:          "bar_parent_fk",
+				Columns:       []string{"parent_id"},
+				ParentTable:   "`main`.`foo`.`PARENT`",
+				ParentColumns: []string{"id"},
+			},
+			{
+				Name:          "bar_another_fk",
+				Columns:       []string{"another_id"},
+				ParentTable:   "main.foo.another",
+				ParentColumns: []string{"id"},
+			},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `bar_pk`",
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `bar_other_fk`",
+		"ALTER TABLE `main`.`foo`.`bar` ADD CONSTRAINT `bar_pk` PRIMARY KEY (`id`, `version`)",
+		"ALTER TABLE `main`.`foo`.`bar` ADD CONSTRAINT `bar_another_fk` FOREIGN KEY (`another_id`) REFERENCES `main`.`foo`.`another` (`id`)",
+	}, statements)
+
+	ti.PrimaryKey = nil
+	ti.ForeignKeys = nil
+	statements, err = ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `bar_pk`",
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `bar_parent_fk`",
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `bar_other_fk`",
+	}, statements)
+}
+
+func TestResourceSqlTableDiff_CheckConstraints(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		CheckConstraints: []SqlCheckConstraintInfo{
+			{Name: "positive_id", Expression: "id > 0"},
+			{Name: "valid_date", Expression: "date > '1900-01-01'"},
+			{Name: "removed", Expression: "name IS NOT NULL"},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		CheckConstraints: []SqlCheckConstraintInfo{
+			{Name: "positive_id", Expression: "id > 0"},
+			{Name: "valid_date", Expression: "date > '2000-01-01'"},
+			{Name: "added", Expression: "length(name) < 100"},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `valid_date`",
+		"ALTER TABLE `main`.`foo`.`bar` DROP CONSTRAINT IF EXISTS `removed`",
+		"ALTER TABLE `main`.`foo`.`bar` ADD CONSTRAINT `valid_date` CHECK (date > '2000-01-01')",
+		"ALTER TABLE `main`.`foo`.`bar` ADD CONSTRAINT `added` CHECK (length(name) < 100)",
+	}, statements)
+}
+
+func TestResourceSqlTableCreateStatement_ColumnDefaults(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "MANAGED",
+		DataSourceFormat: "DELTA",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: false},
+			{Name: "status", Type: "string", Nullable: true, Default: "'new'", Comment: "status"},
+		},
+		Properties: map[string]string{"delta.enableDeletionVectors": "false"},
+	}
+	assert.Equal(t, "CREATE TABLE `main`.`foo`.`bar` (`id` int NOT NULL, `status` string DEFAULT 'new' COMMENT 'status')\n"+
+		"USING DELTA\n"+
+		"TBLPROPERTIES ('delta.enableDeletionVectors'='false', 'delta.feature.allowColumnDefaults'='supported');",
+		ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableDiff_ColumnDefaults(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: true, Default: "0"},
+			{Name: "status", Type: "string", Nullable: true},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: true},
+			{Name: "status", Type: "string", Nullable: true, Default: "'new'"},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` SET TBLPROPERTIES ('delta.feature.allowColumnDefaults'='supported')",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `id` DROP DEFAULT",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `status` SET DEFAULT 'new'",
+	}, statements)
+
+	// the table feature isn't enabled again, once it is supported
+	oldti.EffectiveProperties = map[string]string{"delta.feature.allowColumnDefaults": "supported"}
+	statements, err = ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `id` DROP DEFAULT",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `status` SET DEFAULT 'new'",
+	}, statements)
+}
+
+func TestResourceSqlTableCreateStatement_Collation(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "MANAGED",
+		DataSourceFormat: "DELTA",
+		Collation:        "UTF8_LCASE",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: false},
+			{Name: "code", Type: "string", Nullable: true, Collation: "UTF8_BINARY", Comment: "code"},
+		},
+	}
+	assert.Equal(t, "CREATE TABLE `main`.`foo`.`bar` (`id` int NOT NULL, `code` string COLLATE UTF8_BINARY COMMENT 'code')\n"+
+		"USING DELTA\n"+
+		"DEFAULT COLLATION UTF8_LCASE;",
+		ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableDiff_Collation(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		Collation:   "UTF8_LCASE",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: true},
+			{Name: "code", Type: "string", Nullable: true, Collation: "UTF8_LCASE"},
+			{Name: "name", Type: "string", Nullable: true},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "id", Type: "int", Nullable: true},
+			{Name: "code", Type: "string", Nullable: true, Collation: "utf8_lcase"},
+			{Name: "name", Nullable: true, Collation: "UNICODE_CI"},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` DEFAULT COLLATION UTF8_BINARY",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `name` TYPE string COLLATE UNICODE_CI",
+	}, statements)
+}
+
+func TestResourceSqlTableUpdateMaterializedView_CollationRequiresNew(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		HCL: `
+		name            = "barview"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "MATERIALIZED_VIEW"
+		collation       = "UTF8_LCASE"
+		cluster_id      = "existingcluster"
+		view_definition = "SELECT * FROM main.foo.bar"
+		`,
+		InstanceState: map[string]string{
+			"name":            "barview",
+			"catalog_name":    "main",
+			"schema_name":     "foo",
+			"table_type":      "MATERIALIZED_VIEW",
+			"collation":       "UTF8_BINARY",
+			"cluster_id":      "existingcluster",
+			"view_definition": "SELECT * FROM main.foo.bar",
+		},
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.barview",
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: collation")
+}
+
+func TestResourceSqlTableDiff_ConvertToManaged(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:             "bar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "EXTERNAL",
+		DataSourceFormat: "DELTA",
+		StorageLocation:  "s3://ext-main/foo/bar",
+	}
+	newti := *oldti
+	newti.TableType = "MANAGED"
+	statements, err := newti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `main`.`foo`.`bar` SET MANAGED"}, statements)
+}
+
+func TestResourceSqlTableUpdateTable_ConvertToManagedInPlace(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		`,
+		InstanceState: map[string]string{
+			"name":                    "bar",
+			"catalog_name":            "main",
+			"schema_name":             "foo",
+			"table_type":              "EXTERNAL",
+			"data_source_format":      "DELTA",
+			"storage_location":        "s3://ext-main/foo/bar",
+			"storage_credential_name": "somecred",
+			"cluster_id":              "existingcluster",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"table_type":              {Old: "EXTERNAL", New: "MANAGED"},
+			"storage_credential_name": {Old: "somecred", New: "", NewRemoved: true},
+			"effective_sql":           {New: "ALTER TABLE `main`.`foo`.`bar` SET MANAGED"},
+			"column.#":                {NewComputed: true},
+			"effective_properties.%":  {NewComputed: true},
+			"owner":                   {NewComputed: true},
+		},
+		Resource: ResourceSqlTable(),
+	}.ApplyNoError(t)
+}
+
+func TestResourceSqlTableUpdateTable_ConvertToExternalRequiresNew(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "EXTERNAL"
+		data_source_format = "DELTA"
+		storage_location   = "s3://ext-main/foo/bar"
+		cluster_id         = "existingcluster"
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"storage_location":   "s3://managed/foo/bar",
+			"cluster_id":         "existingcluster",
+		},
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.bar",
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: table_type")
+}
+
+func TestResourceSqlTableUpdateTable_ConvertParquetToManagedRequiresNew(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "PARQUET"
+		cluster_id         = "existingcluster"
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "EXTERNAL",
+			"data_source_format": "PARQUET",
+			"storage_location":   "s3://ext-main/foo/bar",
+			"cluster_id":         "existingcluster",
+		},
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.bar",
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: table_type")
+}
+
+func TestResourceSqlTableUpdateTable_ColumnsAdditionAndDefaultUpdateThrowsError(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlTable(),
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"column.#":           "1",
+			"column.0.name":      "one",
+			"column.0.type":      "string",
+			"column.0.nullable":  "true",
+			"column.0.default":   "'a'",
+			"data_source_format": "DELTA",
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		column {
+			name    = "one"
+			type    = "string"
+			default = "'b'"
+		}
+		column {
+			name = "two"
+			type = "string"
+		}
+		`,
+		ID:          "main.foo.bar",
+		Update:      true,
+		RequiresNew: false,
+	}.ExpectError(t, "detected changes in both number of columns and existing column field values, please do not change number of columns and update column values at the same time")
+}
+
+func TestResourceSqlTableCreateStatement_ColumnMask(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "country", Type: "string", Nullable: true},
+			{
+				Name:     "ssn",
+				Type:     "string",
+				Nullable: true,
+				Comment:  "social security number",
+				Mask: &SqlColumnMask{
+					FunctionName:     "main.default.mask_ssn",
+					UsingColumnNames: []string{"country"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, "CREATE TABLE `main`.`foo`.`bar` (`country` string, "+
+		"`ssn` string MASK `main`.`default`.`mask_ssn` USING COLUMNS (`country`) COMMENT 'social security number');",
+		ti.buildTableCreateStatement())
+}
+
+func TestResourceSqlTableDiff_ColumnMask(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "string", Nullable: true, Mask: &SqlColumnMask{FunctionName: "main.default.mask"}},
+			{Name: "b", Type: "string", Nullable: true, Mask: &SqlColumnMask{FunctionName: "main.default.mask"}},
+			{Name: "c", Type: "string", Nullable: true},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "string", Nullable: true, Mask: &SqlColumnMask{FunctionName: "`main`.`default`.`MASK`"}},
+			{Name: "b", Type: "string", Nullable: true},
+			{Name: "c", Type: "string", Nullable: true, Mask: &SqlColumnMask{
+				FunctionName:     "main.default.mask_c",
+				UsingColumnNames: []string{"a", "b"},
+			}},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `b` DROP MASK",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `c` SET MASK `main`.`default`.`mask_c` USING COLUMNS (`a`, `b`)",
+	}, statements)
+}
+
+func TestColumnMaskFromState(t *testing.T) {
+	assert.Nil(t, columnMaskFromState(nil))
+	assert.Nil(t, columnMaskFromState([]any{}))
+	assert.Equal(t, &SqlColumnMask{
+		FunctionName:     "main.default.mask",
+		UsingColumnNames: []string{"a"},
+	}, columnMaskFromState([]any{map[string]any{
+		"function_name":      "main.default.mask",
+		"using_column_names": []any{"a"},
+	}}))
+}
+
+func TestResourceSqlTableUpdateTable_Tags(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		Tags:        map[string]string{"owner": "data", "stale": "yes"},
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "string", Nullable: true, Tags: map[string]string{"pii": "email"}},
+			{Name: "b", Type: "string", Nullable: true},
+		},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		Tags:        map[string]string{"owner": "finance", "domain": "sales"},
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "string", Nullable: true},
+			{Name: "b", Type: "string", Nullable: true, Tags: map[string]string{"pii": "it's a name"}},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` UNSET TAGS ('stale')",
+		"ALTER TABLE `main`.`foo`.`bar` SET TAGS ('domain' = 'sales', 'owner' = 'finance')",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `a` UNSET TAGS ('pii')",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `b` SET TAGS ('pii' = 'it\\'s a name')",
+	}, statements)
+}
+
+func TestSqlTableSetTagsFromState(t *testing.T) {
+	ti := &SqlTableInfo{
+		ColumnInfos: []SqlColumnInfo{{Name: "a"}, {Name: "b"}},
+	}
+	tags := map[string]any{"owner": "data"}
+	columns := []any{
+		map[string]any{"name": "b", "tags": map[string]any{"pii": "name"}},
+	}
+	assert.True(t, hasTags(tags, columns))
+	assert.True(t, hasTags(map[string]any{}, columns))
+	assert.False(t, hasTags(map[string]any{}, []any{map[string]any{"name": "b"}}))
+	ti.setTagsFromState(tags, columns)
+	assert.Equal(t, map[string]string{"owner": "data"}, ti.Tags)
+	assert.Nil(t, ti.ColumnInfos[0].Tags)
+	assert.Equal(t, map[string]string{"pii": "name"}, ti.ColumnInfos[1].Tags)
+}
+
+func serverlessWarehouseSqlTableFixtures(warehouseId string) []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			ExpectedRequest: sql.ExecuteStatementRequest{
+				Statement:     "CREATE TABLE `main`.`foo`.`bar` (`id` int)\nUSING DELTA;",
+				WaitTimeout:   "50s",
+				WarehouseId:   warehouseId,
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+			},
+			Response: sql.StatementResponse{
+				Status: &sql.StatementStatus{State: "SUCCEEDED"},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+			Response: map[string]any{
+				"name":               "bar",
+				"catalog_name":       "main",
+				"schema_name":        "foo",
+				"table_type":         "MANAGED",
+				"data_source_format": "DELTA",
+				"columns": []catalog.ColumnInfo{
+					{Name: "id", TypeText: "int", Nullable: true},
+				},
+			},
+		},
+	}
+}
+
+const serverlessWarehouseSqlTableHcl = `
+name                     = "bar"
+catalog_name             = "main"
+schema_name              = "foo"
+table_type               = "MANAGED"
+data_source_format       = "DELTA"
+use_serverless_warehouse = true
+
+column {
+  name = "id"
+  type = "int"
+}
+`
+
+func TestResourceSqlTableCreateTable_NewServerlessWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/warehouses?",
+				Response: sql.ListWarehousesResponse{
+					Warehouses: []sql.EndpointInfo{
+						{Id: "classic", Name: "terraform-sql-table"},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/warehouses",
+				ExpectedRequest: sql.CreateWarehouseRequest{
+					Name:                    "terraform-sql-table",
+					ClusterSize:             "2X-Small",
+					MaxNumClusters:          1,
+					AutoStopMins:            10,
+					EnableServerlessCompute: true,
+					WarehouseType:           sql.CreateWarehouseRequestWarehouseTypePro,
+				},
+				Response: sql.CreateWarehouseResponse{
+					Id: "serverless",
+				},
+			},
+		}, serverlessWarehouseSqlTableFixtures("serverless")...),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		HCL:      serverlessWarehouseSqlTableHcl,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                       "main.foo.bar",
+		"use_serverless_warehouse": true,
+	})
+}
+
+func TestResourceSqlTableCreateTable_ExistingServerlessWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/warehouses?",
+				Response: sql.ListWarehousesResponse{
+					Warehouses: []sql.EndpointInfo{
+						{Id: "other", Name: "analysts", EnableServerlessCompute: true},
+						{Id: "serverless", Name: "terraform-sql-table", EnableServerlessCompute: true},
+					},
+				},
+			},
+		}, serverlessWarehouseSqlTableFixtures("serverless")...),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		HCL:      serverlessWarehouseSqlTableHcl,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "main.foo.bar",
+	})
+}
+
+func TestResourceSqlTableServerlessWarehouse_ConflictsWithWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name                     = "bar"
+		catalog_name             = "main"
+		schema_name              = "foo"
+		table_type               = "MANAGED"
+		warehouse_id             = "abc"
+		use_serverless_warehouse = true
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "invalid config supplied. [use_serverless_warehouse] Conflicting configuration arguments. [warehouse_id] Conflicting configuration arguments")
+}
+
+func TestResourceSqlTableCreateTable_Tags(t *testing.T) {
+	tagParameters := []sql.StatementParameterListItem{
+		{Name: "schema", Value: "foo"},
+		{Name: "table", Value: "bar"},
+	}
+	d, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		warehouse_id       = "existingwarehouse"
+
+		column {
+		  name = "id"
+		  type = "int"
+		  tags = {
+		    "pii" = "none"
+		  }
+		}
+		tags = {
+		  "owner" = "data"
+		}
+		`,
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "CREATE TABLE `main`.`foo`.`bar` (`id` int)\nUSING DELTA;",
+					WaitTimeout:   "50s",
+					WarehouseId:   "existingwarehouse",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "ALTER TABLE `main`.`foo`.`bar` SET TAGS ('owner' = 'data')",
+					WaitTimeout:   "50s",
+					WarehouseId:   "existingwarehouse",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `id` SET TAGS ('pii' = 'none')",
+					WaitTimeout:   "50s",
+					WarehouseId:   "existingwarehouse",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: map[string]any{
+					"name":               "bar",
+					"catalog_name":       "main",
+					"schema_name":        "foo",
+					"table_type":         "MANAGED",
+					"data_source_format": "DELTA",
+					"columns": []catalog.ColumnInfo{
+						{Name: "id", TypeText: "int", Nullable: true},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "SELECT tag_name, tag_value FROM `main`.information_schema.table_tags WHERE schema_name = :schema AND table_name = :table",
+					Parameters:    tagParameters,
+					WaitTimeout:   "50s",
+					WarehouseId:   "existingwarehouse",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+					Result: &sql.ResultData{
+						DataArray: [][]string{{"owner", "data"}},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "SELECT column_name, tag_name, tag_value FROM `main`.information_schema.column_tags WHERE schema_name = :schema AND table_name = :table",
+					Parameters:    tagParameters,
+					WaitTimeout:   "50s",
+					WarehouseId:   "existingwarehouse",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+					Result: &sql.ResultData{
+						DataArray: [][]string{{"id", "pii", "none"}},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", d.Get("tags.owner"))
+	assert.Equal(t, "none", d.Get("column.0.tags.pii"))
+}
+
+func TestResourceSqlTableReadTable_TagsWithoutWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+
+		column {
+		  name = "id"
+		  type = "int"
+		  tags = {
+		    "pii" = "none"
+		  }
+		}
+		tags = {
+		  "owner" = "data"
+		}
+		`,
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: map[string]any{
+					"name":               "bar",
+					"catalog_name":       "main",
+					"schema_name":        "foo",
+					"table_type":         "MANAGED",
+					"data_source_format": "DELTA",
+					"columns": []catalog.ColumnInfo{
+						{Name: "id", TypeText: "int", Nullable: true},
+					},
+				},
+			},
+		},
+		InstanceState: map[string]string{
+			"name":              "bar",
+			"catalog_name":      "main",
+			"schema_name":       "foo",
+			"table_type":        "MANAGED",
+			"tags.%":            "1",
+			"tags.owner":        "data",
+			"column.#":          "1",
+			"column.0.name":     "id",
+			"column.0.type":     "int",
+			"column.0.tags.%":   "1",
+			"column.0.tags.pii": "none",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Read:     true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"tags.owner":        "data",
+		"column.0.tags.pii": "none",
+	})
+}
+
+func TestResourceSqlTableRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: map[string]any{
+					"name":               "bar",
+					"catalog_name":       "main",
+					"schema_name":        "foo",
+					"table_type":         "EXTERNAL",
+					"data_source_format": "DELTA",
+					"storage_location":   "s3://ext-main/foo/bar",
+					"columns": []map[string]any{
+						{"name": "id", "type_text": "int", "nullable": false, "comment": "identifier"},
+						{"name": "country", "type_text": "string", "nullable": true, "partition_index": 1},
+						{"name": "date", "type_text": "date", "nullable": true, "partition_index": 0},
+					},
+					"properties": map[string]string{
+						"option.multiLine": "true",
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Read:     true,
+		New:      true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"partitions":         []any{"date", "country"},
+		"options.multiLine":  "true",
+		"column.#":           3,
+		"column.0.comment":   "identifier",
+		"column.0.nullable":  false,
+		"column.1.nullable":  true,
+		"storage_location":   "s3://ext-main/foo/bar",
+		"data_source_format": "DELTA",
+	})
+}
+
+func TestResourceSqlTableRead_ImportClusterKeys(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+					Properties: map[string]string{
+						"clusteringColumns": `[["country"],["address","city"]]`,
+					},
+				},
+			},
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Read:     true,
+		New:      true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"cluster_keys": []any{"country", "address.city"},
+	})
+}
+
+func TestResourceSqlTableRead_KeepsPartitionTransforms(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: map[string]any{
+					"name":               "bar",
+					"catalog_name":       "main",
+					"schema_name":        "foo",
+					"table_type":         "MANAGED",
+					"data_source_format": "ICEBERG",
+					"columns": []map[string]any{
+						{"name": "event_time", "type_text": "timestamp", "nullable": true, "partition_index": 0},
+					},
+				},
+			},
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "ICEBERG"
+		partitions         = ["days(event_time)"]
+		`,
+		InstanceState: map[string]string{
+			"name":         "bar",
+			"catalog_name": "main",
+			"schema_name":  "foo",
+			"table_type":   "MANAGED",
+			"partitions.#": "1",
+			"partitions.0": "days(event_time)",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Read:     true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"partitions": []any{"days(event_time)"},
+	})
+}
+
+func TestResourceSqlTableCreateTable_CheckConstraints(t *testing.T) {
+	executed := []string{}
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		cluster_id         = "existingcluster"
+
+		column {
+		  name      = "id"
+		  type      = "int"
+		}
+		check_constraint {
+		  name       = "positive_id"
+		  expression = "id > 0"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:        "bar",
+					CatalogName: "main",
+					SchemaName:  "foo",
+					TableType:   "MANAGED",
+					ColumnInfos: []SqlColumnInfo{
+						{
+							Name:     "id",
+							Type:     "int",
+							Nullable: true,
+						},
+					},
+					Properties: map[string]string{
+						"delta.constraints.positive_id": "id > 0",
+					},
+				},
+			},
+		}, useExistingClusterForSql...),
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ApplyAndExpectData(t, map[string]any{
+		"check_constraint.#": 1,
+	})
+	assert.Equal(t, []string{
+		"CREATE TABLE `main`.`foo`.`bar` (`id` int);",
+		"ALTER TABLE `main`.`foo`.`bar` ADD CONSTRAINT `positive_id` CHECK (id > 0)",
+	}, executed)
+}
+
 func TestResourceSqlTableSerializeProperties(t *testing.T) {
 	ti := &SqlTableInfo{
 		Properties: map[string]string{
@@ -206,6 +1826,189 @@ func TestResourceSqlTableCreateTable(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestResourceSqlTableCreateTable_EffectiveSql(t *testing.T) {
+	var executed []string
+	d, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		column {
+		  name = "id"
+		  type = "int"
+		}
+		check_constraint {
+		  name       = "positive_id"
+		  expression = "id > 0"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+				},
+			},
+		}, useExistingClusterForSql...),
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Len(t, executed, 2)
+	assert.Equal(t, strings.Join(executed, ";\n"), d.Get("effective_sql"))
+}
+
+func TestResourceSqlTable_EffectiveSqlOnRecreate(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		owner        = "testuser"
+		options = {
+			path = "s3://bucket/new"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":                             "bar",
+			"catalog_name":                     "main",
+			"schema_name":                      "foo",
+			"table_type":                       "MANAGED",
+			"cluster_id":                       "existingcluster",
+			"column.#":                         "0",
+			"owner":                            "testuser",
+			"options.%":                        "1",
+			"options.path":                     "s3://bucket/old",
+			"effective_properties.%":           "1",
+			"effective_properties.option.path": "s3://bucket/old",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"name":                             {Old: "bar", New: "bar"},
+			"catalog_name":                     {Old: "main", New: "main"},
+			"schema_name":                      {Old: "foo", New: "foo"},
+			"table_type":                       {Old: "MANAGED", New: "MANAGED"},
+			"cluster_id":                       {Old: "existingcluster", New: "existingcluster"},
+			"owner":                            {Old: "testuser", New: "testuser"},
+			"column.#":                         {Old: "0", NewComputed: true},
+			"options.%":                        {Old: "1", New: "1"},
+			"options.path":                     {Old: "s3://bucket/old", New: "s3://bucket/new", RequiresNew: true},
+			"effective_properties.%":           {Old: "1", New: "1"},
+			"effective_properties.option.path": {Old: "s3://bucket/old", New: "s3://bucket/new"},
+			"effective_sql": {
+				Old: "",
+				New: "CREATE TABLE `main`.`foo`.`bar`\nOPTIONS ('path'='s3://bucket/new');",
+			},
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSqlTableCreateTable_DefaultOwner(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "",
+				Data:       nil,
+			}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+
+		column {
+		  name      = "id"
+		  type      = "int"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ExpectedRequest: catalog.UpdateTableRequest{
+					Owner: "data engineers",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+					Owner:            "data engineers",
+				},
+			},
+		}, useExistingClusterForSql...),
+		DefaultOwner: "data engineers",
+		Create:       true,
+		Resource:     ResourceSqlTable(),
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "data engineers", d.Get("owner"))
+}
+
+func TestResourceSqlTableCreateTable_DefaultOwnerError(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "",
+				Data:       nil,
+			}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+
+		column {
+		  name      = "id"
+		  type      = "int"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ExpectedRequest: catalog.UpdateTableRequest{
+					Owner: "data engineers",
+				},
+				Response: common.APIErrorBody{
+					ErrorCode: "PERMISSION_DENIED",
+					Message:   "User doesn't have MANAGE on table",
+				},
+				Status: 403,
+			},
+		}, useExistingClusterForSql...),
+		DefaultOwner: "data engineers",
+		Create:       true,
+		Resource:     ResourceSqlTable(),
+	}.Apply(t)
+	assert.EqualError(t, err, "User doesn't have MANAGE on table")
+	// the table is kept in the state, so that it isn't orphaned
+	assert.Equal(t, "main.foo.bar", d.Id())
+}
+
 func TestResourceSqlTableCreateTableWithOwner(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
@@ -522,6 +2325,75 @@ func TestResourceSqlTableUpdateTableAndOwner(t *testing.T) {
 	assert.Equal(t, "bar", d.Get("name"))
 }
 
+func TestResourceSqlTableUpdateOwnerOnly(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			t.Errorf("unexpected SQL statement: %s", commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		warehouse_id       = "stopped"
+		column {
+			name      = "one"
+			type      = "string"
+		}
+		owner = "new group"
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"warehouse_id":       "stopped",
+			"column.#":           "1",
+			"column.0.name":      "one",
+			"column.0.type":      "string",
+			"column.0.nullable":  "true",
+			"owner":              "old group",
+		},
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ExpectedRequest: catalog.UpdateTableRequest{
+					Owner: "new group",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+					ColumnInfos: []SqlColumnInfo{
+						{
+							Name:     "one",
+							Type:     "string",
+							Nullable: true,
+						},
+					},
+					Owner: "new group",
+				},
+			},
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.Apply(t)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new group", d.Get("owner"))
+}
+
 func TestResourceSqlTableUpdateTableClusterKeys(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
@@ -745,10 +2617,176 @@ func TestResourceSqlTableDeleteTable(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestResourceSqlTableDeleteTable_Purge(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			assert.Equal(t, "DROP TABLE `main`.`foo`.`bar` PURGE", commandStr)
+			return common.CommandResults{}
+		},
+		Resource: ResourceSqlTable(),
+		State: map[string]any{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "MANAGED",
+			"data_source_format": "DELTA",
+			"cluster_id":         "existingcluster",
+			"purge_on_destroy":   true,
+		},
+		Fixtures: useExistingClusterForSql,
+		Delete:   true,
+		ID:       "main.foo.bar",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSqlTableCreateTable_Undrop(t *testing.T) {
+	var executed []string
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		undrop_on_create   = true
+		comment            = "restored"
+		column {
+		  name = "id"
+		  type = "int"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+					ColumnInfos: []SqlColumnInfo{
+						{
+							Name:     "id",
+							Type:     "int",
+							Nullable: true,
+						},
+					},
+				},
+			},
+		}, useExistingClusterForSql...),
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ApplyNoError(t)
+	assert.Equal(t, []string{
+		"UNDROP TABLE `main`.`foo`.`bar`",
+		"COMMENT ON TABLE `main`.`foo`.`bar` IS 'restored'",
+	}, executed)
+}
+
+func TestResourceSqlTableCreateTable_UndropFallsBackToCreate(t *testing.T) {
+	var executed []string
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			if strings.HasPrefix(commandStr, "UNDROP") {
+				return common.CommandResults{
+					ResultType: "error",
+					Summary:    "[TABLE_OR_VIEW_NOT_FOUND] The table cannot be found",
+				}
+			}
+			return common.CommandResults{}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		undrop_on_create   = true
+		column {
+		  name = "id"
+		  type = "int"
+		}
+		`,
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "MANAGED",
+					DataSourceFormat: "DELTA",
+				},
+			},
+		}, useExistingClusterForSql...),
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ApplyNoError(t)
+	assert.Len(t, executed, 2)
+	assert.Equal(t, "UNDROP TABLE `main`.`foo`.`bar`", executed[0])
+	assert.True(t, strings.HasPrefix(executed[1], "CREATE TABLE `main`.`foo`.`bar`"), executed[1])
+}
+
+func TestResourceSqlTableCreateTable_UndropFailsOnOtherErrors(t *testing.T) {
+	var executed []string
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "[INSUFFICIENT_PERMISSIONS] User does not have MANAGE on Table",
+			}
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		cluster_id         = "existingcluster"
+		undrop_on_create   = true
+		column {
+		  name = "id"
+		  type = "int"
+		}
+		`,
+		Fixtures: useExistingClusterForSql,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "cannot undrop main.foo.bar: cannot execute UNDROP TABLE `main`.`foo`.`bar`: "+
+		"[INSUFFICIENT_PERMISSIONS] User does not have MANAGE on Table")
+	assert.Equal(t, []string{"UNDROP TABLE `main`.`foo`.`bar`"}, executed)
+}
+
+func TestResourceSqlTable_PurgeOnDestroyOnlyForTables(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name             = "barview"
+		catalog_name     = "main"
+		schema_name      = "foo"
+		table_type       = "VIEW"
+		view_definition  = "SELECT 1"
+		purge_on_destroy = true
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "purge_on_destroy is only supported for MANAGED and EXTERNAL tables")
+}
+
 func TestResourceSqlTableUpdateView_Definition(t *testing.T) {
 	qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
-			assert.NotContains(t, commandStr, "COMMENT ON VIEW", "Changing comments on a VIEW requires new. Resource should not attempt to alter comments via SQL command")
+			assert.NotContains(t, commandStr, "COMMENT ON VIEW", "Comment of the VIEW isn't changed")
 			return common.CommandResults{
 				ResultType: "",
 				Data:       nil,
@@ -808,23 +2846,20 @@ func TestResourceSqlTableUpdateView_Definition(t *testing.T) {
 }
 
 func TestResourceSqlTableUpdateView_Comments(t *testing.T) {
-	d, err := qa.ResourceFixture{
+	var executed []string
+	qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
-			assert.NotContains(t, commandStr, "COMMENT ON VIEW", "Changing comments on a VIEW requires new. Resource should not attempt to alter comments via SQL command")
-			return common.CommandResults{
-				ResultType: "",
-				Data:       nil,
-			}
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
 		},
 		HCL: `
 		name               = "barview"
 		catalog_name       = "main"
 		schema_name        = "foo"
 		table_type         = "VIEW"
-		comment 		   = "this table is managed by terraform"
+		comment 		   = "this view's managed by terraform"
 		cluster_id         = "existingcluster"
 		view_definition    = "SELECT * FROM main.foo.bar"
-
 		`,
 		InstanceState: map[string]string{
 			"name":            "barview",
@@ -834,6 +2869,45 @@ func TestResourceSqlTableUpdateView_Comments(t *testing.T) {
 			"comment":         "terraform managed",
 			"view_definition": "SELECT * FROM main.foo.bar",
 		},
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.barview",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:           "barview",
+					CatalogName:    "main",
+					SchemaName:     "foo",
+					TableType:      "VIEW",
+					ViewDefinition: "SELECT * FROM main.foo.bar",
+					Comment:        "terraform managed",
+				},
+			},
+		}, useExistingClusterForSql...),
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.barview",
+	}.ApplyNoError(t)
+	assert.Equal(t, []string{"COMMENT ON VIEW `main`.`foo`.`barview` IS 'this view\\'s managed by terraform'"}, executed)
+}
+
+func TestResourceSqlTableCreateView_SchemaBinding(t *testing.T) {
+	var executed []string
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name            = "barview"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "VIEW"
+		cluster_id      = "existingcluster"
+		schema_binding  = "EVOLUTION"
+		comment         = "evolving"
+		view_definition = "SELECT * FROM main.foo.bar"
+		`,
 		Fixtures: append([]qa.HTTPFixture{
 			{
 				Method:   "GET",
@@ -844,25 +2918,179 @@ func TestResourceSqlTableUpdateView_Comments(t *testing.T) {
 					SchemaName:     "foo",
 					TableType:      "VIEW",
 					ViewDefinition: "SELECT * FROM main.foo.bar",
-					Comment:        "to be changed (requires new)",
+					Comment:        "evolving",
 				},
 			},
+		}, useExistingClusterForSql...),
+		Resource: ResourceSqlTable(),
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schema_binding": "EVOLUTION",
+	})
+	assert.Equal(t, []string{"CREATE VIEW `main`.`foo`.`barview`\nWITH SCHEMA EVOLUTION\nCOMMENT 'evolving'\nAS SELECT * FROM main.foo.bar;"}, executed)
+}
+
+func TestResourceSqlTableUpdateView_SchemaBinding(t *testing.T) {
+	var executed []string
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			executed = append(executed, commandStr)
+			return common.CommandResults{}
+		},
+		HCL: `
+		name            = "barview"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "VIEW"
+		cluster_id      = "existingcluster"
+		schema_binding  = "COMPENSATION"
+		comment         = "compensating"
+		view_definition = "SELECT * FROM main.foo.bar"
+		`,
+		InstanceState: map[string]string{
+			"name":            "barview",
+			"catalog_name":    "main",
+			"schema_name":     "foo",
+			"table_type":      "VIEW",
+			"cluster_id":      "existingcluster",
+			"schema_binding":  "BINDING",
+			"comment":         "binding",
+			"view_definition": "SELECT * FROM main.foo.bar",
+		},
+		Fixtures: append([]qa.HTTPFixture{
 			{
-				Method:   "POST",
-				Resource: "/api/2.0/clusters/start",
-				ExpectedRequest: clusters.ClusterID{
-					ClusterID: "existingcluster",
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.barview",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:           "barview",
+					CatalogName:    "main",
+					SchemaName:     "foo",
+					TableType:      "VIEW",
+					ViewDefinition: "SELECT * FROM main.foo.bar",
+					Comment:        "binding",
 				},
-				Status: 404,
 			},
-		}, createClusterForSql...),
-		Resource:    ResourceSqlTable(),
-		Create:      true,
-		RequiresNew: true,
-	}.Apply(t)
+		}, useExistingClusterForSql...),
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.barview",
+	}.ApplyNoError(t)
+	assert.Equal(t, []string{"CREATE OR REPLACE VIEW `main`.`foo`.`barview`\nWITH SCHEMA COMPENSATION\nCOMMENT 'compensating'\nAS SELECT * FROM main.foo.bar;"}, executed)
+}
 
-	assert.NoError(t, err)
-	assert.Equal(t, "barview", d.Get("name"))
+func TestResourceSqlTable_SchemaBindingOnlyForViews(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name           = "bar"
+		catalog_name   = "main"
+		schema_name    = "foo"
+		table_type     = "MANAGED"
+		schema_binding = "BINDING"
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "schema_binding is only supported for VIEW, not MANAGED")
+}
+
+func TestResourceSqlTable_ChangeDataFeedOnView(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name            = "barview"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "VIEW"
+		view_definition = "SELECT * FROM main.foo.bar"
+		properties = {
+			"delta.enableChangeDataFeed" = "true"
+		}
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "delta.enableChangeDataFeed is only supported for Delta tables, not VIEW")
+}
+
+func TestResourceSqlTable_DeltaPropertiesOnNonDeltaTable(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "EXTERNAL"
+		data_source_format = "CSV"
+		storage_location   = "s3://ext-main/foo/bar"
+		properties = {
+			"delta.appendOnly" = "true"
+		}
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "delta.appendOnly is only supported for DELTA tables, not CSV")
+}
+
+func TestResourceSqlTable_ClusterKeysOnNonDeltaTable(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "PARQUET"
+		cluster_keys       = ["id"]
+		column {
+			name = "id"
+			type = "int"
+		}
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "cluster_keys are only supported for DELTA tables, not PARQUET")
+}
+
+func TestResourceSqlTable_ColumnDefaultsOnNonDeltaTable(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "PARQUET"
+		column {
+			name    = "id"
+			type    = "int"
+			default = "0"
+		}
+		`,
+		Create:   true,
+		Resource: ResourceSqlTable(),
+	}.ExpectError(t, "column defaults are only supported for DELTA tables, not PARQUET")
+}
+
+func TestResourceSqlTableUpdateMaterializedView_CommentRequiresNew(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		HCL: `
+		name            = "barview"
+		catalog_name    = "main"
+		schema_name     = "foo"
+		table_type      = "MATERIALIZED_VIEW"
+		comment         = "new comment"
+		cluster_id      = "existingcluster"
+		view_definition = "SELECT * FROM main.foo.bar"
+		`,
+		InstanceState: map[string]string{
+			"name":            "barview",
+			"catalog_name":    "main",
+			"schema_name":     "foo",
+			"table_type":      "MATERIALIZED_VIEW",
+			"comment":         "old comment",
+			"cluster_id":      "existingcluster",
+			"view_definition": "SELECT * FROM main.foo.bar",
+		},
+		Resource: ResourceSqlTable(),
+		Update:   true,
+		ID:       "main.foo.barview",
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: comment")
 }
 
 func getColumnsInstanceState(columns []SqlColumnInfo) map[string]string {
@@ -967,9 +3195,108 @@ func TestResourceSqlTableUpdateTable_Columns(t *testing.T) {
 			oldColumns: []SqlColumnInfo{
 				{
 					Name:     "one",
-					Type:     "string",
-					Comment:  "old comment",
-					Nullable: false,
+					Type:     "string",
+					Comment:  "old comment",
+					Nullable: false,
+				},
+				{
+					Name:     "two",
+					Type:     "string",
+					Nullable: true,
+				},
+			},
+			newColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "string",
+					Comment:  "new comment", // Updated comment
+					Nullable: true,
+				},
+				{
+					Name:     "three",
+					Type:     "string",
+					Nullable: true,
+				},
+			},
+			allowedCommands: []string{
+				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` COMMENT 'new comment'",
+				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` DROP NOT NULL",
+				"ALTER TABLE `main`.`foo`.`bar` RENAME COLUMN `two` to `three`",
+			},
+			expectedErrorMsg: "",
+		},
+	)
+}
+
+func TestResourceSqlTableUpdateTable_ColumnsTypeThrowsError(t *testing.T) {
+	resourceSqlTableUpdateColumnHelper(t,
+		resourceSqlTableUpdateColumnTestMetaData{
+			oldColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "string",
+					Comment:  "old comment",
+					Nullable: false,
+				},
+			},
+			newColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "int", // Updated type.
+					Comment:  "old comment",
+					Nullable: true,
+				},
+			},
+			allowedCommands:  []string{},
+			expectedErrorMsg: "changing the 'type' of an existing column is not supported",
+		},
+	)
+}
+
+func TestResourceSqlTableUpdateTable_ColumnsTypeWidening(t *testing.T) {
+	resourceSqlTableUpdateColumnHelper(t,
+		resourceSqlTableUpdateColumnTestMetaData{
+			oldColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "int",
+					Nullable: true,
+				},
+				{
+					Name:     "two",
+					Type:     "decimal(10,2)",
+					Nullable: true,
+				},
+			},
+			newColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "bigint", // Widened type.
+					Nullable: true,
+				},
+				{
+					Name:     "two",
+					Type:     "decimal(12,4)", // Widened type.
+					Nullable: true,
+				},
+			},
+			allowedCommands: []string{
+				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` TYPE bigint",
+				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `two` TYPE decimal(12,4)",
+			},
+			expectedErrorMsg: "",
+		},
+	)
+}
+
+func TestResourceSqlTableUpdateTable_ColumnsReorder(t *testing.T) {
+	resourceSqlTableUpdateColumnHelper(t,
+		resourceSqlTableUpdateColumnTestMetaData{
+			oldColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "int",
+					Nullable: true,
 				},
 				{
 					Name:     "two",
@@ -979,43 +3306,89 @@ func TestResourceSqlTableUpdateTable_Columns(t *testing.T) {
 			},
 			newColumns: []SqlColumnInfo{
 				{
-					Name:     "one",
+					Name:     "two",
 					Type:     "string",
-					Comment:  "new comment", // Updated comment
+					Comment:  "moved",
 					Nullable: true,
 				},
 				{
-					Name:     "three",
-					Type:     "string",
+					Name:     "one",
+					Type:     "int",
 					Nullable: true,
 				},
 			},
-			allowedCommands: []string{
-				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` COMMENT 'new comment'",
-				"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` DROP NOT NULL",
-				"ALTER TABLE `main`.`foo`.`bar` RENAME COLUMN `two` to `three`",
-			},
-			expectedErrorMsg: "",
+			allowedCommands: []string{},
+			expectedErrorMsg: "columns of `main`.`foo`.`bar` could only be reordered with column mapping. " +
+				"Set delta.columnMapping.mode = \"name\" in properties first, it upgrades the Delta protocol of the table",
 		},
 	)
 }
 
-func TestResourceSqlTableUpdateTable_ColumnsTypeThrowsError(t *testing.T) {
+func TestResourceSqlTableDiff_ColumnsReorder(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "int"}, {Name: "b", Type: "int"}, {Name: "c", Type: "int"}, {Name: "d", Type: "int"},
+		},
+		EffectiveProperties: map[string]string{"delta.columnMapping.mode": "name"},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "a", Type: "int"}, {Name: "d", Type: "int"}, {Name: "b", Type: "int"}, {Name: "c", Type: "int"},
+		},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `d` AFTER `a`",
+	}, statements)
+}
+
+func TestResourceSqlTableDiff_ColumnsReorderEnablesColumnMapping(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{{Name: "a", Type: "int"}, {Name: "b", Type: "int"}},
+	}
+	ti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ColumnInfos: []SqlColumnInfo{{Name: "b", Type: "int"}, {Name: "a", Type: "int"}},
+		Properties:  map[string]string{"delta.columnMapping.mode": "name"},
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `main`.`foo`.`bar` SET TBLPROPERTIES ('delta.columnMapping.mode'='name')",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `b` FIRST",
+	}, statements)
+}
+
+func TestResourceSqlTableUpdateTable_ColumnsTypeNarrowingThrowsError(t *testing.T) {
 	resourceSqlTableUpdateColumnHelper(t,
 		resourceSqlTableUpdateColumnTestMetaData{
 			oldColumns: []SqlColumnInfo{
 				{
 					Name:     "one",
-					Type:     "string",
-					Comment:  "old comment",
-					Nullable: false,
+					Type:     "bigint",
+					Nullable: true,
 				},
 			},
 			newColumns: []SqlColumnInfo{
 				{
 					Name:     "one",
-					Type:     "int", // Updated type.
-					Comment:  "old comment",
+					Type:     "int", // Narrowed type.
 					Nullable: true,
 				},
 			},
@@ -1025,6 +3398,22 @@ func TestResourceSqlTableUpdateTable_ColumnsTypeThrowsError(t *testing.T) {
 	)
 }
 
+func TestIsColumnTypeWidening(t *testing.T) {
+	assert.True(t, isColumnTypeWidening("int", "bigint"))
+	assert.True(t, isColumnTypeWidening("INTEGER", "long"))
+	assert.True(t, isColumnTypeWidening("byte", "short"))
+	assert.True(t, isColumnTypeWidening("float", "double"))
+	assert.True(t, isColumnTypeWidening("date", "timestamp_ntz"))
+	assert.True(t, isColumnTypeWidening("decimal", "decimal(12,2)"))
+	assert.True(t, isColumnTypeWidening("decimal(5, 2)", "decimal(7,3)"))
+	assert.True(t, isColumnTypeWidening("int", "decimal(10,0)"))
+	assert.False(t, isColumnTypeWidening("bigint", "int"))
+	assert.False(t, isColumnTypeWidening("double", "float"))
+	assert.False(t, isColumnTypeWidening("string", "int"))
+	assert.False(t, isColumnTypeWidening("decimal(10,2)", "decimal(10,4)"))
+	assert.False(t, isColumnTypeWidening("int", "decimal(9,0)"))
+}
+
 func TestResourceSqlTableUpdateTable_ColumnsTypeUpperLowerCaseThrowsError(t *testing.T) {
 	resourceSqlTableUpdateColumnHelper(t,
 		resourceSqlTableUpdateColumnTestMetaData{
@@ -1139,7 +3528,7 @@ func TestResourceSqlTableUpdateTable_AddColumn(t *testing.T) {
 				},
 			},
 			allowedCommands: []string{
-				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `two` string AFTER one",
+				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `two` string AFTER `one`",
 			},
 			expectedErrorMsg: "",
 		},
@@ -1185,8 +3574,8 @@ func TestResourceSqlTableUpdateTable_AddMultipleColumns(t *testing.T) {
 			},
 			allowedCommands: []string{
 				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `zero` string COMMENT 'managed comment' FIRST",
-				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `two` string COMMENT 'managed comment' AFTER one",
-				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `three` string COMMENT 'managed comment' AFTER two",
+				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `two` string COMMENT 'managed comment' AFTER `one`",
+				"ALTER TABLE `main`.`foo`.`bar` ADD COLUMN `three` string COMMENT 'managed comment' AFTER `two`",
 			},
 			expectedErrorMsg: "",
 		},
@@ -1300,7 +3689,7 @@ func TestResourceSqlTableCreateTable_ExistingSQLWarehouse(t *testing.T) {
 					Statement:     "CREATE TABLE `main`.`foo`.`bar` (`id` int, `name` string COMMENT 'name of thing')\nUSING DELTA\nCOMMENT 'this table is managed by terraform'\nLOCATION 'abfss://container@account/somepath';",
 					WaitTimeout:   "50s",
 					WarehouseId:   "existingwarehouse",
-					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
 				},
 				Response: sql.StatementResponse{
 					StatementId: "statement1",
@@ -1366,7 +3755,7 @@ func TestResourceSqlTableCreateTable_OnlyManagedProperties(t *testing.T) {
 					Statement:     "CREATE TABLE `main`.`foo`.`bar` (`id` int)\nUSING DELTA\nTBLPROPERTIES ('delta.enableDeletionVectors'='false');",
 					WaitTimeout:   "50s",
 					WarehouseId:   "existingwarehouse",
-					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
 				},
 				Response: sql.StatementResponse{
 					StatementId: "statement1",
@@ -1488,6 +3877,81 @@ func TestResourceSqlTable_Diff_ExistingResource(t *testing.T) {
 				"effective_properties.option.myopt": {New: "myval", Old: "otherval"},
 			},
 		},
+		{
+			"existing UniForm table with properties upgraded by the server",
+			`uniform_iceberg = true
+			properties = {
+			    "delta.minWriterVersion" = "5"
+			}`,
+			map[string]string{
+				"uniform_iceberg":                                           "true",
+				"properties.%":                                              "1",
+				"properties.delta.minWriterVersion":                         "5",
+				"effective_properties.%":                                    "4",
+				"effective_properties.delta.minWriterVersion":               "7",
+				"effective_properties.delta.columnMapping.mode":             "name",
+				"effective_properties.delta.enableIcebergCompatV2":          "true",
+				"effective_properties.delta.universalFormat.enabledFormats": "iceberg",
+			},
+			nil,
+		},
+		{
+			"existing resource with properties normalized or upgraded by the server",
+			`properties = {
+			    "delta.minReaderVersion" = "1"
+			    "delta.feature.deletionVectors" = "supported"
+			    "delta.enableChangeDataFeed" = "TRUE"
+			}`,
+			map[string]string{
+				"properties.%":                                       "3",
+				"properties.delta.minReaderVersion":                  "1",
+				"properties.delta.feature.deletionVectors":           "supported",
+				"properties.delta.enableChangeDataFeed":              "TRUE",
+				"effective_properties.%":                             "3",
+				"effective_properties.delta.minReaderVersion":        "3",
+				"effective_properties.delta.feature.deletionVectors": "enabled",
+				"effective_properties.delta.enableChangeDataFeed":    "true",
+			},
+			nil,
+		},
+		{
+			"existing resource with protocol version lower than configured",
+			`properties = {
+			    "delta.minWriterVersion" = "7"
+			}`,
+			map[string]string{
+				"properties.%":                                "1",
+				"properties.delta.minWriterVersion":           "7",
+				"effective_properties.%":                      "1",
+				"effective_properties.delta.minWriterVersion": "5",
+			},
+			map[string]*terraform.ResourceAttrDiff{
+				"effective_properties.delta.minWriterVersion": {New: "7", Old: "5"},
+			},
+		},
+		{
+			"existing resource with ignored properties",
+			`ignored_properties = ["pipelines.*", "option.myopt"]
+			properties = {
+			    "pipelines.autoOptimize.zOrderCols" = "a"
+			}
+			options = {
+				"myopt" = "myval"
+			}`,
+			map[string]string{
+				"ignored_properties.#":                         "2",
+				"ignored_properties.0":                         "pipelines.*",
+				"ignored_properties.1":                         "option.myopt",
+				"properties.%":                                 "1",
+				"properties.pipelines.autoOptimize.zOrderCols": "a",
+				"options.%":                                    "1",
+				"options.myopt":                                "myval",
+				"effective_properties.%":                       "2",
+				"effective_properties.pipelines.autoOptimize.zOrderCols": "a,b",
+				"effective_properties.option.myopt":                      "otherval",
+			},
+			nil,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -1657,11 +4121,103 @@ func TestParseComment_unescapedquote(t *testing.T) {
 	assert.Equal(t, `Comment with\' unescaped quotes \'`, prsd)
 }
 
+func TestSqlTablesAPI_getTable_Constraints(t *testing.T) {
+	client, _, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+			Response: catalog.TableInfo{
+				Name:        "bar",
+				CatalogName: "main",
+				SchemaName:  "foo",
+				TableConstraints: []catalog.TableConstraint{
+					{
+						PrimaryKeyConstraint: &catalog.PrimaryKeyConstraint{
+							Name:         "bar_pk",
+							ChildColumns: []string{"id"},
+						},
+					},
+					{
+						ForeignKeyConstraint: &catalog.ForeignKeyConstraint{
+							Name:          "bar_parent_fk",
+							ChildColumns:  []string{"parent_id"},
+							ParentTable:   "main.foo.parent",
+							ParentColumns: []string{"id"},
+						},
+					},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	actual, err := NewSqlTablesAPI(context.Background(), client).getTable("main.foo.bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", actual.Name)
+	assert.Equal(t, &SqlPrimaryKeyInfo{Name: "bar_pk", Columns: []string{"id"}}, actual.PrimaryKey)
+	assert.Equal(t, []SqlForeignKeyInfo{
+		{
+			Name:          "bar_parent_fk",
+			Columns:       []string{"parent_id"},
+			ParentTable:   "main.foo.parent",
+			ParentColumns: []string{"id"},
+		},
+	}, actual.ForeignKeys)
+}
+
+func TestSqlTablesAPI_getTable_Columns(t *testing.T) {
+	client, _, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.foo.bar",
+			Response: catalog.TableInfo{
+				Name:        "bar",
+				CatalogName: "main",
+				SchemaName:  "foo",
+				Columns: []catalog.ColumnInfo{
+					{
+						Name:     "id",
+						TypeText: "int",
+						TypeJson: `{"name":"id","type":"integer","nullable":false,"metadata":{}}`,
+					},
+					{
+						Name:     "code",
+						TypeText: "string collate UTF8_LCASE",
+						Nullable: true,
+					},
+					{
+						Name:     "status",
+						TypeText: "string",
+						Nullable: true,
+						Comment:  "status",
+						Mask: &catalog.ColumnMask{
+							FunctionName:     "main.default.mask_status",
+							UsingColumnNames: []string{"id"},
+						},
+						TypeJson: `{"name":"status","type":"string","nullable":true,"metadata":{"CURRENT_DEFAULT":"'new'","comment":"status"}}`,
+					},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	actual, err := NewSqlTablesAPI(context.Background(), client).getTable("main.foo.bar")
+	assert.NoError(t, err)
+	assert.Equal(t, []SqlColumnInfo{
+		{Name: "id", Type: "int"},
+		{Name: "code", Type: "string", Nullable: true, Collation: "UTF8_LCASE"},
+		{Name: "status", Type: "string", Nullable: true, Comment: "status", Default: "'new'", Mask: &SqlColumnMask{
+			FunctionName:     "main.default.mask_status",
+			UsingColumnNames: []string{"id"},
+		}},
+	}, actual.ColumnInfos)
+}
+
 func TestSqlTablesAPI_getTable_OptionsAndParametersProcessedCorrectly(t *testing.T) {
 	testCases := []struct {
 		name                        string
 		apiResponse                 SqlTableInfo
 		expectedEffectiveProperties map[string]string
+		expectedOptions             map[string]string
 	}{
 		{
 			name:                        "no properties or options",
@@ -1688,6 +4244,9 @@ func TestSqlTablesAPI_getTable_OptionsAndParametersProcessedCorrectly(t *testing
 				"myprop":       "myval",
 				"option.myopt": "myval",
 			},
+			expectedOptions: map[string]string{
+				"myopt": "myval",
+			},
 		},
 	}
 
@@ -1706,7 +4265,548 @@ func TestSqlTablesAPI_getTable_OptionsAndParametersProcessedCorrectly(t *testing
 			assert.NoError(t, err)
 			assert.Equal(t, testCase.expectedEffectiveProperties, actual.EffectiveProperties)
 			assert.Nil(t, actual.Properties)
-			assert.Nil(t, actual.Options)
+			assert.Equal(t, testCase.expectedOptions, actual.Options)
+		})
+	}
+}
+
+func TestSqlTableExecuteStatement_LongRunning(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			ExpectedRequest: sql.ExecuteStatementRequest{
+				Statement:     "CREATE TABLE `main`.`foo`.`bar` AS SELECT * FROM range(1000000000)",
+				WaitTimeout:   "50s",
+				WarehouseId:   "abc",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+			},
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: "RUNNING"},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/statements/s1?",
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: "SUCCEEDED"},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		assert.NoError(t, err)
+		ti := &SqlTableInfo{WarehouseID: "abc", sqlExec: w.StatementExecution}
+		err = ti.applySql(ctx, "CREATE TABLE `main`.`foo`.`bar` AS SELECT * FROM range(1000000000)")
+		assert.NoError(t, err)
+	})
+}
+
+func TestSqlTableExecuteStatement_TimeoutCancels(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: "PENDING"},
+			},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/sql/statements/s1?",
+			ReuseRequest: true,
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: "RUNNING"},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/s1/cancel",
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		assert.NoError(t, err)
+		ti := &SqlTableInfo{WarehouseID: "abc", sqlExec: w.StatementExecution}
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		err = ti.applySql(ctx, "SELECT 1")
+		assert.ErrorContains(t, err, "statement s1 is RUNNING")
+	})
+}
+
+func TestSqlTableApplySql_RestartsTerminatedCluster(t *testing.T) {
+	terminated := clusters.ClusterInfo{ClusterID: "abc", State: clusters.ClusterStateTerminated}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Response: terminated,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Response: terminated,
+		},
+		{
+			Method:          "POST",
+			Resource:        "/api/2.0/clusters/start",
+			ExpectedRequest: clusters.ClusterID{ClusterID: "abc"},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			ReuseRequest: true,
+			Response:     clusters.ClusterInfo{ClusterID: "abc", State: clusters.ClusterStateRunning},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		calls := 0
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			calls++
+			if calls == 1 {
+				return common.CommandResults{
+					ResultType: "error",
+					Summary:    "Cluster abc has to be running or resizing, but is TERMINATED",
+				}
+			}
+			return common.CommandResults{}
+		})
+		clustersAPI := clusters.NewClustersAPI(ctx, client)
+		ti := &SqlTableInfo{
+			ClusterID:   "abc",
+			exec:        client.CommandExecutor(ctx),
+			clustersAPI: &clustersAPI,
+		}
+		err := ti.applySql(ctx, "DROP TABLE `main`.`foo`.`bar`")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestSqlTableApplySql_NoRetryOnRunningCluster(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{ClusterID: "abc", State: clusters.ClusterStateRunning},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		calls := 0
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			calls++
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "[PARSE_SYNTAX_ERROR] Syntax error",
+			}
 		})
+		clustersAPI := clusters.NewClustersAPI(ctx, client)
+		ti := &SqlTableInfo{
+			ClusterID:   "abc",
+			exec:        client.CommandExecutor(ctx),
+			clustersAPI: &clustersAPI,
+		}
+		err := ti.applySql(ctx, "DROP TABLE")
+		assert.ErrorContains(t, err, "cannot execute DROP TABLE: [PARSE_SYNTAX_ERROR] Syntax error")
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestSqlTableApplySql_WaitsForStartingWarehouse(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status: &sql.StatementStatus{
+					State: "FAILED",
+					Error: &sql.ServiceError{Message: "warehouse is starting"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/warehouses/abc?",
+			Response: sql.GetWarehouseResponse{Id: "abc", State: sql.StateStarting},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/warehouses/abc?",
+			Response: sql.GetWarehouseResponse{Id: "abc", State: sql.StateRunning},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.StatementResponse{
+				StatementId: "s2",
+				Status:      &sql.StatementStatus{State: "SUCCEEDED"},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		assert.NoError(t, err)
+		ti := &SqlTableInfo{
+			WarehouseID: "abc",
+			sqlExec:     w.StatementExecution,
+			warehouses:  w.Warehouses,
+		}
+		err = ti.applySql(ctx, "DROP TABLE `main`.`foo`.`bar`")
+		assert.NoError(t, err)
+	})
+}
+
+func TestSqlTableApplySql_StartsStoppedWarehouse(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: "FAILED"},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/warehouses/abc?",
+			Response: sql.GetWarehouseResponse{Id: "abc", State: sql.StateStopped},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/warehouses/abc/start",
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/warehouses/abc?",
+			Response: sql.GetWarehouseResponse{Id: "abc", State: sql.StateRunning},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.StatementResponse{
+				StatementId: "s2",
+				Status:      &sql.StatementStatus{State: "SUCCEEDED"},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		assert.NoError(t, err)
+		ti := &SqlTableInfo{
+			WarehouseID: "abc",
+			sqlExec:     w.StatementExecution,
+			warehouses:  w.Warehouses,
+		}
+		err = ti.applySql(ctx, "DROP TABLE `main`.`foo`.`bar`")
+		assert.NoError(t, err)
+	})
+}
+
+func TestResourceSqlTableCreateTable_DisallowImplicitCompute(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "MANAGED"
+		data_source_format = "DELTA"
+		column {
+			name = "id"
+			type = "int"
+		}
+		`,
+		DisallowImplicitCompute: true,
+		Create:                  true,
+		Resource:                ResourceSqlTable(),
+	}.ExpectError(t, "creation of the default terraform-sql-table cluster is disallowed by "+
+		"disallow_implicit_compute: specify cluster_id, warehouse_id or use_serverless_warehouse")
+}
+
+func TestResourceSqlTableUpdate_Options(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "EXTERNAL",
+		Options: map[string]string{
+			"header": "false",
+		},
+	}
+	ti := *oldti
+	ti.Options = map[string]string{
+		"header":    "true",
+		"delimiter": ";",
+	}
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `main`.`foo`.`bar` SET OPTIONS ('delimiter'=';', 'header'='true')"}, statements)
+}
+
+func TestOptionsRequireRecreation(t *testing.T) {
+	assert.False(t, optionsRequireRecreation(map[string]any{"header": "false"},
+		map[string]any{"header": "true", "delimiter": ";"}))
+	assert.True(t, optionsRequireRecreation(map[string]any{"header": "false", "delimiter": ";"},
+		map[string]any{"header": "false"}))
+	assert.True(t, optionsRequireRecreation(map[string]any{"path": "s3://a"},
+		map[string]any{"path": "s3://b"}))
+	assert.True(t, optionsRequireRecreation(map[string]any{},
+		map[string]any{"dbtable": "foo"}))
+}
+
+func sqlTableOptionsFixture(options string) qa.ResourceFixture {
+	return qa.ResourceFixture{
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "EXTERNAL"
+		data_source_format = "CSV"
+		storage_location   = "s3://ext/bar"
+		cluster_id         = "existingcluster"
+		owner              = "testuser"
+		` + options,
+		InstanceState: map[string]string{
+			"name":                                  "bar",
+			"catalog_name":                          "main",
+			"schema_name":                           "foo",
+			"table_type":                            "EXTERNAL",
+			"data_source_format":                    "CSV",
+			"storage_location":                      "s3://ext/bar",
+			"cluster_id":                            "existingcluster",
+			"options.%":                             "2",
+			"options.header":                        "false",
+			"options.delimiter":                     ",",
+			"column.#":                              "0",
+			"owner":                                 "testuser",
+			"effective_properties.%":                "2",
+			"effective_properties.option.header":    "false",
+			"effective_properties.option.delimiter": ",",
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+	}
+}
+
+func TestResourceSqlTableOptions_ChangeInPlace(t *testing.T) {
+	fixture := sqlTableOptionsFixture(`options = {
+			header    = "true"
+			delimiter = ","
+		}`)
+	fixture.ExpectedDiff = map[string]*terraform.ResourceAttrDiff{
+		"options.header":                     {Old: "false", New: "true"},
+		"effective_properties.option.header": {Old: "false", New: "true"},
+		"effective_sql": {
+			Old: "",
+			New: "ALTER TABLE `main`.`foo`.`bar` SET OPTIONS ('delimiter'=',', 'header'='true')",
+		},
+	}
+	fixture.ApplyNoError(t)
+}
+
+func TestResourceSqlTableOptions_RemovalRecreates(t *testing.T) {
+	fixture := sqlTableOptionsFixture(`options = {
+			header = "false"
+		}`)
+	fixture.Update = true
+	_, err := fixture.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: options")
+}
+
+func TestResourceSqlTableUpdate_PartitionsToLiquidClustering(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		Partitions:  []string{"university"},
+	}
+	ti := *oldti
+	ti.Partitions = nil
+	ti.ClusterKeys = []string{"university", "major"}
+	statements, err := ti.rewriteStatements(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CREATE OR REPLACE TABLE `main`.`foo`.`bar`\n" +
+		"CLUSTER BY (`university`,`major`)\n" +
+		"AS SELECT * FROM `main`.`foo`.`bar`;"}, statements)
+}
+
+func TestResourceSqlTableUpdate_RemoveClusterKeys(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "MANAGED",
+		ClusterKeys: []string{"university"},
+	}
+	ti := *oldti
+	ti.ClusterKeys = nil
+	statements, err := ti.diff(oldti)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `main`.`foo`.`bar` CLUSTER BY NONE"}, statements)
+}
+
+func sqlTableClusteringFixture(clustering string, state map[string]string) qa.ResourceFixture {
+	instanceState := map[string]string{
+		"name":                   "bar",
+		"catalog_name":           "main",
+		"schema_name":            "foo",
+		"table_type":             "MANAGED",
+		"cluster_id":             "existingcluster",
+		"owner":                  "testuser",
+		"column.#":               "0",
+		"effective_properties.%": "0",
+	}
+	for k, v := range state {
+		instanceState[k] = v
+	}
+	return qa.ResourceFixture{
+		HCL: `
+		name         = "bar"
+		catalog_name = "main"
+		schema_name  = "foo"
+		table_type   = "MANAGED"
+		cluster_id   = "existingcluster"
+		owner        = "testuser"
+		` + clustering,
+		InstanceState: instanceState,
+		Resource:      ResourceSqlTable(),
+		ID:            "main.foo.bar",
+	}
+}
+
+func TestResourceSqlTablePartitionsToLiquidClustering_InPlace(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`cluster_keys = ["university"]`, map[string]string{
+		"partitions.#": "1",
+		"partitions.0": "university",
+	})
+	fixture.ExpectedDiff = map[string]*terraform.ResourceAttrDiff{
+		"partitions.#":   {Old: "1", New: "0"},
+		"partitions.0":   {Old: "university", New: "", NewRemoved: true},
+		"cluster_keys.#": {Old: "0", New: "1"},
+		"cluster_keys.0": {Old: "", New: "university"},
+		"effective_sql": {Old: "", New: "CREATE OR REPLACE TABLE `main`.`foo`.`bar`\n" +
+			"CLUSTER BY (`university`)\nAS SELECT * FROM `main`.`foo`.`bar`;"},
+	}
+	fixture.ApplyNoError(t)
+}
+
+func TestResourceSqlTablePartitionsToLiquidClustering_ExternalTable(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`cluster_keys = ["university"]`, map[string]string{
+		"table_type":       "EXTERNAL",
+		"storage_location": "s3://ext/bar",
+		"partitions.#":     "1",
+		"partitions.0":     "university",
+	})
+	fixture.HCL = strings.Replace(fixture.HCL, `table_type   = "MANAGED"`,
+		`table_type   = "EXTERNAL"
+		storage_location = "s3://ext/bar"`, 1)
+	fixture.Update = true
+	_, err := fixture.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "partitions of main.foo.bar could be replaced with cluster_keys only for MANAGED tables")
+}
+
+func TestResourceSqlTableUpdate_PartitionsToLiquidClusteringExternal(t *testing.T) {
+	oldti := &SqlTableInfo{
+		Name:        "bar",
+		CatalogName: "main",
+		SchemaName:  "foo",
+		TableType:   "EXTERNAL",
+		Partitions:  []string{"university"},
+	}
+	ti := *oldti
+	ti.Partitions = nil
+	ti.ClusterKeys = []string{"university"}
+	_, err := ti.rewriteStatements(oldti)
+	qa.AssertErrorStartsWith(t, err, "partitions of main.foo.bar could be replaced with cluster_keys only for MANAGED tables")
+}
+
+func TestResourceSqlTableLiquidClusteringToPartitions_Recreates(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`partitions = ["university"]`, map[string]string{
+		"cluster_keys.#": "1",
+		"cluster_keys.0": "university",
+	})
+	fixture.Update = true
+	_, err := fixture.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: partitions")
+}
+
+func TestResourceSqlTableLiquidClusteringToPartitions_ReplaceOnChange(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`partitions = ["university"]
+		replace_on_change = true`, map[string]string{
+		"cluster_keys.#":    "1",
+		"cluster_keys.0":    "university",
+		"replace_on_change": "true",
+	})
+	fixture.ExpectedDiff = map[string]*terraform.ResourceAttrDiff{
+		"partitions.#":   {Old: "0", New: "1"},
+		"partitions.0":   {Old: "", New: "university"},
+		"cluster_keys.#": {Old: "1", New: "0"},
+		"cluster_keys.0": {Old: "university", New: "", NewRemoved: true},
+		"effective_sql":  {Old: "", New: "CREATE OR REPLACE TABLE `main`.`foo`.`bar`\nPARTITIONED BY (`university`);"},
+	}
+	fixture.ApplyNoError(t)
+}
+
+func TestResourceSqlTableUpdate_ReplaceOnChange(t *testing.T) {
+	var executed []string
+	fixture := sqlTableClusteringFixture(`partitions = ["university"]
+		replace_on_change = true`, map[string]string{
+		"cluster_keys.#":    "1",
+		"cluster_keys.0":    "university",
+		"replace_on_change": "true",
+	})
+	fixture.Update = true
+	fixture.CommandMock = func(commandStr string) common.CommandResults {
+		executed = append(executed, commandStr)
+		return common.CommandResults{}
+	}
+	fixture.Fixtures = append([]qa.HTTPFixture{
+		{
+			Method:       "GET",
+			Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+			ReuseRequest: true,
+			Response: SqlTableInfo{
+				Name:        "bar",
+				CatalogName: "main",
+				SchemaName:  "foo",
+				TableType:   "MANAGED",
+				ClusterKeys: []string{"university"},
+			},
+		},
+	}, useExistingClusterForSql...)
+	fixture.ApplyNoError(t)
+	assert.Equal(t, []string{"CREATE OR REPLACE TABLE `main`.`foo`.`bar`\nPARTITIONED BY (`university`);"}, executed)
+}
+
+func TestResourceSqlTableReplaceOnChange_NotDelta(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`partitions = ["university"]
+		data_source_format = "CSV"
+		replace_on_change = true`, map[string]string{
+		"cluster_keys.#":     "1",
+		"cluster_keys.0":     "university",
+		"data_source_format": "CSV",
+		"replace_on_change":  "true",
+	})
+	fixture.Update = true
+	_, err := fixture.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "changes require new: partitions")
+}
+
+func TestResourceSqlTableColumnTypeChange_ReplaceOnChange(t *testing.T) {
+	fixture := sqlTableClusteringFixture(`replace_on_change = true
+		column {
+			name = "id"
+			type = "string"
+		}`, map[string]string{
+		"replace_on_change": "true",
+		"column.#":          "1",
+		"column.0.name":     "id",
+		"column.0.type":     "int",
+		"column.0.nullable": "true",
+	})
+	fixture.ExpectedDiff = map[string]*terraform.ResourceAttrDiff{
+		"column.0.type": {Old: "int", New: "string"},
+		"effective_sql": {Old: "", New: "CREATE OR REPLACE TABLE `main`.`foo`.`bar` (`id` string);"},
 	}
+	fixture.ApplyNoError(t)
 }
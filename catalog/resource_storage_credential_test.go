@@ -389,6 +389,92 @@ func TestCreateStorageCredentialCloudflare(t *testing.T) {
 	})
 }
 
+func TestCreateStorageCredentialExportsIdentities(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				ExpectedRequest: catalog.CreateStorageCredential{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRoleRequest{
+						RoleArn: "arn:aws:iam::123456789012:role/uc",
+					},
+					SkipValidation: true,
+				},
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a?",
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRoleResponse{
+						RoleArn:            "arn:aws:iam::123456789012:role/uc",
+						ExternalId:         "0000-1111",
+						UnityCatalogIamArn: "arn:aws:iam::414351767826:role/unity-catalog-prod-UCMasterRole",
+					},
+					MetastoreId: "d",
+					Id:          "1234-5678",
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "arn:aws:iam::123456789012:role/uc"
+		}
+		skip_validation = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"aws_iam_role.0.external_id":           "0000-1111",
+		"aws_iam_role.0.unity_catalog_iam_arn": "arn:aws:iam::414351767826:role/unity-catalog-prod-UCMasterRole",
+	})
+}
+
+func TestCreateStorageCredentialWithDbGcpSAExportsEmail(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				ExpectedRequest: catalog.CreateStorageCredential{
+					Name:                        "a",
+					DatabricksGcpServiceAccount: &catalog.DatabricksGcpServiceAccountRequest{},
+				},
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a?",
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+					DatabricksGcpServiceAccount: &catalog.DatabricksGcpServiceAccountResponse{
+						Email:        "db-uc@prod.iam.gserviceaccount.com",
+						CredentialId: "0000-1111",
+					},
+					MetastoreId: "d",
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		databricks_gcp_service_account {}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"databricks_gcp_service_account.0.email":         "db-uc@prod.iam.gserviceaccount.com",
+		"databricks_gcp_service_account.0.credential_id": "0000-1111",
+	})
+}
+
 func TestUpdateStorageCredentials(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
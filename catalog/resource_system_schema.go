@@ -11,6 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// keepSystemSchemaEnabled returns true, if the schema shouldn't be disabled on destroy, because it was enabled
+// outside of this resource, e.g. by another workspace, that uses the same metastore
+func keepSystemSchemaEnabled(d *schema.ResourceData) bool {
+	return d.Get("already_enabled").(bool) && !d.Get("force_destroy").(bool)
+}
+
 func ResourceSystemSchema() common.Resource {
 	systemSchema := common.StructToSchema(catalog.SystemSchemaInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
 		m["metastore_id"] = &schema.Schema{
@@ -22,6 +28,16 @@ func ResourceSystemSchema() common.Resource {
 			Computed: true,
 		}
 		m["state"].Computed = true
+		// true, if the schema was already enabled, e.g. from another workspace of the metastore
+		m["already_enabled"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+		m["force_destroy"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 		return m
 	})
 	pi := common.NewPairID("metastore_id", "schema").Schema(
@@ -36,6 +52,10 @@ func ResourceSystemSchema() common.Resource {
 			return fmt.Errorf("internal type casting error")
 		}
 		log.Printf("[DEBUG] Old system schema: %s, new: %s", old, new)
+		if !d.IsNewResource() && !d.HasChange("schema") {
+			// only force_destroy has changed
+			return nil
+		}
 		w, err := c.WorkspaceClient()
 		if err != nil {
 			return err
@@ -50,11 +70,14 @@ func ResourceSystemSchema() common.Resource {
 			SchemaName:  new,
 		})
 		//ignore "schema <schema-name> already exists" error
-		if err != nil && !strings.Contains(err.Error(), "already exists") {
+		alreadyEnabled := err != nil && strings.Contains(err.Error(), "already exists")
+		if err != nil && !alreadyEnabled {
 			return err
 		}
 		//disable old schemas if needed
-		if old != "" {
+		if old != "" && keepSystemSchemaEnabled(d) {
+			log.Printf("[INFO] Keeping system schema %s enabled, as it wasn't enabled by this resource", old)
+		} else if old != "" {
 			err = w.SystemSchemas.Disable(ctx, catalog.DisableRequest{
 				MetastoreId: metastoreSummary.MetastoreId,
 				SchemaName:  old,
@@ -64,6 +87,7 @@ func ResourceSystemSchema() common.Resource {
 			}
 		}
 		d.Set("metastore_id", metastoreSummary.MetastoreId)
+		d.Set("already_enabled", alreadyEnabled)
 		pi.Pack(d)
 		return nil
 	}
@@ -109,6 +133,10 @@ func ResourceSystemSchema() common.Resource {
 			if err != nil {
 				return err
 			}
+			if keepSystemSchemaEnabled(d) {
+				log.Printf("[INFO] Keeping system schema %s enabled, as it wasn't enabled by this resource", schemaName)
+				return nil
+			}
 			metastoreSummary, err := w.Metastores.Summary(ctx)
 			if err != nil {
 				return err
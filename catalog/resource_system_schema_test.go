@@ -297,3 +297,88 @@ func TestSystemSchemaDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc|access", d.Id())
 }
+
+func TestSystemSchemaCreate_AlreadyEnabled(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.1/unity-catalog/metastore_summary",
+				ReuseRequest: true,
+				Response: catalog.GetMetastoreSummaryResponse{
+					MetastoreId: "abc",
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.1/unity-catalog/metastores/abc/systemschemas/access",
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Schema access already exists",
+				},
+				Status: 400,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/metastores/abc/systemschemas?",
+				Response: catalog.ListSystemSchemasResponse{
+					Schemas: []catalog.SystemSchemaInfo{
+						{
+							Schema: "access",
+							State:  catalog.SystemSchemaInfoStateEnableCompleted,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSystemSchema(),
+		HCL:      `schema = "access"`,
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "abc|access",
+		"already_enabled": true,
+	})
+}
+
+func TestSystemSchemaDelete_AlreadyEnabledIsKept(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSystemSchema(),
+		Delete:   true,
+		ID:       "abc|access",
+		InstanceState: map[string]string{
+			"schema":          "access",
+			"already_enabled": "true",
+		},
+		HCL: `schema = "access"`,
+	}.ApplyNoError(t)
+}
+
+func TestSystemSchemaDelete_AlreadyEnabledWithForce(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/metastore_summary",
+				Response: catalog.GetMetastoreSummaryResponse{
+					MetastoreId: "abc",
+				},
+			},
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.1/unity-catalog/metastores/abc/systemschemas/access?",
+				Status:   200,
+			},
+		},
+		Resource: ResourceSystemSchema(),
+		Delete:   true,
+		ID:       "abc|access",
+		InstanceState: map[string]string{
+			"schema":          "access",
+			"already_enabled": "true",
+			"force_destroy":   "true",
+		},
+		HCL: `
+		schema        = "access"
+		force_destroy = true`,
+	}.ApplyNoError(t)
+}
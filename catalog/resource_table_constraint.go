@@ -0,0 +1,211 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTableConstraintExecutor returns a SqlTableInfo, that only runs ALTER TABLE statements on the SQL warehouse
+func newTableConstraintExecutor(w *databricks.WorkspaceClient, warehouseID string) *SqlTableInfo {
+	return &SqlTableInfo{
+		WarehouseID: warehouseID,
+		sqlExec:     w.StatementExecution,
+		warehouses:  w.Warehouses,
+	}
+}
+
+// TableConstraint is a single constraint of a table, that is managed outside of Terraform
+type TableConstraint struct {
+	Table           string                  `json:"table" tf:"force_new"`
+	PrimaryKey      *SqlPrimaryKeyInfo      `json:"primary_key,omitempty"`
+	ForeignKey      *SqlForeignKeyInfo      `json:"foreign_key,omitempty"`
+	CheckConstraint *SqlCheckConstraintInfo `json:"check_constraint,omitempty"`
+	// Cascade drops foreign keys, that reference the primary key, when it's removed
+	Cascade bool `json:"cascade,omitempty"`
+	// WarehouseID is used to add and drop CHECK constraints, that aren't supported by the constraints API
+	WarehouseID string `json:"warehouse_id,omitempty"`
+}
+
+var tableConstraintTypes = []string{"primary_key", "foreign_key", "check_constraint"}
+
+func (tc TableConstraint) name() string {
+	switch {
+	case tc.PrimaryKey != nil:
+		return tc.PrimaryKey.Name
+	case tc.ForeignKey != nil:
+		return tc.ForeignKey.Name
+	case tc.CheckConstraint != nil:
+		return tc.CheckConstraint.Name
+	}
+	return ""
+}
+
+func (tc TableConstraint) sqlTableName() string {
+	return quoteSQLIdentifiers(strings.Split(tc.Table, "."), ".")
+}
+
+func (tc TableConstraint) validate() error {
+	if tc.Table != "" && len(strings.Split(tc.Table, ".")) != 3 {
+		return fmt.Errorf("table must be in form of <catalog>.<schema>.<table>, got %s", tc.Table)
+	}
+	if tc.CheckConstraint != nil && tc.WarehouseID == "" {
+		return fmt.Errorf("warehouse_id is required for check_constraint")
+	}
+	return nil
+}
+
+// setFrom finds the constraint with the given name among constraints of the table.
+// CHECK constraints are only returned as `delta.constraints.*` table properties.
+func (tc *TableConstraint) setFrom(table *catalog.TableInfo, name string) error {
+	tc.PrimaryKey = nil
+	tc.ForeignKey = nil
+	tc.CheckConstraint = nil
+	for _, c := range table.TableConstraints {
+		if c.PrimaryKeyConstraint != nil && strings.EqualFold(c.PrimaryKeyConstraint.Name, name) {
+			tc.PrimaryKey = &SqlPrimaryKeyInfo{
+				Name:    c.PrimaryKeyConstraint.Name,
+				Columns: c.PrimaryKeyConstraint.ChildColumns,
+			}
+			return nil
+		}
+		if c.ForeignKeyConstraint != nil && strings.EqualFold(c.ForeignKeyConstraint.Name, name) {
+			tc.ForeignKey = &SqlForeignKeyInfo{
+				Name:          c.ForeignKeyConstraint.Name,
+				Columns:       c.ForeignKeyConstraint.ChildColumns,
+				ParentTable:   c.ForeignKeyConstraint.ParentTable,
+				ParentColumns: c.ForeignKeyConstraint.ParentColumns,
+			}
+			return nil
+		}
+	}
+	for key, expression := range table.Properties {
+		if strings.EqualFold(key, checkConstraintPropertyPrefix+name) {
+			tc.CheckConstraint = &SqlCheckConstraintInfo{
+				Name:       name,
+				Expression: expression,
+			}
+			return nil
+		}
+	}
+	return apierr.NotFound(fmt.Sprintf("constraint %s doesn't exist on table %s", name, table.FullName))
+}
+
+func ResourceTableConstraint() common.Resource {
+	s := common.StructToSchema(TableConstraint{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "table").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+		// constraints can't be altered, so any change of the definition replaces the constraint
+		for _, constraintType := range tableConstraintTypes {
+			m[constraintType].ForceNew = true
+			m[constraintType].ExactlyOneOf = tableConstraintTypes
+			for _, field := range m[constraintType].Elem.(*schema.Resource).Schema {
+				field.ForceNew = true
+			}
+		}
+		return m
+	})
+	unpackID := func(d *schema.ResourceData) (string, string, error) {
+		parts := strings.SplitN(d.Id(), "|", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", "", fmt.Errorf("invalid ID: %s, expected <table>|<constraint_name>", d.Id())
+		}
+		return parts[0], parts[1], nil
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var tc TableConstraint
+			common.DiffToStructPointer(d, s, &tc)
+			return tc.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var tc TableConstraint
+			common.DataToStructPointer(d, s, &tc)
+			switch {
+			case tc.CheckConstraint != nil:
+				err = newTableConstraintExecutor(w, tc.WarehouseID).applySql(ctx,
+					fmt.Sprintf("ALTER TABLE %s ADD %s", tc.sqlTableName(), tc.CheckConstraint.serialize()))
+			case tc.PrimaryKey != nil:
+				_, err = w.TableConstraints.Create(ctx, catalog.CreateTableConstraint{
+					FullNameArg: tc.Table,
+					Constraint: catalog.TableConstraint{
+						PrimaryKeyConstraint: &catalog.PrimaryKeyConstraint{
+							Name:         tc.PrimaryKey.Name,
+							ChildColumns: tc.PrimaryKey.Columns,
+						},
+					},
+				})
+			case tc.ForeignKey != nil:
+				_, err = w.TableConstraints.Create(ctx, catalog.CreateTableConstraint{
+					FullNameArg: tc.Table,
+					Constraint: catalog.TableConstraint{
+						ForeignKeyConstraint: &catalog.ForeignKeyConstraint{
+							Name:          tc.ForeignKey.Name,
+							ChildColumns:  tc.ForeignKey.Columns,
+							ParentTable:   tc.ForeignKey.ParentTable,
+							ParentColumns: tc.ForeignKey.ParentColumns,
+						},
+					},
+				})
+			}
+			if err != nil {
+				return err
+			}
+			d.SetId(tc.Table + "|" + tc.name())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			tableName, constraintName, err := unpackID(d)
+			if err != nil {
+				return err
+			}
+			table, err := w.Tables.GetByFullName(ctx, tableName)
+			if err != nil {
+				return err
+			}
+			var tc TableConstraint
+			common.DataToStructPointer(d, s, &tc)
+			tc.Table = tableName
+			if err = tc.setFrom(table, constraintName); err != nil {
+				return err
+			}
+			return common.StructToData(tc, s, d)
+		},
+		// only cascade and warehouse_id could be changed, and they are used on destroy
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var tc TableConstraint
+			common.DataToStructPointer(d, s, &tc)
+			if tc.CheckConstraint != nil {
+				return newTableConstraintExecutor(w, tc.WarehouseID).applySql(ctx,
+					fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s",
+						tc.sqlTableName(), QuoteSQLIdentifier(tc.CheckConstraint.Name)))
+			}
+			return w.TableConstraints.Delete(ctx, catalog.DeleteTableConstraintRequest{
+				FullName:       tc.Table,
+				ConstraintName: tc.name(),
+				Cascade:        tc.Cascade,
+			})
+		},
+	}
+}
@@ -0,0 +1,251 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+var ordersTableWithConstraints = &catalog.TableInfo{
+	FullName: "main.sales.orders",
+	TableConstraints: []catalog.TableConstraint{
+		{
+			PrimaryKeyConstraint: &catalog.PrimaryKeyConstraint{
+				Name:         "orders_pk",
+				ChildColumns: []string{"id"},
+			},
+		},
+		{
+			ForeignKeyConstraint: &catalog.ForeignKeyConstraint{
+				Name:          "orders_customer_fk",
+				ChildColumns:  []string{"customer_id"},
+				ParentTable:   "main.sales.customers",
+				ParentColumns: []string{"id"},
+			},
+		},
+	},
+	Properties: map[string]string{
+		"delta.constraints.positive_amount": "amount > 0",
+	},
+}
+
+func TestResourceTableConstraintCreatePrimaryKey(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTableConstraintsAPI().EXPECT().Create(mock.Anything, catalog.CreateTableConstraint{
+				FullNameArg: "main.sales.orders",
+				Constraint: catalog.TableConstraint{
+					PrimaryKeyConstraint: &catalog.PrimaryKeyConstraint{
+						Name:         "orders_pk",
+						ChildColumns: []string{"id"},
+					},
+				},
+			}).Return(&catalog.TableConstraint{}, nil)
+			w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+				Return(ordersTableWithConstraints, nil)
+		},
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		table = "main.sales.orders"
+		primary_key {
+			name    = "orders_pk"
+			columns = ["id"]
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                    "main.sales.orders|orders_pk",
+		"primary_key.0.name":    "orders_pk",
+		"primary_key.0.columns": []any{"id"},
+	})
+}
+
+func TestResourceTableConstraintCreateForeignKey(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTableConstraintsAPI().EXPECT().Create(mock.Anything, catalog.CreateTableConstraint{
+				FullNameArg: "main.sales.orders",
+				Constraint: catalog.TableConstraint{
+					ForeignKeyConstraint: &catalog.ForeignKeyConstraint{
+						Name:          "orders_customer_fk",
+						ChildColumns:  []string{"customer_id"},
+						ParentTable:   "main.sales.customers",
+						ParentColumns: []string{"id"},
+					},
+				},
+			}).Return(&catalog.TableConstraint{}, nil)
+			w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+				Return(ordersTableWithConstraints, nil)
+		},
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		table = "main.sales.orders"
+		foreign_key {
+			name           = "orders_customer_fk"
+			columns        = ["customer_id"]
+			parent_table   = "main.sales.customers"
+			parent_columns = ["id"]
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "main.sales.orders|orders_customer_fk",
+		"foreign_key.0.parent_table": "main.sales.customers",
+	})
+}
+
+func TestResourceTableConstraintCreateCheck(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "ALTER TABLE `main`.`sales`.`orders` ADD CONSTRAINT `positive_amount` CHECK (amount > 0)",
+					WaitTimeout:   "50s",
+					WarehouseId:   "abc",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders?",
+				Response: ordersTableWithConstraints,
+			},
+		},
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		table        = "main.sales.orders"
+		warehouse_id = "abc"
+		check_constraint {
+			name       = "positive_amount"
+			expression = "amount > 0"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                            "main.sales.orders|positive_amount",
+		"check_constraint.0.expression": "amount > 0",
+		"warehouse_id":                  "abc",
+	})
+}
+
+func TestResourceTableConstraintCheckRequiresWarehouse(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		table = "main.sales.orders"
+		check_constraint {
+			name       = "positive_amount"
+			expression = "amount > 0"
+		}`,
+	}.ExpectError(t, "warehouse_id is required for check_constraint")
+}
+
+func TestResourceTableConstraintInvalidTable(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceTableConstraint(),
+		Create:   true,
+		HCL: `
+		table = "sales.orders"
+		primary_key {
+			name    = "orders_pk"
+			columns = ["id"]
+		}`,
+	}.ExpectError(t, "table must be in form of <catalog>.<schema>.<table>, got sales.orders")
+}
+
+func TestResourceTableConstraintImportForeignKey(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+				Return(ordersTableWithConstraints, nil)
+		},
+		Resource: ResourceTableConstraint(),
+		Read:     true,
+		New:      true,
+		ID:       "main.sales.orders|orders_customer_fk",
+	}.ApplyAndExpectData(t, map[string]any{
+		"table":                        "main.sales.orders",
+		"foreign_key.0.name":           "orders_customer_fk",
+		"foreign_key.0.columns":        []any{"customer_id"},
+		"foreign_key.0.parent_columns": []any{"id"},
+	})
+}
+
+func TestResourceTableConstraintReadRemoved(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTablesAPI().EXPECT().GetByFullName(mock.Anything, "main.sales.orders").
+				Return(&catalog.TableInfo{FullName: "main.sales.orders"}, nil)
+		},
+		Resource: ResourceTableConstraint(),
+		Read:     true,
+		Removed:  true,
+		ID:       "main.sales.orders|orders_pk",
+		InstanceState: map[string]string{
+			"table":                   "main.sales.orders",
+			"primary_key.#":           "1",
+			"primary_key.0.name":      "orders_pk",
+			"primary_key.0.columns.#": "1",
+			"primary_key.0.columns.0": "id",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceTableConstraintDeletePrimaryKeyCascade(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockTableConstraintsAPI().EXPECT().Delete(mock.Anything, catalog.DeleteTableConstraintRequest{
+				FullName:       "main.sales.orders",
+				ConstraintName: "orders_pk",
+				Cascade:        true,
+			}).Return(nil)
+		},
+		Resource: ResourceTableConstraint(),
+		Delete:   true,
+		ID:       "main.sales.orders|orders_pk",
+		HCL: `
+		table   = "main.sales.orders"
+		cascade = true
+		primary_key {
+			name    = "orders_pk"
+			columns = ["id"]
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceTableConstraintDeleteCheck(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sql.ExecuteStatementRequest{
+					Statement:     "ALTER TABLE `main`.`sales`.`orders` DROP CONSTRAINT IF EXISTS `positive_amount`",
+					WaitTimeout:   "50s",
+					WarehouseId:   "abc",
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				},
+				Response: sql.StatementResponse{
+					Status: &sql.StatementStatus{State: "SUCCEEDED"},
+				},
+			},
+		},
+		Resource: ResourceTableConstraint(),
+		Delete:   true,
+		ID:       "main.sales.orders|positive_amount",
+		HCL: `
+		table        = "main.sales.orders"
+		warehouse_id = "abc"
+		check_constraint {
+			name       = "positive_amount"
+			expression = "amount > 0"
+		}`,
+	}.ApplyNoError(t)
+}
@@ -68,13 +68,15 @@ func ResourceVolume() common.Resource {
 			d.SetId(v.FullName)
 
 			// Don't update owner if it is not provided
-			if d.Get("owner") == "" {
+			owner := c.OwnerOrDefault(d.Get("owner").(string))
+			if owner == "" {
 				return nil
 			}
 
 			var updateVolumeRequestContent catalog.UpdateVolumeRequestContent
 			common.DataToStructPointer(d, s, &updateVolumeRequestContent)
 			updateVolumeRequestContent.Name = d.Id()
+			updateVolumeRequestContent.Owner = owner
 			_, err = w.Volumes.Update(ctx, updateVolumeRequestContent)
 			if err != nil {
 				return err
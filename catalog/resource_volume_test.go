@@ -235,6 +235,66 @@ func TestVolumesCreateWithInitialOwner_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Something unexpected")
 }
 
+func TestVolumesCreate_DefaultOwner(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/volumes",
+				ExpectedRequest: catalog.CreateVolumeRequestContent{
+					Name:        "testName",
+					VolumeType:  catalog.VolumeType("testVolumeType"),
+					CatalogName: "testCatalogName",
+					SchemaName:  "testSchemaName",
+				},
+				Response: catalog.VolumeInfo{
+					Name:        "testName",
+					VolumeType:  catalog.VolumeType("testVolumeType"),
+					CatalogName: "testCatalogName",
+					SchemaName:  "testSchemaName",
+					FullName:    "testCatalogName.testSchemaName.testName",
+					Owner:       "initialOwner",
+				},
+			},
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.1/unity-catalog/volumes/testCatalogName.testSchemaName.testName",
+				ExpectedRequest: catalog.UpdateVolumeRequestContent{
+					Name:  "testName",
+					Owner: "defaultOwner",
+				},
+				Response: catalog.VolumeInfo{
+					FullName: "testCatalogName.testSchemaName.testName",
+					Owner:    "defaultOwner",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/volumes/testCatalogName.testSchemaName.testName?",
+				Response: catalog.VolumeInfo{
+					Name:        "testName",
+					VolumeType:  catalog.VolumeType("testVolumeType"),
+					CatalogName: "testCatalogName",
+					SchemaName:  "testSchemaName",
+					FullName:    "testCatalogName.testSchemaName.testName",
+					Owner:       "defaultOwner",
+				},
+			},
+		},
+		Resource:     ResourceVolume(),
+		DefaultOwner: "defaultOwner",
+		Create:       true,
+		HCL: `
+		name = "testName"
+		volume_type = "testVolumeType"
+		catalog_name = "testCatalogName"
+		schema_name = "testSchemaName"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"owner": "defaultOwner",
+	})
+}
+
 func TestVolumesRead(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -0,0 +1,228 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// columnTypeParser parses column types, including nested ARRAY, MAP and STRUCT types, e.g.
+// `struct<a:int, b: array<string> NOT NULL COMMENT 'b'>`
+type columnTypeParser struct {
+	tokens []string
+	pos    int
+}
+
+// normalizeColumnType returns the canonical form of a column type, so that semantically equal types are equal
+// strings: keywords, type names and names of struct fields are lower case, aliases are resolved, and whitespace
+// and backticks around names are removed.
+func normalizeColumnType(columnType string) (string, error) {
+	tokens, err := tokenizeColumnType(columnType)
+	if err != nil {
+		return "", err
+	}
+	p := &columnTypeParser{tokens: tokens}
+	normalized, err := p.parseType()
+	if err != nil {
+		return "", err
+	}
+	if p.pos < len(p.tokens) {
+		return "", fmt.Errorf("unexpected %s in column type: %s", p.tokens[p.pos], columnType)
+	}
+	return normalized, nil
+}
+
+func tokenizeColumnType(columnType string) ([]string, error) {
+	var tokens []string
+	runes := []rune(columnType)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case strings.ContainsRune("<>(),:", r):
+			tokens = append(tokens, string(r))
+			i++
+		case r == '`' || r == '\'':
+			// quoted names and comments, where quotes are escaped by doubling them or with a backslash
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == '\\' && r == '\'' {
+					j++
+					continue
+				}
+				if runes[j] == r {
+					if j+1 < len(runes) && runes[j+1] == r {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated %c in column type: %s", r, columnType)
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && (runes[j] == '_' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %c in column type: %s", r, columnType)
+		}
+	}
+	return tokens, nil
+}
+
+func (p *columnTypeParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *columnTypeParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *columnTypeParser) expect(token string) error {
+	if actual := p.next(); actual != token {
+		return fmt.Errorf("expected %s, but got %q", token, actual)
+	}
+	return nil
+}
+
+func isWord(token string) bool {
+	return token != "" && !strings.ContainsAny(token[:1], "<>(),:`'")
+}
+
+func (p *columnTypeParser) parseType() (string, error) {
+	name := strings.ToLower(p.next())
+	if !isWord(name) {
+		return "", fmt.Errorf("expected type name, but got %q", name)
+	}
+	switch name {
+	case "array":
+		if err := p.expect("<"); err != nil {
+			return "", err
+		}
+		elementType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		return "array<" + elementType + ">", p.expect(">")
+	case "map":
+		if err := p.expect("<"); err != nil {
+			return "", err
+		}
+		keyType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		if err = p.expect(","); err != nil {
+			return "", err
+		}
+		valueType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		return "map<" + keyType + "," + valueType + ">", p.expect(">")
+	case "struct":
+		return p.parseStruct()
+	}
+	return p.parsePrimitive(name)
+}
+
+func (p *columnTypeParser) parseStruct() (string, error) {
+	if err := p.expect("<"); err != nil {
+		return "", err
+	}
+	var fields []string
+	for p.peek() != ">" {
+		if len(fields) > 0 {
+			if err := p.expect(","); err != nil {
+				return "", err
+			}
+		}
+		name := p.next()
+		if strings.HasPrefix(name, "`") {
+			name = strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+		} else if !isWord(name) {
+			return "", fmt.Errorf("expected field name, but got %q", name)
+		}
+		if p.peek() == ":" {
+			p.next()
+		}
+		fieldType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		field := strings.ToLower(name) + ":" + fieldType
+		for {
+			modifier := strings.ToLower(p.peek())
+			if modifier == "not" {
+				p.next()
+				if strings.ToLower(p.next()) != "null" {
+					return "", fmt.Errorf("expected NULL after NOT")
+				}
+				field += " not null"
+			} else if modifier == "comment" {
+				p.next()
+				comment := p.next()
+				if !strings.HasPrefix(comment, "'") {
+					return "", fmt.Errorf("expected comment, but got %q", comment)
+				}
+				field += " comment " + comment
+			} else {
+				break
+			}
+		}
+		fields = append(fields, field)
+	}
+	p.next()
+	return "struct<" + strings.Join(fields, ",") + ">", nil
+}
+
+func (p *columnTypeParser) parsePrimitive(name string) (string, error) {
+	// multi-word types, e.g. interval day to second or string collate utf8_lcase
+	for isWord(p.peek()) {
+		word := strings.ToLower(p.peek())
+		if word == "not" || word == "comment" {
+			break
+		}
+		name += " " + word
+		p.next()
+	}
+	if p.peek() == "(" {
+		p.next()
+		var params []string
+		for p.peek() != ")" {
+			if len(params) > 0 {
+				if err := p.expect(","); err != nil {
+					return "", err
+				}
+			}
+			param := p.next()
+			if !isWord(param) {
+				return "", fmt.Errorf("expected type parameter, but got %q", param)
+			}
+			// e.g. SRID of GEOMETRY(ANY)
+			params = append(params, strings.ToLower(param))
+		}
+		p.next()
+		if name == "dec" || name == "numeric" {
+			name = "decimal"
+		}
+		return name + "(" + strings.Join(params, ",") + ")", nil
+	}
+	if alias, ok := columnTypeAliases[name]; ok {
+		return alias, nil
+	}
+	return name, nil
+}
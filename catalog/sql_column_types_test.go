@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeColumnType(t *testing.T) {
+	for columnType, expected := range map[string]string{
+		"INT":                          "int",
+		"integer":                      "int",
+		"Decimal":                      "decimal(10,0)",
+		"DEC( 12, 2 )":                 "decimal(12,2)",
+		"interval DAY to SECOND":       "interval day to second",
+		"INTERVAL YEAR TO YEAR":        "interval year",
+		"TIMESTAMP_NTZ":                "timestamp_ntz",
+		"timestamp without time zone":  "timestamp_ntz",
+		"TIMESTAMP_LTZ":                "timestamp",
+		"VARIANT":                      "variant",
+		"GEOGRAPHY":                    "geography(4326)",
+		"GEOMETRY(ANY)":                "geometry(any)",
+		"array<TIMESTAMP_LTZ>":         "array<timestamp>",
+		"ARRAY< STRING >":              "array<string>",
+		"map<string, array<long>>":     "map<string,array<bigint>>",
+		"struct<>":                     "struct<>",
+		"STRUCT<A: INT, b STRING>":     "struct<a:int,b:string>",
+		"struct<`Field Name`:int>":     "struct<field name:int>",
+		"struct<a:int NOT NULL>":       "struct<a:int not null>",
+		"struct<a:string COMMENT 'x'>": "struct<a:string comment 'x'>",
+		"struct<a:struct<B:map<int, struct<c:short>>>>": "struct<a:struct<b:map<int,struct<c:smallint>>>>",
+	} {
+		normalized, err := normalizeColumnType(columnType)
+		assert.NoError(t, err, columnType)
+		assert.Equal(t, expected, normalized, columnType)
+	}
+}
+
+func TestNormalizeColumnType_Invalid(t *testing.T) {
+	for _, columnType := range []string{
+		"",
+		"array<int",
+		"map<int>",
+		"struct<a:int,>",
+		"struct<a int NOT>",
+		"struct<`a:int>",
+		"int>",
+		"decimal(10,",
+		"int;",
+	} {
+		_, err := normalizeColumnType(columnType)
+		assert.Error(t, err, columnType)
+	}
+}
+
+func TestGetColumnType_ComplexTypes(t *testing.T) {
+	assert.Equal(t, getColumnType("struct<a:int,b:string>"), getColumnType("STRUCT<A: INT, B: STRING>"))
+	assert.NotEqual(t, getColumnType("struct<a:int,b:string>"), getColumnType("struct<b:string,a:int>"))
+	assert.NotEqual(t, getColumnType("array<int>"), getColumnType("array<bigint>"))
+	// unparseable types are still compared case-insensitively
+	assert.Equal(t, getColumnType("some<weird"), getColumnType("SOME<WEIRD"))
+}
@@ -0,0 +1,37 @@
+package catalog
+
+import (
+	"strings"
+)
+
+// sqlStringEscaper escapes string literals of Databricks SQL, where the backslash is the escape character
+var sqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// escapeSQLString returns the value escaped for a single-quoted SQL string literal
+func escapeSQLString(value string) string {
+	return sqlStringEscaper.Replace(value)
+}
+
+// QuoteSQLString returns the value as a single-quoted SQL string literal, e.g. 'it\'s'
+func QuoteSQLString(value string) string {
+	return "'" + escapeSQLString(value) + "'"
+}
+
+// QuoteSQLIdentifier returns the name as a backquoted SQL identifier, where backticks in the name are doubled
+func QuoteSQLIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// quoteSQLIdentifiers returns names as a list of backquoted SQL identifiers, separated by the separator
+func quoteSQLIdentifiers(names []string, separator string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, QuoteSQLIdentifier(name))
+	}
+	return strings.Join(quoted, separator)
+}
+
+// QuoteSQLFullName returns the dot-separated name, e.g. main.default.sales, with every part backquoted
+func QuoteSQLFullName(name string) string {
+	return quoteSQLIdentifiers(strings.Split(name, "."), ".")
+}
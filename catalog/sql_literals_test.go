@@ -0,0 +1,44 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteSQLString(t *testing.T) {
+	assert.Equal(t, `''`, QuoteSQLString(""))
+	assert.Equal(t, `'it\'s'`, QuoteSQLString("it's"))
+	assert.Equal(t, `'C:\\data\\'`, QuoteSQLString(`C:\data\`))
+	assert.Equal(t, `'\\\'); DROP TABLE x; --'`, QuoteSQLString(`\'); DROP TABLE x; --`))
+}
+
+func TestQuoteSQLIdentifier(t *testing.T) {
+	assert.Equal(t, "`a b`", QuoteSQLIdentifier("a b"))
+	assert.Equal(t, "`a``b`", QuoteSQLIdentifier("a`b"))
+	assert.Equal(t, "`main`.`foo`.`b``ar`", quoteSQLIdentifiers([]string{"main", "foo", "b`ar"}, "."))
+}
+
+func TestResourceSqlTableCreateStatement_EscapedLiterals(t *testing.T) {
+	ti := &SqlTableInfo{
+		Name:             "b`ar",
+		CatalogName:      "main",
+		SchemaName:       "foo",
+		TableType:        "EXTERNAL",
+		DataSourceFormat: "CSV",
+		StorageLocation:  "s3://ext-main/it's",
+		ColumnInfos: []SqlColumnInfo{
+			{Name: "i`d", Type: "int", Nullable: true, Comment: `it's \'quoted\' C:\temp`},
+		},
+		Properties: map[string]string{"owner's": "team's"},
+		Options:    map[string]string{"delimiter": `\t`},
+		Comment:    "it's",
+	}
+	assert.Equal(t, "CREATE EXTERNAL TABLE `main`.`foo`.`b``ar` (`i``d` int COMMENT 'it\\'s \\'quoted\\' C:\\\\temp')\n"+
+		"USING CSV\n"+
+		"COMMENT 'it\\'s'\n"+
+		"TBLPROPERTIES ('owner\\'s'='team\\'s')\n"+
+		"OPTIONS ('delimiter'='\\\\t')\n"+
+		"LOCATION 's3://ext-main/it\\'s';",
+		ti.buildTableCreateStatement())
+}
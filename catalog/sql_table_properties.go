@@ -0,0 +1,74 @@
+package catalog
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+// managedPropertyRule recognizes values of table properties, that the server sets in place of the configured ones
+type managedPropertyRule struct {
+	// pattern of property names, e.g. delta.feature.*
+	pattern string
+	// isManaged returns true, if the effective value is an equivalent or an upgrade of the configured one
+	isManaged func(configured, effective string) bool
+}
+
+var managedPropertyRules = []managedPropertyRule{
+	// protocol versions are upgraded, once a table feature requiring them is enabled
+	{"delta.min*Version", isUpgradedVersion},
+	// enabled table features are reported as supported
+	{"delta.feature.*", func(configured, effective string) bool {
+		return isSupportedFeature(configured) && isSupportedFeature(effective)
+	}},
+	// boolean values are normalized to lower case
+	{"delta.*", func(configured, effective string) bool {
+		return isBoolean(configured) && strings.EqualFold(configured, effective)
+	}},
+}
+
+func isUpgradedVersion(configured, effective string) bool {
+	configuredVersion, err := strconv.Atoi(configured)
+	if err != nil {
+		return false
+	}
+	effectiveVersion, err := strconv.Atoi(effective)
+	return err == nil && effectiveVersion >= configuredVersion
+}
+
+func isSupportedFeature(value string) bool {
+	return strings.EqualFold(value, "supported") || strings.EqualFold(value, "enabled")
+}
+
+func isBoolean(value string) bool {
+	_, err := strconv.ParseBool(strings.ToLower(value))
+	return err == nil
+}
+
+// matchesPropertyPattern returns true, if the property name matches the pattern, where * matches any characters
+func matchesPropertyPattern(pattern, key string) bool {
+	// names of properties don't have slashes, so * of path patterns matches any part of them
+	matched, err := path.Match(pattern, key)
+	return err == nil && matched
+}
+
+// isManagedPropertyValue classifies the effective value of the property, that is read from the server. It returns true,
+// if the difference from the configured value is caused by the server and shouldn't be planned as a change.
+func isManagedPropertyValue(key, configured, effective string) bool {
+	for _, rule := range managedPropertyRules {
+		if matchesPropertyPattern(rule.pattern, key) && rule.isManaged(configured, effective) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIgnoredProperty returns true, if the property matches any pattern of the ignored_properties
+func isIgnoredProperty(ignoredProperties []string, key string) bool {
+	for _, pattern := range ignoredProperties {
+		if matchesPropertyPattern(pattern, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,11 +2,13 @@ package clusters
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
 )
 
 // StartAndGetInfo starts cluster and returns info
@@ -21,7 +23,7 @@ func StartClusterAndGetInfo(ctx context.Context, w *databricks.WorkspaceClient,
 		return cluster, nil
 	case compute.StatePending, compute.StateResizing, compute.StateRestarting:
 		// let's wait tiny bit, so we return RUNNING cluster info
-		return w.Clusters.WaitGetClusterRunning(ctx, clusterID, 20*time.Minute, nil)
+		return WaitForClusterRunning(ctx, w, clusterID, 20*time.Minute)
 	case compute.StateTerminating:
 		// Let it finish terminating, so it's safe to start again.
 		// TERMINATED cluster info will be returned this way
@@ -33,7 +35,44 @@ func StartClusterAndGetInfo(ctx context.Context, w *databricks.WorkspaceClient,
 		// most likely we can start error'ed cluster again...
 		log.Printf("[ERROR] Cluster %s: %s", cluster.State, cluster.StateMessage)
 	}
-	return w.Clusters.StartByClusterIdAndWait(ctx, clusterID)
+	return StartAndWaitForCluster(ctx, w, clusterID, 20*time.Minute)
+}
+
+// StartAndWaitForCluster starts a terminated cluster and waits until it's running
+func StartAndWaitForCluster(ctx context.Context, w *databricks.WorkspaceClient, clusterID string,
+	timeout time.Duration) (*compute.ClusterDetails, error) {
+	_, err := w.Clusters.Start(ctx, compute.StartCluster{ClusterId: clusterID})
+	if err != nil {
+		return nil, err
+	}
+	return WaitForClusterRunning(ctx, w, clusterID, timeout)
+}
+
+// WaitForClusterRunning waits until the cluster is running and reports its state and state message, e.g. that
+// instances are being acquired, as progress
+func WaitForClusterRunning(ctx context.Context, w *databricks.WorkspaceClient, clusterID string,
+	timeout time.Duration) (*compute.ClusterDetails, error) {
+	var cluster *compute.ClusterDetails
+	err := common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
+		var err error
+		cluster, err = w.Clusters.GetByClusterId(ctx, clusterID)
+		if err != nil {
+			return common.Progress{}, err
+		}
+		switch cluster.State {
+		case compute.StateRunning:
+			return common.Completed(), nil
+		case compute.StateError, compute.StateTerminated:
+			return common.Progress{}, fmt.Errorf("failed to reach %s, got %s: %s",
+				compute.StateRunning, cluster.State, cluster.StateMessage)
+		}
+		progress := common.InProgress("cluster %s is %s", clusterID, cluster.State)
+		if cluster.StateMessage != "" {
+			progress.Message += ": " + cluster.StateMessage
+		}
+		return progress, nil
+	})
+	return cluster, err
 }
 
 // LatestSparkVersionOrDefault returns Spark version matching the definition, or default in case of error
@@ -3,6 +3,7 @@ package clusters
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
@@ -162,3 +163,33 @@ func TestStartClusterAndGetInfo_StartingError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "I am a teapot")
 }
+
+func TestWaitForClusterRunning_Terminated(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				State:        ClusterStatePending,
+				StateMessage: "Finding instances for new nodes",
+				ClusterID:    "abc",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				State:        ClusterStateTerminated,
+				StateMessage: "Cloud provider launch failure",
+				ClusterID:    "abc",
+			},
+		},
+	})
+	defer server.Close()
+	require.NoError(t, err)
+
+	w, err := client.WorkspaceClient()
+	require.NoError(t, err)
+	_, err = WaitForClusterRunning(context.Background(), w, "abc", time.Minute)
+	assert.EqualError(t, err, "failed to reach RUNNING, got TERMINATED: Cloud provider launch failure")
+}
@@ -0,0 +1,93 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type clusterEvent struct {
+	Timestamp             int64             `json:"timestamp,omitempty" tf:"computed"`
+	Type                  string            `json:"type,omitempty" tf:"computed"`
+	User                  string            `json:"user,omitempty" tf:"computed"`
+	Cause                 string            `json:"cause,omitempty" tf:"computed"`
+	CurrentNumWorkers     int               `json:"current_num_workers,omitempty" tf:"computed"`
+	TargetNumWorkers      int               `json:"target_num_workers,omitempty" tf:"computed"`
+	DriverStateMessage    string            `json:"driver_state_message,omitempty" tf:"computed"`
+	TerminationCode       string            `json:"termination_code,omitempty" tf:"computed"`
+	TerminationType       string            `json:"termination_type,omitempty" tf:"computed"`
+	TerminationParameters map[string]string `json:"termination_parameters,omitempty" tf:"computed"`
+}
+
+func newClusterEvent(e compute.ClusterEvent) clusterEvent {
+	event := clusterEvent{
+		Timestamp: e.Timestamp,
+		Type:      string(e.Type),
+	}
+	if e.Details == nil {
+		return event
+	}
+	event.User = e.Details.User
+	event.Cause = string(e.Details.Cause)
+	event.CurrentNumWorkers = e.Details.CurrentNumWorkers
+	event.TargetNumWorkers = e.Details.TargetNumWorkers
+	event.DriverStateMessage = e.Details.DriverStateMessage
+	if e.Details.Reason != nil {
+		event.TerminationCode = string(e.Details.Reason.Code)
+		event.TerminationType = string(e.Details.Reason.Type)
+		event.TerminationParameters = e.Details.Reason.Parameters
+	}
+	return event
+}
+
+// DataSourceClusterEvents returns recent events of a cluster together with its current health
+func DataSourceClusterEvents() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		ClusterId       string         `json:"cluster_id"`
+		EventTypes      []string       `json:"event_types,omitempty"`
+		StartTime       int64          `json:"start_time,omitempty"`
+		EndTime         int64          `json:"end_time,omitempty"`
+		Order           string         `json:"order,omitempty" tf:"default:DESC"`
+		Limit           int64          `json:"limit,omitempty" tf:"default:50"`
+		State           string         `json:"state,omitempty" tf:"computed"`
+		StateMessage    string         `json:"state_message,omitempty" tf:"computed"`
+		TerminationCode string         `json:"termination_code,omitempty" tf:"computed"`
+		Events          []clusterEvent `json:"events,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		if data.Limit < 1 || data.Limit > 500 {
+			return fmt.Errorf("limit must be between 1 and 500, got %d", data.Limit)
+		}
+		cluster, err := w.Clusters.GetByClusterId(ctx, data.ClusterId)
+		if err != nil {
+			return err
+		}
+		data.State = string(cluster.State)
+		data.StateMessage = cluster.StateMessage
+		if cluster.TerminationReason != nil {
+			data.TerminationCode = string(cluster.TerminationReason.Code)
+		}
+		eventTypes := make([]compute.EventType, 0, len(data.EventTypes))
+		for _, eventType := range data.EventTypes {
+			eventTypes = append(eventTypes, compute.EventType(eventType))
+		}
+		events, err := w.Clusters.EventsAll(ctx, compute.GetEvents{
+			ClusterId:  data.ClusterId,
+			EventTypes: eventTypes,
+			StartTime:  data.StartTime,
+			EndTime:    data.EndTime,
+			Order:      compute.GetEventsOrder(data.Order),
+			Limit:      data.Limit,
+		})
+		if err != nil {
+			return err
+		}
+		data.Events = make([]clusterEvent, 0, len(events))
+		for _, e := range events {
+			data.Events = append(data.Events, newClusterEvent(e))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,110 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClusterEventsData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockClustersAPI().EXPECT()
+			e.GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+				ClusterId:    "abc",
+				State:        compute.StateTerminated,
+				StateMessage: "Inactive cluster terminated",
+				TerminationReason: &compute.TerminationReason{
+					Code: compute.TerminationReasonCodeInactivity,
+				},
+			}, nil)
+			e.EventsAll(mock.Anything, compute.GetEvents{
+				ClusterId:  "abc",
+				EventTypes: []compute.EventType{compute.EventTypeTerminating, compute.EventTypeResizing},
+				Order:      compute.GetEventsOrderDesc,
+				Limit:      10,
+			}).Return([]compute.ClusterEvent{
+				{
+					ClusterId: "abc",
+					Timestamp: 1700000000000,
+					Type:      compute.EventTypeTerminating,
+					Details: &compute.EventDetails{
+						Reason: &compute.TerminationReason{
+							Code: compute.TerminationReasonCodeInactivity,
+							Type: compute.TerminationReasonTypeSuccess,
+							Parameters: map[string]string{
+								"inactivity_duration_min": "60",
+							},
+						},
+					},
+				},
+				{
+					ClusterId: "abc",
+					Timestamp: 1600000000000,
+					Type:      compute.EventTypeResizing,
+					Details: &compute.EventDetails{
+						User:              "user@example.com",
+						CurrentNumWorkers: 2,
+						TargetNumWorkers:  4,
+					},
+				},
+			}, nil)
+		},
+		Resource: DataSourceClusterEvents(),
+		HCL: `
+		cluster_id  = "abc"
+		event_types = ["TERMINATING", "RESIZING"]
+		limit       = 10`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"state":                     "TERMINATED",
+		"state_message":             "Inactive cluster terminated",
+		"termination_code":          "INACTIVITY",
+		"events.#":                  2,
+		"events.0.type":             "TERMINATING",
+		"events.0.timestamp":        1700000000000,
+		"events.0.termination_code": "INACTIVITY",
+		"events.0.termination_type": "SUCCESS",
+		"events.0.termination_parameters.inactivity_duration_min": "60",
+		"events.1.type":                "RESIZING",
+		"events.1.user":                "user@example.com",
+		"events.1.current_num_workers": 2,
+		"events.1.target_num_workers":  4,
+	})
+}
+
+func TestClusterEventsData_InvalidLimit(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceClusterEvents(),
+		HCL: `
+		cluster_id = "abc"
+		limit      = 1000`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "limit must be between 1 and 500, got 1000")
+}
+
+func TestClusterEventsData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockClustersAPI().EXPECT()
+			e.GetByClusterId(mock.Anything, "abc").Return(nil, &apierr.APIError{
+				ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+				StatusCode: 404,
+				Message:    "Cluster abc does not exist",
+			})
+		},
+		Resource:    DataSourceClusterEvents(),
+		HCL:         `cluster_id = "abc"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "Cluster abc does not exist")
+}
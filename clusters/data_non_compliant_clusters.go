@@ -0,0 +1,68 @@
+package clusters
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// isolatedDataSecurityModes are access modes, that are allowed when user isolation is enforced
+var isolatedDataSecurityModes = map[compute.DataSecurityMode]bool{
+	compute.DataSecurityModeUserIsolation:     true,
+	compute.DataSecurityModeSingleUser:        true,
+	compute.DataSecurityModeLegacyTableAcl:    true,
+	compute.DataSecurityModeLegacyPassthrough: true,
+	compute.DataSecurityModeLegacySingleUser:  true,
+}
+
+type nonCompliantCluster struct {
+	ClusterId        string `json:"cluster_id"`
+	ClusterName      string `json:"cluster_name,omitempty"`
+	DataSecurityMode string `json:"data_security_mode,omitempty"`
+	State            string `json:"state,omitempty"`
+	CreatorUserName  string `json:"creator_user_name,omitempty"`
+	ClusterSource    string `json:"cluster_source,omitempty"`
+}
+
+// DataSourceNonCompliantClusters lists clusters without user isolation, that couldn't be started once
+// user isolation is enforced in the workspace
+func DataSourceNonCompliantClusters() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id                 string                `json:"id,omitempty" tf:"computed"`
+		IncludeJobClusters bool                  `json:"include_job_clusters,omitempty"`
+		Ids                []string              `json:"ids,omitempty" tf:"computed,slice_set"`
+		Clusters           []nonCompliantCluster `json:"clusters,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		clusters, err := w.Clusters.ListAll(ctx, compute.ListClustersRequest{})
+		if err != nil {
+			return err
+		}
+		data.Ids = []string{}
+		data.Clusters = []nonCompliantCluster{}
+		for _, v := range clusters {
+			if isolatedDataSecurityModes[v.DataSecurityMode] {
+				continue
+			}
+			if v.ClusterSource == compute.ClusterSourceJob && !data.IncludeJobClusters {
+				continue
+			}
+			data.Ids = append(data.Ids, v.ClusterId)
+			data.Clusters = append(data.Clusters, nonCompliantCluster{
+				ClusterId:        v.ClusterId,
+				ClusterName:      v.ClusterName,
+				DataSecurityMode: string(v.DataSecurityMode),
+				State:            string(v.State),
+				CreatorUserName:  v.CreatorUserName,
+				ClusterSource:    string(v.ClusterSource),
+			})
+		}
+		sort.Slice(data.Clusters, func(i, j int) bool {
+			return data.Clusters[i].ClusterId < data.Clusters[j].ClusterId
+		})
+		data.Id = "_"
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func nonCompliantClustersMock(m *mocks.MockWorkspaceClient) {
+	m.GetMockClustersAPI().EXPECT().ListAll(mock.Anything, compute.ListClustersRequest{}).Return([]compute.ClusterDetails{
+		{
+			ClusterId:        "shared",
+			DataSecurityMode: compute.DataSecurityModeUserIsolation,
+		},
+		{
+			ClusterId:        "single",
+			DataSecurityMode: compute.DataSecurityModeSingleUser,
+		},
+		{
+			ClusterId:        "no-isolation",
+			ClusterName:      "legacy",
+			DataSecurityMode: compute.DataSecurityModeNone,
+			State:            compute.StateRunning,
+			CreatorUserName:  "user@example.com",
+			ClusterSource:    compute.ClusterSourceUi,
+		},
+		{
+			ClusterId:     "custom",
+			ClusterSource: compute.ClusterSourceApi,
+		},
+		{
+			ClusterId:     "job",
+			ClusterSource: compute.ClusterSourceJob,
+		},
+	}, nil)
+}
+
+func TestNonCompliantClustersDataSource(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: nonCompliantClustersMock,
+		Resource:                DataSourceNonCompliantClusters(),
+		NonWritable:             true,
+		Read:                    true,
+		ID:                      "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                           []string{"custom", "no-isolation"},
+		"clusters.#":                    2,
+		"clusters.0.cluster_id":         "custom",
+		"clusters.1.cluster_id":         "no-isolation",
+		"clusters.1.cluster_name":       "legacy",
+		"clusters.1.data_security_mode": "NONE",
+		"clusters.1.state":              "RUNNING",
+		"clusters.1.creator_user_name":  "user@example.com",
+		"clusters.1.cluster_source":     "UI",
+	})
+}
+
+func TestNonCompliantClustersDataSource_IncludeJobClusters(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: nonCompliantClustersMock,
+		Resource:                DataSourceNonCompliantClusters(),
+		HCL:                     `include_job_clusters = true`,
+		NonWritable:             true,
+		Read:                    true,
+		ID:                      "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":        []string{"custom", "job", "no-isolation"},
+		"clusters.#": 3,
+	})
+}
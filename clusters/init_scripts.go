@@ -0,0 +1,126 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// these checks are hermetic, so that they could be performed at plan time
+var (
+	validateVolumesInitScript = validation.StringMatch(regexp.MustCompile(`^/Volumes/[^/]+/[^/]+/[^/]+/.+`),
+		"must be a path to a file in a volume, e.g. /Volumes/<catalog>/<schema>/<volume>/init.sh")
+	validateWorkspaceInitScript = validation.StringMatch(regexp.MustCompile(`^/.+`),
+		"must be an absolute path to a workspace file")
+)
+
+// initScriptAllowlist lazily loads INIT_SCRIPT artifact matchers of the metastore. Only metastore admins
+// could read the allowlist, so the check is skipped, if it couldn't be loaded.
+type initScriptAllowlist struct {
+	w        *databricks.WorkspaceClient
+	loaded   bool
+	matchers []catalog.ArtifactMatcher
+}
+
+func (a *initScriptAllowlist) allows(ctx context.Context, path string) bool {
+	if !a.loaded {
+		a.loaded = true
+		al, err := a.w.ArtifactAllowlists.GetByArtifactType(ctx, catalog.ArtifactTypeInitScript)
+		if err != nil {
+			log.Printf("[WARN] cannot read artifact allowlist, skipping init scripts check: %s", err)
+			return true
+		}
+		a.matchers = al.ArtifactMatchers
+		if a.matchers == nil {
+			a.matchers = []catalog.ArtifactMatcher{}
+		}
+	}
+	if a.matchers == nil {
+		return true
+	}
+	for _, m := range a.matchers {
+		if m.MatchType == catalog.MatchTypePrefixMatch && strings.HasPrefix(path, m.Artifact) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateInitScriptsSpec performs the checks of init scripts that don't need API calls, so that they could
+// run in CustomizeDiff and report misconfiguration at plan time.
+func ValidateInitScriptsSpec(dataSecurityMode compute.DataSecurityMode, initScripts []compute.InitScriptInfo) error {
+	for i, is := range initScripts {
+		destinations := 0
+		for _, set := range []bool{is.Abfss != nil, is.Dbfs != nil, is.File != nil, is.Gcs != nil,
+			is.S3 != nil, is.Volumes != nil, is.Workspace != nil} {
+			if set {
+				destinations++
+			}
+		}
+		if destinations != 1 {
+			return fmt.Errorf("init_scripts.%d must have exactly one destination, got %d", i, destinations)
+		}
+		if is.Dbfs != nil && dataSecurityMode == compute.DataSecurityModeUserIsolation {
+			return fmt.Errorf("init script %s is stored in DBFS, which isn't supported for clusters with "+
+				"USER_ISOLATION data security mode. Use a volume or a workspace file instead", is.Dbfs.Destination)
+		}
+	}
+	return nil
+}
+
+// ValidateInitScripts checks that workspace and volumes init scripts exist and, for clusters in the shared
+// access mode, that they are permitted by the artifact allowlist of the metastore. It calls the API, so it runs
+// at apply time, before the cluster or the job is created, instead of failing on the cluster start.
+func ValidateInitScripts(ctx context.Context, w *databricks.WorkspaceClient,
+	dataSecurityMode compute.DataSecurityMode, initScripts []compute.InitScriptInfo) error {
+	allowlist := &initScriptAllowlist{w: w}
+	for _, is := range initScripts {
+		var path string
+		switch {
+		case is.Workspace != nil:
+			_, err := w.Workspace.GetStatusByPath(ctx, is.Workspace.Destination)
+			if apierr.IsMissing(err) {
+				return fmt.Errorf("init script %s doesn't exist in the workspace", is.Workspace.Destination)
+			}
+			if err != nil {
+				log.Printf("[WARN] cannot check init script %s: %s", is.Workspace.Destination, err)
+			}
+			// workspace files don't have to be in the allowlist
+			continue
+		case is.Volumes != nil:
+			path = is.Volumes.Destination
+			_, err := w.Files.GetMetadataByFilePath(ctx, path)
+			if apierr.IsMissing(err) {
+				return fmt.Errorf("init script %s doesn't exist in the volume", path)
+			}
+			if err != nil {
+				log.Printf("[WARN] cannot check init script %s: %s", path, err)
+			}
+		case is.S3 != nil:
+			path = is.S3.Destination
+		case is.Abfss != nil:
+			path = is.Abfss.Destination
+		case is.Gcs != nil:
+			path = is.Gcs.Destination
+		default:
+			continue
+		}
+		if dataSecurityMode != compute.DataSecurityModeUserIsolation {
+			continue
+		}
+		if !allowlist.allows(ctx, path) {
+			return fmt.Errorf("init script %s is not permitted by the artifact allowlist of the metastore, "+
+				"which is required for clusters with USER_ISOLATION data security mode. "+
+				"Add it to databricks_artifact_allowlist with artifact_type = \"INIT_SCRIPT\"", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package clusters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var volumesInitScript = []compute.InitScriptInfo{
+	{
+		Volumes: &compute.VolumesStorageInfo{
+			Destination: "/Volumes/main/default/scripts/init.sh",
+		},
+	},
+}
+
+func TestValidateInitScripts_Allowlisted(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockFilesAPI().EXPECT().GetMetadataByFilePath(mock.Anything, "/Volumes/main/default/scripts/init.sh").
+		Return(&files.GetMetadataResponse{}, nil)
+	w.GetMockArtifactAllowlistsAPI().EXPECT().GetByArtifactType(mock.Anything, catalog.ArtifactTypeInitScript).
+		Return(&catalog.ArtifactAllowlistInfo{
+			ArtifactMatchers: []catalog.ArtifactMatcher{
+				{
+					Artifact:  "/Volumes/main/default/scripts/",
+					MatchType: catalog.MatchTypePrefixMatch,
+				},
+			},
+		}, nil)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, volumesInitScript)
+	assert.NoError(t, err)
+}
+
+func TestValidateInitScripts_NotAllowlisted(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockFilesAPI().EXPECT().GetMetadataByFilePath(mock.Anything, "/Volumes/main/default/scripts/init.sh").
+		Return(&files.GetMetadataResponse{}, nil)
+	w.GetMockArtifactAllowlistsAPI().EXPECT().GetByArtifactType(mock.Anything, catalog.ArtifactTypeInitScript).
+		Return(&catalog.ArtifactAllowlistInfo{
+			ArtifactMatchers: []catalog.ArtifactMatcher{
+				{
+					Artifact:  "/Volumes/main/other/",
+					MatchType: catalog.MatchTypePrefixMatch,
+				},
+			},
+		}, nil)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, volumesInitScript)
+	qa.AssertErrorStartsWith(t, err, "init script /Volumes/main/default/scripts/init.sh is not permitted by the artifact allowlist")
+}
+
+func TestValidateInitScripts_AllowlistNotReadable(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockFilesAPI().EXPECT().GetMetadataByFilePath(mock.Anything, "/Volumes/main/default/scripts/init.sh").
+		Return(&files.GetMetadataResponse{}, nil)
+	w.GetMockArtifactAllowlistsAPI().EXPECT().GetByArtifactType(mock.Anything, catalog.ArtifactTypeInitScript).
+		Return(nil, apierr.ErrPermissionDenied)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, volumesInitScript)
+	assert.NoError(t, err)
+}
+
+func TestValidateInitScripts_SingleUserSkipsAllowlist(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockFilesAPI().EXPECT().GetMetadataByFilePath(mock.Anything, "/Volumes/main/default/scripts/init.sh").
+		Return(&files.GetMetadataResponse{}, nil)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeSingleUser, volumesInitScript)
+	assert.NoError(t, err)
+}
+
+func TestValidateInitScripts_MissingVolumeFile(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockFilesAPI().EXPECT().GetMetadataByFilePath(mock.Anything, "/Volumes/main/default/scripts/init.sh").
+		Return(nil, apierr.ErrNotFound)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, volumesInitScript)
+	assert.EqualError(t, err, "init script /Volumes/main/default/scripts/init.sh doesn't exist in the volume")
+}
+
+func TestValidateInitScripts_MissingWorkspaceFile(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, "/Shared/init.sh").
+		Return(nil, apierr.ErrNotFound)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, []compute.InitScriptInfo{
+			{
+				Workspace: &compute.WorkspaceStorageInfo{
+					Destination: "/Shared/init.sh",
+				},
+			},
+		})
+	assert.EqualError(t, err, "init script /Shared/init.sh doesn't exist in the workspace")
+}
+
+func TestValidateInitScripts_WorkspaceFileExists(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, "/Shared/init.sh").
+		Return(&workspace.ObjectInfo{Path: "/Shared/init.sh"}, nil)
+	err := ValidateInitScripts(context.Background(), w.WorkspaceClient,
+		compute.DataSecurityModeUserIsolation, []compute.InitScriptInfo{
+			{
+				Workspace: &compute.WorkspaceStorageInfo{
+					Destination: "/Shared/init.sh",
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestResourceClusterCreate_InvalidVolumesInitScript(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name  = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id  = "i3.xlarge"
+		num_workers   = 1
+		init_scripts {
+			volumes {
+				destination = "/Workspace/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [init_scripts.#.volumes.#.destination] invalid value for "+
+		"init_scripts.0.volumes.0.destination (must be a path to a file in a volume, e.g. /Volumes/<catalog>/<schema>/<volume>/init.sh)")
+}
+
+func TestValidateInitScriptsSpec(t *testing.T) {
+	assert.NoError(t, ValidateInitScriptsSpec(compute.DataSecurityModeUserIsolation, volumesInitScript))
+	assert.EqualError(t, ValidateInitScriptsSpec(compute.DataSecurityModeSingleUser, []compute.InitScriptInfo{{}}),
+		"init_scripts.0 must have exactly one destination, got 0")
+	assert.NoError(t, ValidateInitScriptsSpec(compute.DataSecurityModeSingleUser, []compute.InitScriptInfo{
+		{Dbfs: &compute.DbfsStorageInfo{Destination: "dbfs:/init.sh"}},
+	}))
+}
+
+func TestResourceClusterCreate_DbfsInitScriptOnSharedCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name       = "Shared Autoscaling"
+		spark_version      = "7.1-scala12"
+		node_type_id       = "i3.xlarge"
+		num_workers        = 1
+		data_security_mode = "USER_ISOLATION"
+		init_scripts {
+			dbfs {
+				destination = "dbfs:/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "init script dbfs:/init.sh is stored in DBFS, which isn't supported for clusters with "+
+		"USER_ISOLATION data security mode. Use a volume or a workspace file instead")
+}
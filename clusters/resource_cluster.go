@@ -39,6 +39,11 @@ func ResourceCluster() common.Resource {
 		Schema:        clusterSchema,
 		SchemaVersion: clusterSchemaVersion,
 		Timeouts:      resourceClusterTimeouts(),
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var cluster compute.ClusterSpec
+			common.DiffToStructPointer(d, clusterSchema, &cluster)
+			return ValidateInitScriptsSpec(cluster.DataSecurityMode, cluster.InitScripts)
+		},
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    clusterSchemaV0(),
@@ -364,8 +369,8 @@ func (ClusterSpec) CustomizeSchema(s *common.CustomizableSchema) *common.Customi
 	s.SchemaPath("init_scripts", "dbfs").SetDeprecated(DbfsDeprecationWarning)
 	s.SchemaPath("init_scripts", "dbfs", "destination").SetRequired()
 	s.SchemaPath("init_scripts", "s3", "destination").SetRequired()
-	s.SchemaPath("init_scripts", "volumes", "destination").SetRequired()
-	s.SchemaPath("init_scripts", "workspace", "destination").SetRequired()
+	s.SchemaPath("init_scripts", "volumes", "destination").SetRequired().SetValidateFunc(validateVolumesInitScript)
+	s.SchemaPath("init_scripts", "workspace", "destination").SetRequired().SetValidateFunc(validateWorkspaceInitScript)
 	s.SchemaPath("workload_type", "clients").SetRequired()
 	s.SchemaPath("workload_type", "clients", "notebooks").SetDefault(true)
 	s.SchemaPath("workload_type", "clients", "jobs").SetDefault(true)
@@ -429,6 +434,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 	if err := Validate(createClusterRequest); err != nil {
 		return err
 	}
+	err = ValidateInitScripts(ctx, w, createClusterRequest.DataSecurityMode, createClusterRequest.InitScripts)
+	if err != nil {
+		return err
+	}
 	if err = ModifyRequestOnInstancePool(&createClusterRequest); err != nil {
 		return err
 	}
@@ -470,7 +479,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 		return nil
 	}
 
-	clusterInfo, err := clusterWaiter.GetWithTimeout(timeout)
+	clusterInfo, err := WaitForClusterRunning(ctx, w, d.Id(), timeout-time.Since(start))
 	if err != nil {
 		return err
 	}
@@ -574,6 +583,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err := Validate(cluster); err != nil {
 			return err
 		}
+		if d.HasChanges("init_scripts", "data_security_mode") {
+			err = ValidateInitScripts(ctx, w, cluster.DataSecurityMode, cluster.InitScripts)
+			if err != nil {
+				return err
+			}
+		}
 		if err = ModifyRequestOnInstancePool(&cluster); err != nil {
 			return err
 		}
@@ -701,7 +716,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 	}
 	if len(libsToUninstall) > 0 || len(libsToInstall) > 0 {
 		if !clusterInfo.IsRunningOrResizing() {
-			if _, err = clusters.StartByClusterIdAndWait(ctx, clusterId); err != nil {
+			if _, err = StartAndWaitForCluster(ctx, w, clusterId, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
 			}
 		}
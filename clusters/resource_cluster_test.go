@@ -587,6 +587,26 @@ func TestResourceClusterCreateNoWait(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceClusterCreate_PlanGuardrails(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		PlanGuardrails: common.PlanGuardrails{
+			MaxWorkers:   50,
+			RequiredTags: []string{"cost_center"},
+		},
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 100
+		custom_tags = {
+			"team" = "data"
+		}`,
+	}.ExpectError(t, "plan guardrails violated: num_workers is 100, but at most 50 workers are allowed\n"+
+		"custom_tags must have tags: cost_center")
+}
+
 func TestResourceClusterCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -161,8 +161,17 @@ func (a CommandsAPI) createContext(language, clusterID string) (string, error) {
 	return context.ID, err
 }
 
+// commandTimeout is the time left until the deadline of the context, so that commands could run as long as
+// the timeouts of the resource allow. Without the deadline, commands have to finish in 10 minutes.
+func (a CommandsAPI) commandTimeout() time.Duration {
+	if deadline, ok := a.context.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return 10 * time.Minute
+}
+
 func (a CommandsAPI) waitForCommandFinished(commandID, contextID, clusterID string) error {
-	return resource.RetryContext(a.context, 10*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(a.context, a.commandTimeout(), func() *resource.RetryError {
 		commandInfo, err := a.getCommand(commandID, contextID, clusterID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -179,7 +188,7 @@ func (a CommandsAPI) waitForCommandFinished(commandID, contextID, clusterID stri
 }
 
 func (a CommandsAPI) waitForContextReady(contextID, clusterID string) error {
-	return resource.RetryContext(a.context, 10*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(a.context, a.commandTimeout(), func() *resource.RetryError {
 		status, err := a.getContext(contextID, clusterID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -50,6 +50,15 @@ func (a *cachedMe) Me(ctx context.Context) (*iam.User, error) {
 type DatabricksClient struct {
 	*client.DatabricksClient
 
+	// owner of Unity Catalog objects created without an explicit owner
+	DefaultOwner string
+
+	// if true, resources fail instead of creating default clusters to execute SQL statements
+	DisallowImplicitCompute bool
+
+	// policy rules, that are checked on plan for all resources
+	PlanGuardrails PlanGuardrails
+
 	// callback used to create API1.2 call wrapper, which simplifies unit testing
 	commandFactory        func(context.Context, *DatabricksClient) CommandExecutor
 	cachedWorkspaceClient *databricks.WorkspaceClient
@@ -251,6 +260,23 @@ func (c *DatabricksClient) Scim(ctx context.Context, method, path string, reques
 	}, request, response, c.addApiPrefix, c.scimVisitor)
 }
 
+// OwnerOrDefault returns the given owner, or default_owner of the provider configuration if the owner is empty.
+// The result is empty if neither of them is set, and then the owner assigned on creation is kept.
+func (c *DatabricksClient) OwnerOrDefault(owner string) string {
+	if owner != "" {
+		return owner
+	}
+	return c.DefaultOwner
+}
+
+// CheckImplicitCompute returns an error, if the provider configuration disallows creation of the default cluster
+func (c *DatabricksClient) CheckImplicitCompute(clusterName string) error {
+	if !c.DisallowImplicitCompute {
+		return nil
+	}
+	return fmt.Errorf("creation of the default %s cluster is disallowed by disallow_implicit_compute", clusterName)
+}
+
 // IsAzure returns true if client is configured for Azure Databricks - either by using AAD auth or with host+token combination
 func (c *DatabricksClient) IsAzure() bool {
 	return c.Config.IsAzure()
@@ -297,8 +323,11 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 	}
 	// copy all client configuration options except Databricks CLI profile
 	return &DatabricksClient{
-		DatabricksClient: client,
-		commandFactory:   c.commandFactory,
+		DatabricksClient:        client,
+		DefaultOwner:            c.DefaultOwner,
+		DisallowImplicitCompute: c.DisallowImplicitCompute,
+		PlanGuardrails:          c.PlanGuardrails,
+		commandFactory:          c.commandFactory,
 	}, nil
 }
 
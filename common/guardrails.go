@@ -0,0 +1,181 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PlanGuardrails are provider-level policy rules, that are checked on plan for every resource being created or
+// updated, e.g. to forbid large clusters or untagged compute without an external policy engine.
+type PlanGuardrails struct {
+	// maximum number of workers of any cluster, including the upper bound of autoscaling
+	MaxWorkers int
+	// node types, that can't be used for workers or drivers of clusters and instance pools
+	ForbiddenNodeTypes []string
+	// tag keys, that must be present in custom_tags of clusters and instance pools
+	RequiredTags []string
+	// if true, workspaces must be created with secure cluster connectivity (no public IP)
+	ForbidPublicIP bool
+}
+
+// IsEmpty returns true, if no guardrails are configured
+func (g PlanGuardrails) IsEmpty() bool {
+	return g.MaxWorkers == 0 && len(g.ForbiddenNodeTypes) == 0 && len(g.RequiredTags) == 0 && !g.ForbidPublicIP
+}
+
+// GuardrailBlock is the resource or any of its nested blocks, as planned
+type GuardrailBlock struct {
+	// path of the block within the resource, e.g. `task.0.new_cluster.0`, or empty for the resource itself
+	Path string
+	// planned values of the block attributes
+	Values map[string]any
+
+	diff *schema.ResourceDiff
+}
+
+// Known returns true, if the planned value of the block attribute is known, i.e. not computed from other resources.
+// Attributes of blocks within sets are always considered known.
+func (b GuardrailBlock) Known(key string) bool {
+	if b.diff == nil {
+		return true
+	}
+	return b.diff.NewValueKnown(b.attribute(key))
+}
+
+func (b GuardrailBlock) attribute(key string) string {
+	if b.Path == "" {
+		return key
+	}
+	return b.Path + "." + key
+}
+
+// GuardrailRule checks a single block of a resource against the configured guardrails
+type GuardrailRule func(g PlanGuardrails, b GuardrailBlock) error
+
+var guardrailRules = []GuardrailRule{
+	maxWorkersGuardrail,
+	forbiddenNodeTypesGuardrail,
+	requiredTagsGuardrail,
+	forbidPublicIPGuardrail,
+}
+
+// RegisterGuardrailRule adds a rule, that is evaluated for every block of every resource on plan
+func RegisterGuardrailRule(rule GuardrailRule) {
+	guardrailRules = append(guardrailRules, rule)
+}
+
+func maxWorkersGuardrail(g PlanGuardrails, b GuardrailBlock) error {
+	if g.MaxWorkers == 0 {
+		return nil
+	}
+	if numWorkers, ok := b.Values["num_workers"].(int); ok && numWorkers > g.MaxWorkers {
+		return fmt.Errorf("%s is %d, but at most %d workers are allowed",
+			b.attribute("num_workers"), numWorkers, g.MaxWorkers)
+	}
+	if autoscale, ok := b.Values["autoscale"].([]any); ok && len(autoscale) > 0 {
+		if m, ok := autoscale[0].(map[string]any); ok {
+			if maxWorkers, ok := m["max_workers"].(int); ok && maxWorkers > g.MaxWorkers {
+				return fmt.Errorf("%s is %d, but at most %d workers are allowed",
+					b.attribute("autoscale.0.max_workers"), maxWorkers, g.MaxWorkers)
+			}
+		}
+	}
+	return nil
+}
+
+func forbiddenNodeTypesGuardrail(g PlanGuardrails, b GuardrailBlock) error {
+	for _, key := range []string{"node_type_id", "driver_node_type_id"} {
+		nodeType, ok := b.Values[key].(string)
+		if !ok || nodeType == "" {
+			continue
+		}
+		for _, forbidden := range g.ForbiddenNodeTypes {
+			if strings.EqualFold(nodeType, forbidden) {
+				return fmt.Errorf("%s %s is forbidden", b.attribute(key), nodeType)
+			}
+		}
+	}
+	return nil
+}
+
+func requiredTagsGuardrail(g PlanGuardrails, b GuardrailBlock) error {
+	if len(g.RequiredTags) == 0 {
+		return nil
+	}
+	// only clusters and instance pools are checked, which could be recognized by node type or instance pool
+	_, hasNodeType := b.Values["node_type_id"]
+	_, hasInstancePool := b.Values["instance_pool_id"]
+	_, hasTags := b.Values["custom_tags"]
+	if !hasTags || !(hasNodeType || hasInstancePool) || !b.Known("custom_tags") {
+		return nil
+	}
+	tags, _ := b.Values["custom_tags"].(map[string]any)
+	missing := []string{}
+	for _, tag := range g.RequiredTags {
+		if _, ok := tags[tag]; !ok {
+			missing = append(missing, tag)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s must have tags: %s", b.attribute("custom_tags"), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func forbidPublicIPGuardrail(g PlanGuardrails, b GuardrailBlock) error {
+	if !g.ForbidPublicIP {
+		return nil
+	}
+	if noPublicIP, ok := b.Values["is_no_public_ip_enabled"].(bool); ok && !noPublicIP {
+		return fmt.Errorf("%s must be true, as public IPs are forbidden", b.attribute("is_no_public_ip_enabled"))
+	}
+	return nil
+}
+
+// Check evaluates all guardrail rules for the resource and all of its nested blocks
+func (g PlanGuardrails) Check(d *schema.ResourceDiff, s map[string]*schema.Schema) error {
+	values := map[string]any{}
+	for key := range s {
+		values[key] = d.Get(key)
+	}
+	var errs []error
+	g.checkBlock(GuardrailBlock{Values: values, diff: d}, &errs)
+	return errors.Join(errs...)
+}
+
+func (g PlanGuardrails) checkBlock(b GuardrailBlock, errs *[]error) {
+	for _, rule := range guardrailRules {
+		if err := rule(g, b); err != nil {
+			*errs = append(*errs, err)
+		}
+	}
+	keys := make([]string, 0, len(b.Values))
+	for key := range b.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, diff := b.Values[key], b.diff
+		if set, ok := value.(*schema.Set); ok {
+			// elements of sets aren't addressable by index, so only their values are checked
+			value, diff = set.List(), nil
+		}
+		nested, ok := value.([]any)
+		if !ok {
+			continue
+		}
+		for i, item := range nested {
+			if m, ok := item.(map[string]any); ok {
+				g.checkBlock(GuardrailBlock{
+					Path:   fmt.Sprintf("%s.%d", b.attribute(key), i),
+					Values: m,
+					diff:   diff,
+				}, errs)
+			}
+		}
+	}
+}
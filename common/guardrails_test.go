@@ -0,0 +1,85 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanGuardrailsIsEmpty(t *testing.T) {
+	assert.True(t, PlanGuardrails{}.IsEmpty())
+	assert.False(t, PlanGuardrails{MaxWorkers: 10}.IsEmpty())
+	assert.False(t, PlanGuardrails{ForbidPublicIP: true}.IsEmpty())
+}
+
+func TestPlanGuardrailsNestedBlocks(t *testing.T) {
+	g := PlanGuardrails{
+		MaxWorkers:         10,
+		ForbiddenNodeTypes: []string{"i3.16xlarge"},
+		RequiredTags:       []string{"team"},
+	}
+	var errs []error
+	g.checkBlock(GuardrailBlock{Values: map[string]any{
+		"name": "abc",
+		"task": []any{
+			map[string]any{
+				"task_key": "a",
+				"new_cluster": []any{
+					map[string]any{
+						"num_workers":  20,
+						"node_type_id": "I3.16xlarge",
+						"custom_tags": map[string]any{
+							"team": "data",
+						},
+					},
+				},
+			},
+			map[string]any{
+				"task_key": "b",
+				"new_cluster": []any{
+					map[string]any{
+						"autoscale": []any{
+							map[string]any{
+								"min_workers": 1,
+								"max_workers": 5,
+							},
+						},
+						"node_type_id": "i3.xlarge",
+						"custom_tags":  map[string]any{},
+					},
+				},
+			},
+		},
+	}}, &errs)
+	assert.EqualError(t, errors.Join(errs...),
+		"task.0.new_cluster.0.num_workers is 20, but at most 10 workers are allowed\n"+
+			"task.0.new_cluster.0.node_type_id I3.16xlarge is forbidden\n"+
+			"task.1.new_cluster.0.custom_tags must have tags: team")
+}
+
+func TestPlanGuardrailsForbidPublicIP(t *testing.T) {
+	var errs []error
+	PlanGuardrails{ForbidPublicIP: true}.checkBlock(GuardrailBlock{Values: map[string]any{
+		"workspace_name":          "abc",
+		"is_no_public_ip_enabled": false,
+	}}, &errs)
+	assert.EqualError(t, errors.Join(errs...), "is_no_public_ip_enabled must be true, as public IPs are forbidden")
+}
+
+func TestPlanGuardrailsCustomRule(t *testing.T) {
+	defer func(rules []GuardrailRule) {
+		guardrailRules = rules
+	}(guardrailRules)
+	RegisterGuardrailRule(func(g PlanGuardrails, b GuardrailBlock) error {
+		if b.Values["spark_version"] == "7.3.x-scala2.12" {
+			return errors.New("deprecated runtime")
+		}
+		return nil
+	})
+	var errs []error
+	PlanGuardrails{MaxWorkers: 1}.checkBlock(GuardrailBlock{Values: map[string]any{
+		"spark_version": "7.3.x-scala2.12",
+	}}, &errs)
+	assert.EqualError(t, errors.Join(errs...), "deprecated runtime")
+}
@@ -57,10 +57,10 @@ func recoverable(cb func(
 }
 
 func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
-	if r.CustomizeDiff == nil {
+	if r.CustomizeDiff == nil && r.Create == nil && r.Update == nil {
 		return nil
 	}
-	return func(ctx context.Context, rd *schema.ResourceDiff, _ any) (err error) {
+	return func(ctx context.Context, rd *schema.ResourceDiff, m any) (err error) {
 		defer func() {
 			// this is deliberate decision to convert a panic into error,
 			// so that any unforeseen bug would we visible to end-user
@@ -71,9 +71,21 @@ func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
 					"customize diff for")
 			}
 		}()
-		// we don't propagate instance of SDK client to the diff function, because
-		// authentication is not deterministic at this stage with the recent Terraform
-		// versions. Diff customization must be limited to hermetic checks only anyway.
+		// guardrails are only a part of the provider configuration and don't require authentication.
+		// Unchanged resources aren't checked, so that new guardrails don't block unrelated changes.
+		if c, ok := m.(*DatabricksClient); ok && !c.PlanGuardrails.IsEmpty() &&
+			(rd.Id() == "" || len(rd.GetChangedKeysPrefix("")) > 0) {
+			if err = c.PlanGuardrails.Check(rd, r.Schema); err != nil {
+				return nicerError(ctx, fmt.Errorf("plan guardrails violated: %w", err), "customize diff for")
+			}
+		}
+		if r.CustomizeDiff == nil {
+			return nil
+		}
+		// the client is used above only to read guardrails of the provider configuration.
+		// We don't propagate it to the diff function of the resource, because authentication
+		// is not deterministic at this stage with the recent Terraform versions. Diff
+		// customization must be limited to hermetic checks only anyway.
 		err = r.CustomizeDiff(ctx, rd)
 		if err != nil {
 			err = nicerError(ctx, err, "customize diff for")
@@ -27,6 +27,13 @@ func StringIsUUID(s string) bool {
 	return uuidRegex.MatchString(s)
 }
 
+var scimFilterEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// ScimFilterValue escapes a value of a quoted string in SCIM filters, so that names with quotes don't break them
+func ScimFilterValue(value string) string {
+	return scimFilterEscaper.Replace(value)
+}
+
 func GetTerraformVersionFromContext(ctx context.Context) string {
 	tfVersion := "unknown"
 	p, ok := ctx.Value(Provider).(*schema.Provider)
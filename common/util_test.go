@@ -18,6 +18,10 @@ func TestStringIsUUID(t *testing.T) {
 	assert.False(t, StringIsUUID("abc"))
 }
 
+func TestScimFilterValue(t *testing.T) {
+	assert.Equal(t, `a \"b\" \\c`, ScimFilterValue(`a "b" \c`))
+}
+
 func TestGetTerraformVersionFromContext(t *testing.T) {
 	assert.Equal(t, "unknown", GetTerraformVersionFromContext(context.Background()))
 
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Progress describes the state of a long-running operation
+type Progress struct {
+	// Done is true once the operation has completed
+	Done bool
+	// Message describes what the operation is currently doing, e.g. `cluster is PENDING`
+	Message string
+	// Percent of completion, if the API reports it. Negative values mean that it's unknown.
+	Percent float64
+}
+
+// InProgress returns progress of an operation with unknown completion percentage
+func InProgress(format string, a ...any) Progress {
+	return Progress{Message: fmt.Sprintf(format, a...), Percent: -1}
+}
+
+// Completed returns progress of a completed operation
+func Completed() Progress {
+	return Progress{Done: true, Percent: 100}
+}
+
+func (p Progress) String() string {
+	if p.Percent < 0 {
+		return p.Message
+	}
+	return fmt.Sprintf("%s (%.0f%%)", p.Message, p.Percent)
+}
+
+// WaitForProgress polls check until the operation is done, check returns an error or timeout expires.
+// Terraform doesn't stream output of providers during apply, so every change of progress is logged, and long-running
+// operations could be followed with TF_LOG=INFO. The last progress is part of the error, if the operation doesn't
+// complete in time. Timeout should come from the resource timeouts, e.g. d.Timeout(schema.TimeoutCreate),
+// so that users could override it.
+func WaitForProgress(ctx context.Context, timeout time.Duration, check func(context.Context) (Progress, error)) error {
+	var last string
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		progress, err := check(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if progress.Done {
+			return nil
+		}
+		message := progress.String()
+		if message != last {
+			tflog.Info(ctx, message)
+			last = message
+		}
+		return retry.RetryableError(&incompleteError{message})
+	})
+	var incomplete *incompleteError
+	if errors.As(err, &incomplete) {
+		return fmt.Errorf("operation didn't complete in %s, last progress: %s", timeout, incomplete.message)
+	}
+	return err
+}
+
+// incompleteError tells the last progress of an operation, that didn't complete in time
+type incompleteError struct {
+	message string
+}
+
+func (e *incompleteError) Error() string {
+	return e.message
+}
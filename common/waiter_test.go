@@ -0,0 +1,45 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForProgress_Done(t *testing.T) {
+	calls := 0
+	err := WaitForProgress(context.Background(), time.Minute, func(ctx context.Context) (Progress, error) {
+		calls++
+		if calls < 3 {
+			return InProgress("step %d", calls), nil
+		}
+		return Completed(), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitForProgress_Error(t *testing.T) {
+	calls := 0
+	err := WaitForProgress(context.Background(), time.Minute, func(ctx context.Context) (Progress, error) {
+		calls++
+		return Progress{}, errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 1, calls)
+}
+
+func TestWaitForProgress_Timeout(t *testing.T) {
+	err := WaitForProgress(context.Background(), time.Second, func(ctx context.Context) (Progress, error) {
+		return Progress{Message: "cluster is PENDING", Percent: 42}, nil
+	})
+	assert.EqualError(t, err, "operation didn't complete in 1s, last progress: cluster is PENDING (42%)")
+}
+
+func TestProgressString(t *testing.T) {
+	assert.Equal(t, "cluster is PENDING", InProgress("cluster is %s", "PENDING").String())
+	assert.Equal(t, "sync (50%)", Progress{Message: "sync", Percent: 50}.String())
+}
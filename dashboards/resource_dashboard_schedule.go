@@ -0,0 +1,372 @@
+package dashboards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DashboardScheduleSubscriber struct {
+	UserName      string `json:"user_name,omitempty"`
+	GroupName     string `json:"group_name,omitempty"`
+	DestinationId string `json:"destination_id,omitempty"`
+}
+
+type DashboardScheduleSubscription struct {
+	SubscriptionId string `json:"subscription_id,omitempty"`
+	UserId         int64  `json:"user_id,omitempty"`
+	DestinationId  string `json:"destination_id,omitempty"`
+}
+
+type DashboardSchedule struct {
+	DashboardId   string                          `json:"dashboard_id" tf:"force_new"`
+	ScheduleId    string                          `json:"schedule_id,omitempty" tf:"computed"`
+	DisplayName   string                          `json:"display_name,omitempty"`
+	CronSchedule  dashboards.CronSchedule         `json:"cron_schedule"`
+	PauseStatus   string                          `json:"pause_status,omitempty" tf:"computed"`
+	Etag          string                          `json:"etag,omitempty" tf:"computed"`
+	Subscribers   []DashboardScheduleSubscriber   `json:"subscribers,omitempty" tf:"alias:subscriber,slice_set"`
+	Subscriptions []DashboardScheduleSubscription `json:"subscriptions,omitempty" tf:"computed"`
+}
+
+var dashboardScheduleSchema = common.StructToSchema(DashboardSchedule{}, nil)
+
+func subscriberKey(s dashboards.Subscriber) string {
+	if s.UserSubscriber != nil {
+		return fmt.Sprintf("user:%d", s.UserSubscriber.UserId)
+	}
+	if s.DestinationSubscriber != nil {
+		return "destination:" + s.DestinationSubscriber.DestinationId
+	}
+	return ""
+}
+
+// subscriberNotFoundError is returned when a user or a group of a subscriber doesn't exist in the workspace
+type subscriberNotFoundError struct {
+	kind string
+	name string
+}
+
+func (e subscriberNotFoundError) Error() string {
+	return fmt.Sprintf("cannot find %s %s", e.kind, e.name)
+}
+
+func parseUserId(id string) (int64, error) {
+	userId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user id %s: %w", id, err)
+	}
+	return userId, nil
+}
+
+// expandGroupMembers returns ids of all users that are direct or nested members of the group
+func expandGroupMembers(ctx context.Context, w *databricks.WorkspaceClient, group iam.Group, visited map[string]bool) ([]int64, error) {
+	if visited[group.Id] {
+		return nil, nil
+	}
+	visited[group.Id] = true
+	userIds := []int64{}
+	for _, member := range group.Members {
+		switch {
+		case strings.HasPrefix(member.Ref, "Users/"):
+			userId, err := parseUserId(member.Value)
+			if err != nil {
+				return nil, err
+			}
+			userIds = append(userIds, userId)
+		case strings.HasPrefix(member.Ref, "Groups/"):
+			if visited[member.Value] {
+				continue
+			}
+			nested, err := w.Groups.GetById(ctx, member.Value)
+			if err != nil {
+				return nil, err
+			}
+			nestedIds, err := expandGroupMembers(ctx, w, *nested, visited)
+			if err != nil {
+				return nil, err
+			}
+			userIds = append(userIds, nestedIds...)
+		default:
+			log.Printf("[DEBUG] Skipping %s, only users could be subscribed to a dashboard schedule", member.Ref)
+		}
+	}
+	return userIds, nil
+}
+
+// resolveSubscribers converts configured subscribers into the API subscribers, expanding groups into their members.
+// Subscriptions API only accepts users and notification destinations, so groups are expanded on the client.
+func resolveSubscribers(ctx context.Context, w *databricks.WorkspaceClient, subscribers []DashboardScheduleSubscriber) (map[string]dashboards.Subscriber, error) {
+	resolved := map[string]dashboards.Subscriber{}
+	addUser := func(userId int64) {
+		s := dashboards.Subscriber{UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: userId}}
+		resolved[subscriberKey(s)] = s
+	}
+	for _, subscriber := range subscribers {
+		specified := 0
+		for _, v := range []string{subscriber.UserName, subscriber.GroupName, subscriber.DestinationId} {
+			if v != "" {
+				specified++
+			}
+		}
+		if specified != 1 {
+			return nil, fmt.Errorf("exactly one of user_name, group_name or destination_id must be specified for subscriber")
+		}
+		switch {
+		case subscriber.UserName != "":
+			users, err := w.Users.ListAll(ctx, iam.ListUsersRequest{
+				Filter:     fmt.Sprintf(`userName eq "%s"`, common.ScimFilterValue(subscriber.UserName)),
+				Attributes: "id,userName",
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(users) != 1 {
+				return nil, subscriberNotFoundError{kind: "user", name: subscriber.UserName}
+			}
+			userId, err := parseUserId(users[0].Id)
+			if err != nil {
+				return nil, err
+			}
+			addUser(userId)
+		case subscriber.GroupName != "":
+			groups, err := w.Groups.ListAll(ctx, iam.ListGroupsRequest{
+				Filter:     fmt.Sprintf(`displayName eq "%s"`, common.ScimFilterValue(subscriber.GroupName)),
+				Attributes: "id,displayName,members",
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(groups) != 1 {
+				return nil, subscriberNotFoundError{kind: "group", name: subscriber.GroupName}
+			}
+			userIds, err := expandGroupMembers(ctx, w, groups[0], map[string]bool{})
+			if err != nil {
+				return nil, err
+			}
+			for _, userId := range userIds {
+				addUser(userId)
+			}
+		case subscriber.DestinationId != "":
+			s := dashboards.Subscriber{DestinationSubscriber: &dashboards.SubscriptionSubscriberDestination{
+				DestinationId: subscriber.DestinationId,
+			}}
+			resolved[subscriberKey(s)] = s
+		}
+	}
+	return resolved, nil
+}
+
+// currentSubscribers returns configured subscribers that are still in sync with the existing subscriptions. Groups are
+// expanded again, so that a subscriber is dropped, if its users aren't subscribed anymore, e.g. after they've joined
+// the group. Subscriptions that no subscriber accounts for, e.g. of users that have left the group, drop all
+// subscribers. Either way, the difference shows up in the plan and the next apply syncs the subscriptions.
+func currentSubscribers(ctx context.Context, w *databricks.WorkspaceClient, subscribers []DashboardScheduleSubscriber,
+	subscriptions []dashboards.Subscription) ([]DashboardScheduleSubscriber, error) {
+	existing := map[string]bool{}
+	for _, subscription := range subscriptions {
+		existing[subscriberKey(subscription.Subscriber)] = true
+	}
+	expected := map[string]bool{}
+	current := []DashboardScheduleSubscriber{}
+	for _, subscriber := range subscribers {
+		resolved, err := resolveSubscribers(ctx, w, []DashboardScheduleSubscriber{subscriber})
+		var notFound subscriberNotFoundError
+		if errors.As(err, &notFound) || apierr.IsMissing(err) {
+			// deleted principals are dropped, so that the plan shows the difference instead of failing the refresh
+			log.Printf("[WARN] Dropping subscriber from the state: %s", err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		inSync := true
+		for key := range resolved {
+			expected[key] = true
+			inSync = inSync && existing[key]
+		}
+		if inSync {
+			current = append(current, subscriber)
+		}
+	}
+	for key := range existing {
+		if !expected[key] {
+			log.Printf("[INFO] Subscription of %s isn't configured, it'll be removed by the next apply", key)
+			return []DashboardScheduleSubscriber{}, nil
+		}
+	}
+	return current, nil
+}
+
+// syncSubscriptions creates missing subscriptions and removes the ones that are no longer configured
+func syncSubscriptions(ctx context.Context, w *databricks.WorkspaceClient, dashboardId, scheduleId string,
+	subscribers []DashboardScheduleSubscriber) error {
+	desired, err := resolveSubscribers(ctx, w, subscribers)
+	if err != nil {
+		return err
+	}
+	existing, err := w.Lakeview.ListSubscriptionsAll(ctx, dashboards.ListSubscriptionsRequest{
+		DashboardId: dashboardId,
+		ScheduleId:  scheduleId,
+	})
+	if err != nil {
+		return err
+	}
+	for _, subscription := range existing {
+		key := subscriberKey(subscription.Subscriber)
+		if _, ok := desired[key]; ok {
+			delete(desired, key)
+			continue
+		}
+		err = w.Lakeview.DeleteSubscription(ctx, dashboards.DeleteSubscriptionRequest{
+			DashboardId:    dashboardId,
+			ScheduleId:     scheduleId,
+			SubscriptionId: subscription.SubscriptionId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, subscriber := range desired {
+		_, err = w.Lakeview.CreateSubscription(ctx, dashboards.CreateSubscriptionRequest{
+			DashboardId: dashboardId,
+			ScheduleId:  scheduleId,
+			Subscriber:  subscriber,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResourceDashboardSchedule manages schedules of Lakeview dashboards and their subscriptions
+func ResourceDashboardSchedule() common.Resource {
+	p := common.NewPairSeparatedID("dashboard_id", "schedule_id", "/")
+	return common.Resource{
+		Schema: dashboardScheduleSchema,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var ds DashboardSchedule
+			common.DataToStructPointer(d, dashboardScheduleSchema, &ds)
+			schedule, err := w.Lakeview.CreateSchedule(ctx, dashboards.CreateScheduleRequest{
+				DashboardId:  ds.DashboardId,
+				DisplayName:  ds.DisplayName,
+				CronSchedule: ds.CronSchedule,
+				PauseStatus:  dashboards.SchedulePauseStatus(ds.PauseStatus),
+			})
+			if err != nil {
+				return err
+			}
+			d.Set("schedule_id", schedule.ScheduleId)
+			p.Pack(d)
+			return syncSubscriptions(ctx, w, ds.DashboardId, schedule.ScheduleId, ds.Subscribers)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			dashboardId, scheduleId, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			schedule, err := w.Lakeview.GetSchedule(ctx, dashboards.GetScheduleRequest{
+				DashboardId: dashboardId,
+				ScheduleId:  scheduleId,
+			})
+			if err != nil {
+				return err
+			}
+			subscriptions, err := w.Lakeview.ListSubscriptionsAll(ctx, dashboards.ListSubscriptionsRequest{
+				DashboardId: dashboardId,
+				ScheduleId:  scheduleId,
+			})
+			if err != nil {
+				return err
+			}
+			var state DashboardSchedule
+			common.DataToStructPointer(d, dashboardScheduleSchema, &state)
+			subscribers, err := currentSubscribers(ctx, w, state.Subscribers, subscriptions)
+			if err != nil {
+				return err
+			}
+			ds := DashboardSchedule{
+				Subscribers:  subscribers,
+				DashboardId:  schedule.DashboardId,
+				ScheduleId:   schedule.ScheduleId,
+				DisplayName:  schedule.DisplayName,
+				CronSchedule: schedule.CronSchedule,
+				PauseStatus:  string(schedule.PauseStatus),
+				Etag:         schedule.Etag,
+			}
+			for _, subscription := range subscriptions {
+				s := DashboardScheduleSubscription{SubscriptionId: subscription.SubscriptionId}
+				if subscription.Subscriber.UserSubscriber != nil {
+					s.UserId = subscription.Subscriber.UserSubscriber.UserId
+				}
+				if subscription.Subscriber.DestinationSubscriber != nil {
+					s.DestinationId = subscription.Subscriber.DestinationSubscriber.DestinationId
+				}
+				ds.Subscriptions = append(ds.Subscriptions, s)
+			}
+			err = common.StructToData(ds, dashboardScheduleSchema, d)
+			if err != nil {
+				return err
+			}
+			if len(subscribers) == 0 {
+				// StructToData skips empty slices
+				return d.Set("subscriber", []any{})
+			}
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var ds DashboardSchedule
+			common.DataToStructPointer(d, dashboardScheduleSchema, &ds)
+			if d.HasChanges("display_name", "cron_schedule", "pause_status") {
+				_, err = w.Lakeview.UpdateSchedule(ctx, dashboards.UpdateScheduleRequest{
+					DashboardId:  ds.DashboardId,
+					ScheduleId:   ds.ScheduleId,
+					DisplayName:  ds.DisplayName,
+					CronSchedule: ds.CronSchedule,
+					PauseStatus:  dashboards.SchedulePauseStatus(ds.PauseStatus),
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return syncSubscriptions(ctx, w, ds.DashboardId, ds.ScheduleId, ds.Subscribers)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			dashboardId, scheduleId, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			return w.Lakeview.DeleteSchedule(ctx, dashboards.DeleteScheduleRequest{
+				DashboardId: dashboardId,
+				ScheduleId:  scheduleId,
+			})
+		},
+	}
+}
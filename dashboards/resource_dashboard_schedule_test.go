@@ -0,0 +1,377 @@
+package dashboards
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var testCronSchedule = dashboards.CronSchedule{
+	QuartzCronExpression: "0 0 8 * * ?",
+	TimezoneId:           "Europe/Amsterdam",
+}
+
+func TestDashboardScheduleCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceDashboardSchedule(), qa.CornerCaseID("xyz/123"))
+}
+
+func TestDashboardScheduleCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockLakeviewAPI().EXPECT()
+			e.CreateSchedule(mock.Anything, dashboards.CreateScheduleRequest{
+				DashboardId:  "xyz",
+				DisplayName:  "Daily",
+				CronSchedule: testCronSchedule,
+			}).Return(&dashboards.Schedule{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}, nil)
+			w.GetMockUsersAPI().EXPECT().ListAll(mock.Anything, iam.ListUsersRequest{
+				Filter:     `userName eq "me@example.com"`,
+				Attributes: "id,userName",
+			}).Return([]iam.User{{Id: "1", UserName: "me@example.com"}}, nil)
+			g := w.GetMockGroupsAPI().EXPECT()
+			g.ListAll(mock.Anything, iam.ListGroupsRequest{
+				Filter:     `displayName eq "analysts"`,
+				Attributes: "id,displayName,members",
+			}).Return([]iam.Group{{
+				Id:          "g1",
+				DisplayName: "analysts",
+				Members: []iam.ComplexValue{
+					{Ref: "Users/1", Value: "1"},
+					{Ref: "Users/2", Value: "2"},
+					{Ref: "ServicePrincipals/3", Value: "3"},
+					{Ref: "Groups/g2", Value: "g2"},
+				},
+			}}, nil)
+			g.GetById(mock.Anything, "g2").Return(&iam.Group{
+				Id: "g2",
+				Members: []iam.ComplexValue{
+					{Ref: "Users/4", Value: "4"},
+					{Ref: "Groups/g1", Value: "g1"},
+				},
+			}, nil)
+			e.ListSubscriptionsAll(mock.Anything, dashboards.ListSubscriptionsRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return([]dashboards.Subscription{}, nil).Once()
+			for _, s := range []dashboards.Subscriber{
+				{UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: 1}},
+				{UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: 2}},
+				{UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: 4}},
+				{DestinationSubscriber: &dashboards.SubscriptionSubscriberDestination{DestinationId: "slack"}},
+			} {
+				e.CreateSubscription(mock.Anything, dashboards.CreateSubscriptionRequest{
+					DashboardId: "xyz",
+					ScheduleId:  "123",
+					Subscriber:  s,
+				}).Return(&dashboards.Subscription{}, nil).Once()
+			}
+			e.GetSchedule(mock.Anything, dashboards.GetScheduleRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return(&dashboards.Schedule{
+				DashboardId:  "xyz",
+				ScheduleId:   "123",
+				DisplayName:  "Daily",
+				CronSchedule: testCronSchedule,
+				PauseStatus:  dashboards.SchedulePauseStatusUnpaused,
+			}, nil)
+			e.ListSubscriptionsAll(mock.Anything, dashboards.ListSubscriptionsRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return([]dashboards.Subscription{
+				{
+					SubscriptionId: "s1",
+					Subscriber: dashboards.Subscriber{
+						UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: 1},
+					},
+				},
+				{
+					SubscriptionId: "s2",
+					Subscriber: dashboards.Subscriber{
+						DestinationSubscriber: &dashboards.SubscriptionSubscriberDestination{DestinationId: "slack"},
+					},
+				},
+			}, nil)
+		},
+		Resource: ResourceDashboardSchedule(),
+		Create:   true,
+		HCL: `
+		dashboard_id = "xyz"
+		display_name = "Daily"
+		cron_schedule {
+			quartz_cron_expression = "0 0 8 * * ?"
+			timezone_id            = "Europe/Amsterdam"
+		}
+		subscriber {
+			user_name = "me@example.com"
+		}
+		subscriber {
+			group_name = "analysts"
+		}
+		subscriber {
+			destination_id = "slack"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                              "xyz/123",
+		"schedule_id":                     "123",
+		"pause_status":                    "UNPAUSED",
+		"subscriptions.#":                 2,
+		"subscriptions.0.user_id":         1,
+		"subscriptions.1.destination_id":  "slack",
+		"subscriptions.1.subscription_id": "s2",
+	})
+}
+
+func TestDashboardScheduleUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockLakeviewAPI().EXPECT()
+			e.UpdateSchedule(mock.Anything, dashboards.UpdateScheduleRequest{
+				DashboardId:  "xyz",
+				ScheduleId:   "123",
+				DisplayName:  "Daily",
+				CronSchedule: testCronSchedule,
+				PauseStatus:  dashboards.SchedulePauseStatusPaused,
+			}).Return(&dashboards.Schedule{}, nil)
+			e.ListSubscriptionsAll(mock.Anything, dashboards.ListSubscriptionsRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return([]dashboards.Subscription{
+				{
+					SubscriptionId: "s1",
+					Subscriber: dashboards.Subscriber{
+						UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: 1},
+					},
+				},
+				{
+					SubscriptionId: "s2",
+					Subscriber: dashboards.Subscriber{
+						DestinationSubscriber: &dashboards.SubscriptionSubscriberDestination{DestinationId: "slack"},
+					},
+				},
+			}, nil)
+			e.DeleteSubscription(mock.Anything, dashboards.DeleteSubscriptionRequest{
+				DashboardId:    "xyz",
+				ScheduleId:     "123",
+				SubscriptionId: "s1",
+			}).Return(nil)
+			e.GetSchedule(mock.Anything, dashboards.GetScheduleRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return(&dashboards.Schedule{
+				DashboardId:  "xyz",
+				ScheduleId:   "123",
+				DisplayName:  "Daily",
+				CronSchedule: testCronSchedule,
+				PauseStatus:  dashboards.SchedulePauseStatusPaused,
+			}, nil)
+		},
+		Resource: ResourceDashboardSchedule(),
+		Update:   true,
+		ID:       "xyz/123",
+		InstanceState: map[string]string{
+			"dashboard_id":                           "xyz",
+			"schedule_id":                            "123",
+			"display_name":                           "Daily",
+			"pause_status":                           "UNPAUSED",
+			"cron_schedule.#":                        "1",
+			"cron_schedule.0.quartz_cron_expression": "0 0 8 * * ?",
+			"cron_schedule.0.timezone_id":            "Europe/Amsterdam",
+		},
+		HCL: `
+		dashboard_id = "xyz"
+		display_name = "Daily"
+		pause_status = "PAUSED"
+		cron_schedule {
+			quartz_cron_expression = "0 0 8 * * ?"
+			timezone_id            = "Europe/Amsterdam"
+		}
+		subscriber {
+			destination_id = "slack"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"pause_status": "PAUSED",
+	})
+}
+
+func TestDashboardScheduleCreate_InvalidSubscriber(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockLakeviewAPI().EXPECT().CreateSchedule(mock.Anything, mock.Anything).Return(&dashboards.Schedule{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}, nil)
+		},
+		Resource: ResourceDashboardSchedule(),
+		Create:   true,
+		HCL: `
+		dashboard_id = "xyz"
+		cron_schedule {
+			quartz_cron_expression = "0 0 8 * * ?"
+			timezone_id            = "Europe/Amsterdam"
+		}
+		subscriber {
+			user_name  = "me@example.com"
+			group_name = "analysts"
+		}
+		`,
+	}.ExpectError(t, "exactly one of user_name, group_name or destination_id must be specified for subscriber")
+}
+
+func TestDashboardScheduleDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockLakeviewAPI().EXPECT().DeleteSchedule(mock.Anything, dashboards.DeleteScheduleRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return(nil)
+		},
+		Resource: ResourceDashboardSchedule(),
+		Delete:   true,
+		ID:       "xyz/123",
+	}.ApplyNoError(t)
+}
+
+func dashboardScheduleReadMocks(w *mocks.MockWorkspaceClient, members []iam.ComplexValue, subscriptions []dashboards.Subscription) {
+	e := w.GetMockLakeviewAPI().EXPECT()
+	e.GetSchedule(mock.Anything, dashboards.GetScheduleRequest{
+		DashboardId: "xyz",
+		ScheduleId:  "123",
+	}).Return(&dashboards.Schedule{
+		DashboardId:  "xyz",
+		ScheduleId:   "123",
+		DisplayName:  "Daily",
+		CronSchedule: testCronSchedule,
+	}, nil)
+	e.ListSubscriptionsAll(mock.Anything, dashboards.ListSubscriptionsRequest{
+		DashboardId: "xyz",
+		ScheduleId:  "123",
+	}).Return(subscriptions, nil)
+	w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, iam.ListGroupsRequest{
+		Filter:     `displayName eq "analysts"`,
+		Attributes: "id,displayName,members",
+	}).Return([]iam.Group{{Id: "g1", DisplayName: "analysts", Members: members}}, nil)
+}
+
+func userSubscription(id string, userId int64) dashboards.Subscription {
+	return dashboards.Subscription{
+		SubscriptionId: id,
+		Subscriber: dashboards.Subscriber{
+			UserSubscriber: &dashboards.SubscriptionSubscriberUser{UserId: userId},
+		},
+	}
+}
+
+const dashboardScheduleGroupHcl = `
+dashboard_id = "xyz"
+display_name = "Daily"
+cron_schedule {
+	quartz_cron_expression = "0 0 8 * * ?"
+	timezone_id            = "Europe/Amsterdam"
+}
+subscriber {
+	group_name = "analysts"
+}
+`
+
+func TestDashboardScheduleRead_GroupInSync(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			dashboardScheduleReadMocks(w, []iam.ComplexValue{{Ref: "Users/1", Value: "1"}},
+				[]dashboards.Subscription{userSubscription("s1", 1)})
+		},
+		Resource: ResourceDashboardSchedule(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz/123",
+		HCL:      dashboardScheduleGroupHcl,
+	}.ApplyAndExpectData(t, map[string]any{
+		"subscriber.#": 1,
+	})
+}
+
+func TestDashboardScheduleRead_UserJoinedGroup(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			dashboardScheduleReadMocks(w, []iam.ComplexValue{
+				{Ref: "Users/1", Value: "1"},
+				{Ref: "Users/2", Value: "2"},
+			}, []dashboards.Subscription{userSubscription("s1", 1)})
+		},
+		Resource: ResourceDashboardSchedule(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz/123",
+		HCL:      dashboardScheduleGroupHcl,
+	}.ApplyAndExpectData(t, map[string]any{
+		"subscriber.#": 0,
+	})
+}
+
+func TestDashboardScheduleRead_UserLeftGroup(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			dashboardScheduleReadMocks(w, []iam.ComplexValue{{Ref: "Users/1", Value: "1"}},
+				[]dashboards.Subscription{userSubscription("s1", 1), userSubscription("s2", 2)})
+		},
+		Resource: ResourceDashboardSchedule(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz/123",
+		HCL:      dashboardScheduleGroupHcl,
+	}.ApplyAndExpectData(t, map[string]any{
+		"subscriber.#": 0,
+	})
+}
+
+func TestDashboardScheduleRead_GroupDeleted(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockLakeviewAPI().EXPECT()
+			e.GetSchedule(mock.Anything, dashboards.GetScheduleRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return(&dashboards.Schedule{
+				DashboardId:  "xyz",
+				ScheduleId:   "123",
+				DisplayName:  "Daily",
+				CronSchedule: testCronSchedule,
+			}, nil)
+			e.ListSubscriptionsAll(mock.Anything, dashboards.ListSubscriptionsRequest{
+				DashboardId: "xyz",
+				ScheduleId:  "123",
+			}).Return([]dashboards.Subscription{}, nil)
+			w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, iam.ListGroupsRequest{
+				Filter:     `displayName eq "\"data\" analysts"`,
+				Attributes: "id,displayName,members",
+			}).Return([]iam.Group{}, nil)
+		},
+		Resource: ResourceDashboardSchedule(),
+		Read:     true,
+		New:      true,
+		ID:       "xyz/123",
+		HCL: `
+		dashboard_id = "xyz"
+		display_name = "Daily"
+		cron_schedule {
+			quartz_cron_expression = "0 0 8 * * ?"
+			timezone_id            = "Europe/Amsterdam"
+		}
+		subscriber {
+			group_name = "\"data\" analysts"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"subscriber.#": 0,
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go/client"
@@ -89,6 +91,28 @@ func providerSchemaPluginFramework() schema.Schema {
 			}
 		}
 	}
+	ps["default_owner"] = schema.StringAttribute{
+		Optional: true,
+	}
+	ps["disallow_implicit_compute"] = schema.BoolAttribute{
+		Optional: true,
+	}
+	// guardrails are enforced only for SDKv2 resources, because none of the plugin framework resources manage
+	// compute. The attributes are still declared, as muxed providers must have the same schema.
+	ps["guardrail_max_workers"] = schema.Int64Attribute{
+		Optional: true,
+	}
+	ps["guardrail_forbidden_node_types"] = schema.ListAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+	ps["guardrail_required_tags"] = schema.ListAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+	ps["guardrail_forbid_public_ip"] = schema.BoolAttribute{
+		Optional: true,
+	}
 	return schema.Schema{
 		Attributes: ps,
 	}
@@ -140,6 +164,18 @@ func configureDatabricksClient_PluginFramework(ctx context.Context, req provider
 			attrsUsed = append(attrsUsed, attr.Name)
 		}
 	}
+	var defaultOwner types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("default_owner"), &defaultOwner)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	var disallowImplicitCompute types.Bool
+	diags = req.Config.GetAttribute(ctx, path.Root("disallow_implicit_compute"), &disallowImplicitCompute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
 	sort.Strings(attrsUsed)
 	tflog.Info(ctx, fmt.Sprintf("Explicit and implicit attributes: %s", strings.Join(attrsUsed, ", ")))
 	if cfg.AuthType != "" {
@@ -162,7 +198,15 @@ func configureDatabricksClient_PluginFramework(ctx context.Context, req provider
 		return nil
 	}
 	pc := &common.DatabricksClient{
-		DatabricksClient: client,
+		DatabricksClient:        client,
+		DefaultOwner:            defaultOwner.ValueString(),
+		DisallowImplicitCompute: disallowImplicitCompute.ValueBool(),
+	}
+	if defaultOwner.IsNull() {
+		pc.DefaultOwner = os.Getenv("DATABRICKS_DEFAULT_OWNER")
+	}
+	if disallowImplicitCompute.IsNull() {
+		pc.DisallowImplicitCompute, _ = strconv.ParseBool(os.Getenv("DATABRICKS_DISALLOW_IMPLICIT_COMPUTE"))
 	}
 	pc.WithCommandExecutor(func(ctx context.Context, client *common.DatabricksClient) common.CommandExecutor {
 		return commands.NewCommandsAPI(ctx, client)
@@ -7,7 +7,6 @@ import (
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
-	"github.com/databricks/databricks-sdk-go/retries"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
@@ -29,32 +28,60 @@ func ResourceQualityMonitor() resource.Resource {
 	return &QualityMonitorResource{}
 }
 
-func waitForMonitor(ctx context.Context, w *databricks.WorkspaceClient, monitor *catalog.MonitorInfo) diag.Diagnostics {
-	updatedMonitor, err := retries.Poll[catalog.MonitorInfo](ctx, qualityMonitorDefaultProvisionTimeout, func() (*catalog.MonitorInfo, *retries.Err) {
+func waitForMonitor(ctx context.Context, w *databricks.WorkspaceClient, monitor *catalog.MonitorInfo, timeout time.Duration) diag.Diagnostics {
+	err := common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		newMonitor, err := w.QualityMonitors.GetByTableName(ctx, monitor.TableName)
 		if err != nil {
-			return nil, retries.Halt(fmt.Errorf("failed to get monitor: %s", err))
+			return common.Progress{}, fmt.Errorf("failed to get monitor: %s", err)
 		}
 
 		switch newMonitor.Status {
 		case catalog.MonitorInfoStatusMonitorStatusActive:
-			return newMonitor, nil
+			*monitor = *newMonitor
+			return common.Completed(), nil
 		case catalog.MonitorInfoStatusMonitorStatusError, catalog.MonitorInfoStatusMonitorStatusFailed:
-			return nil, retries.Halt(fmt.Errorf("monitor status returned %s for monitor: %s", newMonitor.Status, newMonitor.TableName))
+			return common.Progress{}, fmt.Errorf("monitor status returned %s for monitor: %s", newMonitor.Status, newMonitor.TableName)
 		}
-		return nil, retries.Continue(fmt.Errorf("monitor %s is still pending", newMonitor.TableName))
+		return common.InProgress("monitor %s is %s", newMonitor.TableName, newMonitor.Status), nil
 	})
 	if err != nil {
 		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to get monitor", err.Error())}
 	}
-	*monitor = *updatedMonitor
 	return nil
 }
 
 type MonitorInfoExtended struct {
 	catalog_tf.MonitorInfo
-	WarehouseId          types.String `tfsdk:"warehouse_id" tf:"optional"`
-	SkipBuiltinDashboard types.Bool   `tfsdk:"skip_builtin_dashboard" tf:"optional"`
+	WarehouseId          types.String            `tfsdk:"warehouse_id" tf:"optional"`
+	SkipBuiltinDashboard types.Bool              `tfsdk:"skip_builtin_dashboard" tf:"optional"`
+	Timeouts             *QualityMonitorTimeouts `tfsdk:"timeouts" tf:"optional"`
+}
+
+// QualityMonitorTimeouts overrides the time to wait for the monitor to become active, the same way as `timeouts`
+// blocks of SDKv2 resources, e.g. `create = "30m"`
+type QualityMonitorTimeouts struct {
+	Create types.String `tfsdk:"create" tf:"optional"`
+	Update types.String `tfsdk:"update" tf:"optional"`
+}
+
+func (t *QualityMonitorTimeouts) timeout(operation string) (time.Duration, diag.Diagnostics) {
+	var v types.String
+	if t != nil {
+		switch operation {
+		case "create":
+			v = t.Create
+		case "update":
+			v = t.Update
+		}
+	}
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return qualityMonitorDefaultProvisionTimeout, nil
+	}
+	timeout, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		return 0, diag.Diagnostics{diag.NewErrorDiagnostic(fmt.Sprintf("invalid timeouts.%s", operation), err.Error())}
+	}
+	return timeout, nil
 }
 
 type QualityMonitorResource struct {
@@ -109,12 +136,17 @@ func (r *QualityMonitorResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	timeout, diags := monitorInfoTfSDK.Timeouts.timeout("create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	monitor, err := w.QualityMonitors.Create(ctx, createMonitorGoSDK)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create monitor", err.Error())
 		return
 	}
-	resp.Diagnostics.Append(waitForMonitor(ctx, w, monitor)...)
+	resp.Diagnostics.Append(waitForMonitor(ctx, w, monitor, timeout)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -124,6 +156,7 @@ func (r *QualityMonitorResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newMonitorInfoTfSDK.Timeouts = monitorInfoTfSDK.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newMonitorInfoTfSDK)...)
 }
@@ -154,6 +187,11 @@ func (r *QualityMonitorResource) Read(ctx context.Context, req resource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// timeouts are known only from the configuration
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &monitorInfoTfSDK.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, monitorInfoTfSDK)...)
 }
@@ -181,12 +219,17 @@ func (r *QualityMonitorResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	timeout, diags := monitorInfoTfSDK.Timeouts.timeout("update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	monitor, err := w.QualityMonitors.Update(ctx, updateMonitorGoSDK)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update monitor", err.Error())
 		return
 	}
-	resp.Diagnostics.Append(waitForMonitor(ctx, w, monitor)...)
+	resp.Diagnostics.Append(waitForMonitor(ctx, w, monitor, timeout)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -196,6 +239,7 @@ func (r *QualityMonitorResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newMonitorInfoTfSDK.Timeouts = monitorInfoTfSDK.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newMonitorInfoTfSDK)...)
 }
@@ -66,6 +66,9 @@ func TestUcAccQualityMonitorPluginFramework(t *testing.T) {
 				  model_id_col = "model_id"
 				  problem_type = "PROBLEM_TYPE_REGRESSION"
 				} 
+				timeouts = {
+				  create = "30m"
+				}
 			}
 
 			resource "databricks_sql_table" "myTimeseries" {
@@ -79,19 +79,26 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_aws_unity_catalog_policy":             aws.DataAwsUnityCatalogPolicy().ToResource(),
 			"databricks_cluster":                              clusters.DataSourceCluster().ToResource(),
 			"databricks_clusters":                             clusters.DataSourceClusters().ToResource(),
+			"databricks_cluster_events":                       clusters.DataSourceClusterEvents().ToResource(),
 			"databricks_cluster_policy":                       policies.DataSourceClusterPolicy().ToResource(),
 			"databricks_catalog":                              catalog.DataSourceCatalog().ToResource(),
 			"databricks_catalogs":                             catalog.DataSourceCatalogs().ToResource(),
+			"databricks_compliance_report":                    settings.DataSourceComplianceReport().ToResource(),
 			"databricks_current_config":                       mws.DataSourceCurrentConfiguration().ToResource(),
 			"databricks_current_metastore":                    catalog.DataSourceCurrentMetastore().ToResource(),
 			"databricks_current_user":                         scim.DataSourceCurrentUser().ToResource(),
 			"databricks_dbfs_file":                            storage.DataSourceDbfsFile().ToResource(),
 			"databricks_dbfs_file_paths":                      storage.DataSourceDbfsFilePaths().ToResource(),
+			"databricks_dbfs_inventory":                       storage.DataSourceDbfsInventory().ToResource(),
 			"databricks_directory":                            workspace.DataSourceDirectory().ToResource(),
+			"databricks_effective_grants":                     catalog.DataSourceEffectiveGrants().ToResource(),
+			"databricks_effective_permissions":                permissions.DataSourceEffectivePermissions().ToResource(),
 			"databricks_external_location":                    catalog.DataSourceExternalLocation().ToResource(),
+			"databricks_external_location_validation":         catalog.DataSourceExternalLocationValidation().ToResource(),
 			"databricks_external_locations":                   catalog.DataSourceExternalLocations().ToResource(),
 			"databricks_group":                                scim.DataSourceGroup().ToResource(),
 			"databricks_instance_pool":                        pools.DataSourceInstancePool().ToResource(),
+			"databricks_instance_pool_utilization":            pools.DataSourceInstancePoolUtilization().ToResource(),
 			"databricks_instance_profiles":                    aws.DataSourceInstanceProfiles().ToResource(),
 			"databricks_jobs":                                 jobs.DataSourceJobs().ToResource(),
 			"databricks_job":                                  jobs.DataSourceJob().ToResource(),
@@ -99,9 +106,12 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_metastores":                           catalog.DataSourceMetastores().ToResource(),
 			"databricks_mlflow_experiment":                    mlflow.DataSourceExperiment().ToResource(),
 			"databricks_mlflow_model":                         mlflow.DataSourceModel().ToResource(),
+			"databricks_mws_billable_usage":                   mws.DataSourceMwsBillableUsage().ToResource(),
 			"databricks_mws_credentials":                      mws.DataSourceMwsCredentials().ToResource(),
+			"databricks_mws_serverless_egress":                mws.DataSourceMwsServerlessEgress().ToResource(),
 			"databricks_mws_workspaces":                       mws.DataSourceMwsWorkspaces().ToResource(),
 			"databricks_node_type":                            clusters.DataSourceNodeType().ToResource(),
+			"databricks_non_compliant_clusters":               clusters.DataSourceNonCompliantClusters().ToResource(),
 			"databricks_notebook":                             workspace.DataSourceNotebook().ToResource(),
 			"databricks_notebook_paths":                       workspace.DataSourceNotebookPaths().ToResource(),
 			"databricks_pipelines":                            pipelines.DataSourcePipelines().ToResource(),
@@ -116,12 +126,18 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_sql_warehouses":                       sql.DataSourceWarehouses().ToResource(),
 			"databricks_storage_credential":                   catalog.DataSourceStorageCredential().ToResource(),
 			"databricks_storage_credentials":                  catalog.DataSourceStorageCredentials().ToResource(),
+			"databricks_system_schemas":                       catalog.DataSourceSystemSchemas().ToResource(),
 			"databricks_table":                                catalog.DataSourceTable().ToResource(),
 			"databricks_tables":                               catalog.DataSourceTables().ToResource(),
+			"databricks_temporary_table_credentials":          catalog.DataSourceTemporaryTableCredentials().ToResource(),
 			"databricks_views":                                catalog.DataSourceViews().ToResource(),
+			"databricks_vector_search_index":                  vectorsearch.DataSourceVectorSearchIndex().ToResource(),
 			"databricks_volume":                               catalog.DataSourceVolume().ToResource(),
+			"databricks_volume_files":                         storage.DataSourceVolumeFiles().ToResource(),
 			"databricks_volumes":                              catalog.DataSourceVolumes().ToResource(),
 			"databricks_user":                                 scim.DataSourceUser().ToResource(),
+			"databricks_workspace_conf":                       workspace.DataSourceWorkspaceConf().ToResource(),
+			"databricks_workspace_object":                     workspace.DataSourceWorkspaceObject().ToResource(),
 			"databricks_zones":                                clusters.DataSourceClusterZones().ToResource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{ // must be in alphabetical order
@@ -132,18 +148,27 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_azure_adls_gen2_mount":           storage.ResourceAzureAdlsGen2Mount().ToResource(),
 			"databricks_azure_blob_mount":                storage.ResourceAzureBlobMount().ToResource(),
 			"databricks_catalog":                         catalog.ResourceCatalog().ToResource(),
+			"databricks_catalog_bindings":                catalog.ResourceCatalogBindings().ToResource(),
 			"databricks_catalog_workspace_binding":       catalog.ResourceCatalogWorkspaceBinding().ToResource(),
+			"databricks_clean_room":                      sharing.ResourceCleanRoom().ToResource(),
+			"databricks_clean_room_asset":                sharing.ResourceCleanRoomAsset().ToResource(),
 			"databricks_connection":                      catalog.ResourceConnection().ToResource(),
+			"databricks_credential":                      catalog.ResourceCredential().ToResource(),
 			"databricks_cluster":                         clusters.ResourceCluster().ToResource(),
 			"databricks_cluster_policy":                  policies.ResourceClusterPolicy().ToResource(),
 			"databricks_dashboard":                       dashboards.ResourceDashboard().ToResource(),
+			"databricks_dashboard_schedule":              dashboards.ResourceDashboardSchedule().ToResource(),
 			"databricks_dbfs_file":                       storage.ResourceDbfsFile().ToResource(),
 			"databricks_directory":                       workspace.ResourceDirectory().ToResource(),
 			"databricks_entitlements":                    scim.ResourceEntitlements().ToResource(),
+			"databricks_entity_tag":                      catalog.ResourceEntityTag().ToResource(),
+			"databricks_enforce_user_isolation_setting":  workspace.ResourceEnforceUserIsolationSetting().ToResource(),
 			"databricks_external_location":               catalog.ResourceExternalLocation().ToResource(),
 			"databricks_file":                            storage.ResourceFile().ToResource(),
+			"databricks_function":                        catalog.ResourceFunction().ToResource(),
 			"databricks_git_credential":                  repos.ResourceGitCredential().ToResource(),
 			"databricks_global_init_script":              workspace.ResourceGlobalInitScript().ToResource(),
+			"databricks_global_init_script_order":        workspace.ResourceGlobalInitScriptOrder().ToResource(),
 			"databricks_grant":                           catalog.ResourceGrant().ToResource(),
 			"databricks_grants":                          catalog.ResourceGrants().ToResource(),
 			"databricks_group":                           scim.ResourceGroup().ToResource(),
@@ -171,6 +196,8 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_mws_ncc_private_endpoint_rule":   mws.ResourceMwsNccPrivateEndpointRule().ToResource(),
 			"databricks_mws_networks":                    mws.ResourceMwsNetworks().ToResource(),
 			"databricks_mws_network_connectivity_config": mws.ResourceMwsNetworkConnectivityConfig().ToResource(),
+			"databricks_mws_network_policy":              mws.ResourceMwsNetworkPolicy().ToResource(),
+			"databricks_mws_network_policy_binding":      mws.ResourceMwsNetworkPolicyBinding().ToResource(),
 			"databricks_mws_permission_assignment":       mws.ResourceMwsPermissionAssignment().ToResource(),
 			"databricks_mws_private_access_settings":     mws.ResourceMwsPrivateAccessSettings().ToResource(),
 			"databricks_mws_storage_configurations":      mws.ResourceMwsStorageConfigurations().ToResource(),
@@ -187,11 +214,13 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_quality_monitor":                 catalog.ResourceQualityMonitor().ToResource(),
 			"databricks_recipient":                       sharing.ResourceRecipient().ToResource(),
 			"databricks_registered_model":                catalog.ResourceRegisteredModel().ToResource(),
+			"databricks_registered_model_alias":          catalog.ResourceRegisteredModelAlias().ToResource(),
 			"databricks_repo":                            repos.ResourceRepo().ToResource(),
 			"databricks_schema":                          catalog.ResourceSchema().ToResource(),
 			"databricks_secret":                          secrets.ResourceSecret().ToResource(),
 			"databricks_secret_scope":                    secrets.ResourceSecretScope().ToResource(),
 			"databricks_secret_acl":                      secrets.ResourceSecretACL().ToResource(),
+			"databricks_secret_acls":                     secrets.ResourceSecretACLs().ToResource(),
 			"databricks_service_principal":               scim.ResourceServicePrincipal().ToResource(),
 			"databricks_service_principal_role":          aws.ResourceServicePrincipalRole().ToResource(),
 			"databricks_service_principal_secret":        tokens.ResourceServicePrincipalSecret().ToResource(),
@@ -208,6 +237,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_storage_credential":              catalog.ResourceStorageCredential().ToResource(),
 			"databricks_system_schema":                   catalog.ResourceSystemSchema().ToResource(),
 			"databricks_table":                           catalog.ResourceTable().ToResource(),
+			"databricks_table_constraint":                catalog.ResourceTableConstraint().ToResource(),
 			"databricks_token":                           tokens.ResourceToken().ToResource(),
 			"databricks_user":                            scim.ResourceUser().ToResource(),
 			"databricks_user_instance_profile":           aws.ResourceUserInstanceProfile().ToResource(),
@@ -215,9 +245,12 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_vector_search_endpoint":          vectorsearch.ResourceVectorSearchEndpoint().ToResource(),
 			"databricks_vector_search_index":             vectorsearch.ResourceVectorSearchIndex().ToResource(),
 			"databricks_volume":                          catalog.ResourceVolume().ToResource(),
+			"databricks_volume_directory":                storage.ResourceVolumeDirectory().ToResource(),
+			"databricks_workspace_api_token_policy":      tokens.ResourceWorkspaceApiTokenPolicy().ToResource(),
 			"databricks_workspace_binding":               catalog.ResourceWorkspaceBinding().ToResource(),
 			"databricks_workspace_conf":                  workspace.ResourceWorkspaceConf().ToResource(),
 			"databricks_workspace_file":                  workspace.ResourceWorkspaceFile().ToResource(),
+			"databricks_workspace_purge_policy":          workspace.ResourceWorkspacePurgePolicy().ToResource(),
 		},
 		Schema: providerSchema(),
 	}
@@ -257,9 +290,47 @@ func providerSchema() map[string]*schema.Schema {
 	// TODO: check if still relevant
 	ps["rate_limit"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_RATE_LIMIT", 15)
 	ps["debug_truncate_bytes"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_DEBUG_TRUNCATE_BYTES", 96)
+	ps["default_owner"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_DEFAULT_OWNER", nil),
+	}
+	ps["disallow_implicit_compute"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_DISALLOW_IMPLICIT_COMPUTE", false),
+	}
+	ps["guardrail_max_workers"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_GUARDRAIL_MAX_WORKERS", 0),
+	}
+	ps["guardrail_forbidden_node_types"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	ps["guardrail_required_tags"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	ps["guardrail_forbid_public_ip"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_GUARDRAIL_FORBID_PUBLIC_IP", false),
+	}
 	return ps
 }
 
+func stringList(v any) []string {
+	result := []string{}
+	for _, item := range v.([]any) {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
 func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 	cfg := &config.Config{}
 	attrsUsed := []string{}
@@ -305,7 +376,15 @@ func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any
 		return nil, diag.FromErr(err)
 	}
 	pc := &common.DatabricksClient{
-		DatabricksClient: client,
+		DatabricksClient:        client,
+		DefaultOwner:            d.Get("default_owner").(string),
+		DisallowImplicitCompute: d.Get("disallow_implicit_compute").(bool),
+		PlanGuardrails: common.PlanGuardrails{
+			MaxWorkers:         d.Get("guardrail_max_workers").(int),
+			ForbiddenNodeTypes: stringList(d.Get("guardrail_forbidden_node_types")),
+			RequiredTags:       stringList(d.Get("guardrail_required_tags")),
+			ForbidPublicIP:     d.Get("guardrail_forbid_public_ip").(bool),
+		},
 	}
 	pc.WithCommandExecutor(func(ctx context.Context, client *common.DatabricksClient) common.CommandExecutor {
 		return commands.NewCommandsAPI(ctx, client)
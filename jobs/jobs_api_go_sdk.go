@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -197,9 +199,184 @@ func prepareJobSettingsForUpdateGoSdk(d *schema.ResourceData, js *JobSettingsRes
 			return err
 		}
 	}
+	setTaskForceSendFields(d, js.Tasks)
+	return mergeTaskEnvironmentVariables(d, js.Tasks)
+}
+
+// taskZeroValueFields are task attributes, which zero values are meaningful, e.g. `max_retries = 0` or
+// `retry_on_timeout = false`. They're omitted from requests unless added to ForceSendFields.
+var taskZeroValueFields = []string{
+	"timeout_seconds",
+	"max_retries",
+	"min_retry_interval_millis",
+	"retry_on_timeout",
+	"disable_auto_optimization",
+}
+
+// nestedAttributeGetter reads attributes of a nested block, e.g. `task.0.`
+type nestedAttributeGetter struct {
+	d      *schema.ResourceData
+	prefix string
+}
+
+func (n nestedAttributeGetter) GetOk(key string) (any, bool) {
+	return n.d.GetOk(n.prefix + key)
+}
+
+func (n nestedAttributeGetter) GetOkExists(key string) (any, bool) {
+	return n.d.GetOkExists(n.prefix + key)
+}
+
+// setTaskForceSendFields makes sure that zero values explicitly set for tasks and nested tasks of `for_each_task`
+// are sent. Tasks must be in the order of the configuration, i.e. not sorted yet.
+func setTaskForceSendFields(d *schema.ResourceData, tasks []jobs.Task) {
+	for i := range tasks {
+		prefix := fmt.Sprintf("task.%d.", i)
+		common.SetForceSendFields(&tasks[i], nestedAttributeGetter{d, prefix}, taskZeroValueFields)
+		if tasks[i].ForEachTask != nil {
+			common.SetForceSendFields(&tasks[i].ForEachTask.Task,
+				nestedAttributeGetter{d, prefix + "for_each_task.0.task.0."}, taskZeroValueFields)
+		}
+	}
+}
+
+// validateJobInitScripts checks init scripts of all clusters defined in the job before it's created or updated
+func validateJobInitScripts(ctx context.Context, w *databricks.WorkspaceClient, tasks []jobs.Task, jobClusters []jobs.JobCluster) error {
+	specs := []*compute.ClusterSpec{}
+	for _, task := range tasks {
+		if task.NewCluster != nil {
+			specs = append(specs, task.NewCluster)
+		}
+		if task.ForEachTask != nil && task.ForEachTask.Task.NewCluster != nil {
+			specs = append(specs, task.ForEachTask.Task.NewCluster)
+		}
+	}
+	for i := range jobClusters {
+		specs = append(specs, &jobClusters[i].NewCluster)
+	}
+	for _, spec := range specs {
+		err := clusters.ValidateInitScripts(ctx, w, spec.DataSecurityMode, spec.InitScripts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	envVarNameRegex       = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	secretReferenceRegex  = regexp.MustCompile(`^\{\{secrets/[^/{}]+/[^/{}]+\}\}$`)
+	secretReferencePrefix = "{{secrets/"
+)
+
+func stringMap(v any) map[string]string {
+	m, _ := v.(map[string]any)
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+// validateTaskEnvironmentVariables checks names and secret references of `environment_variables` of the task. They're
+// merged into `spark_env_vars` of the task cluster, because job clusters are shared between tasks, and the environment
+// spec of serverless tasks has only the client version and dependencies, so neither could have variables per task.
+func validateTaskEnvironmentVariables(task jobs.Task, envVars map[string]string) error {
+	if len(envVars) == 0 {
+		return nil
+	}
+	switch {
+	case task.NewCluster != nil:
+	case task.JobClusterKey != "":
+		return fmt.Errorf("environment_variables require new_cluster, job cluster %s is shared between tasks, "+
+			"use its spark_env_vars instead", task.JobClusterKey)
+	case task.ExistingClusterId != "":
+		return fmt.Errorf("environment_variables require new_cluster, use spark_env_vars of the existing cluster instead")
+	default:
+		return fmt.Errorf("environment_variables require new_cluster, serverless environments don't support " +
+			"environment variables")
+	}
+	for name, value := range envVars {
+		if !envVarNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid environment variable name: %s", name)
+		}
+		if strings.Contains(value, secretReferencePrefix) && !secretReferenceRegex.MatchString(value) {
+			return fmt.Errorf("environment variable %s must be a secret reference in the form of "+
+				"{{secrets/<scope>/<key>}}, got: %s", name, value)
+		}
+		if existing, ok := task.NewCluster.SparkEnvVars[name]; ok && existing != value {
+			return fmt.Errorf("environment variable %s is already set in spark_env_vars of new_cluster", name)
+		}
+	}
+	return nil
+}
+
+// mergeTaskEnvironmentVariables adds `environment_variables` of tasks to `spark_env_vars` of their clusters. Tasks
+// must be in the order of the configuration, i.e. not sorted yet.
+func mergeTaskEnvironmentVariables(d *schema.ResourceData, tasks []jobs.Task) error {
+	for i := range tasks {
+		envVars := stringMap(d.Get(fmt.Sprintf("task.%d.environment_variables", i)))
+		err := validateTaskEnvironmentVariables(tasks[i], envVars)
+		if err != nil {
+			return fmt.Errorf("task %s invalid: %w", tasks[i].TaskKey, err)
+		}
+		if len(envVars) == 0 {
+			continue
+		}
+		if tasks[i].NewCluster.SparkEnvVars == nil {
+			tasks[i].NewCluster.SparkEnvVars = map[string]string{}
+		}
+		for name, value := range envVars {
+			tasks[i].NewCluster.SparkEnvVars[name] = value
+		}
+	}
 	return nil
 }
 
+// extractTaskEnvironmentVariables removes `environment_variables` known from the state from `spark_env_vars` of
+// task clusters returned by the API, so that they don't show up as a diff of `new_cluster`. It returns the removed
+// variables by task key.
+func extractTaskEnvironmentVariables(d *schema.ResourceData, tasks []jobs.Task) map[string]map[string]string {
+	known := map[string]map[string]string{}
+	for i := range d.Get("task").([]any) {
+		envVars := stringMap(d.Get(fmt.Sprintf("task.%d.environment_variables", i)))
+		if len(envVars) > 0 {
+			known[d.Get(fmt.Sprintf("task.%d.task_key", i)).(string)] = envVars
+		}
+	}
+	extracted := map[string]map[string]string{}
+	for i := range tasks {
+		envVars, ok := known[tasks[i].TaskKey]
+		if !ok || tasks[i].NewCluster == nil {
+			continue
+		}
+		found := map[string]string{}
+		for name, value := range envVars {
+			if actual, ok := tasks[i].NewCluster.SparkEnvVars[name]; ok && actual == value {
+				found[name] = value
+				delete(tasks[i].NewCluster.SparkEnvVars, name)
+			}
+		}
+		extracted[tasks[i].TaskKey] = found
+	}
+	return extracted
+}
+
+// setTaskEnvironmentVariables stores `environment_variables` extracted from task clusters in the state
+func setTaskEnvironmentVariables(d *schema.ResourceData, extracted map[string]map[string]string) error {
+	if len(extracted) == 0 {
+		return nil
+	}
+	tasks := d.Get("task").([]any)
+	for _, raw := range tasks {
+		task := raw.(map[string]any)
+		if envVars, ok := extracted[task["task_key"].(string)]; ok {
+			task["environment_variables"] = envVars
+		}
+	}
+	return d.Set("task", tasks)
+}
+
 func prepareJobSettingsForCreateGoSdk(d *schema.ResourceData, jc *JobCreateStruct) error {
 	// We always need to add NumWorkers into ForceSendField for the go-sdk client.
 	// Before the go-sdk migration, the field `num_workers` was required, so we always sent it.
@@ -217,7 +394,8 @@ func prepareJobSettingsForCreateGoSdk(d *schema.ResourceData, jc *JobCreateStruc
 			return err
 		}
 	}
-	return nil
+	setTaskForceSendFields(d, jc.Tasks)
+	return mergeTaskEnvironmentVariables(d, jc.Tasks)
 }
 
 func Create(createJob jobs.CreateJob, w *databricks.WorkspaceClient, ctx context.Context) (int64, error) {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -159,6 +160,8 @@ type ForEachNestedTask struct {
 	MinRetryIntervalMillis int32                          `json:"min_retry_interval_millis,omitempty"`
 	RetryOnTimeout         bool                           `json:"retry_on_timeout,omitempty" tf:"computed"`
 	Health                 *JobHealth                     `json:"health,omitempty"`
+	// DisableAutoOptimization is only applicable to tasks running on serverless compute
+	DisableAutoOptimization bool `json:"disable_auto_optimization,omitempty"`
 
 	EnvironmentKey string `json:"environment_key,omitempty"`
 }
@@ -241,6 +244,8 @@ type JobTaskSettings struct {
 	MinRetryIntervalMillis int32                          `json:"min_retry_interval_millis,omitempty"`
 	RetryOnTimeout         bool                           `json:"retry_on_timeout,omitempty" tf:"computed"`
 	Health                 *JobHealth                     `json:"health,omitempty"`
+	// DisableAutoOptimization is only applicable to tasks running on serverless compute
+	DisableAutoOptimization bool `json:"disable_auto_optimization,omitempty"`
 
 	EnvironmentKey string `json:"environment_key,omitempty"`
 }
@@ -528,8 +533,6 @@ func (JobSettingsResource) CustomizeSchema(s *common.CustomizableSchema) *common
 
 	// Computed
 	s.SchemaPath("run_as").SetComputed()
-	s.SchemaPath("task", "retry_on_timeout").SetComputed()
-	s.SchemaPath("task", "for_each_task", "task", "retry_on_timeout").SetComputed()
 	s.SchemaPath("format").SetComputed()
 
 	// Default
@@ -562,6 +565,19 @@ func (JobSettingsResource) CustomizeSchema(s *common.CustomizableSchema) *common
 		Optional: true,
 		Default:  false,
 		Type:     schema.TypeBool,
+	}).AddNewField("resolve_git_commit", &schema.Schema{
+		Optional: true,
+		Default:  false,
+		Type:     schema.TypeBool,
+	}).AddNewField("resolved_commit", &schema.Schema{
+		Computed: true,
+		Type:     schema.TypeString,
+	})
+
+	s.SchemaPath("task").AddNewField("environment_variables", &schema.Schema{
+		Optional: true,
+		Type:     schema.TypeMap,
+		Elem:     &schema.Schema{Type: schema.TypeString},
 	})
 
 	s.SchemaPath("always_running").SetConflictsWith([]string{"control_run_state", "continuous"})
@@ -900,6 +916,34 @@ func gitSourceSchema(s map[string]*schema.Schema, prefix string) {
 	s["tag"].ConflictsWith = []string{"git_source.0.branch", "git_source.0.commit"}
 	s["branch"].ConflictsWith = []string{"git_source.0.commit", "git_source.0.tag"}
 	s["commit"].ConflictsWith = []string{"git_source.0.branch", "git_source.0.tag"}
+	s["commit"].ValidateFunc = validation.StringMatch(gitCommitRegex, "commit must be a Git commit SHA")
+}
+
+var gitCommitRegex = regexp.MustCompile(`^[0-9a-fA-F]{4,40}$`)
+
+// resolveGitCommit returns the commit pinned in git_source or, if `resolve_git_commit` is set, the commit used by
+// the latest run of the job, so that it's possible to audit which revision of the code was executed. Runs are listed
+// only on request, as it's an extra API call on every read.
+func resolveGitCommit(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData,
+	jobID int64, commit string) (string, error) {
+	if commit != "" || !d.Get("resolve_git_commit").(bool) {
+		return commit, nil
+	}
+	runs := w.Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+		JobId: jobID,
+		Limit: 1,
+	})
+	if !runs.HasNext(ctx) {
+		return "", nil
+	}
+	run, err := runs.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	if run.GitSource == nil || run.GitSource.GitSnapshot == nil {
+		return "", nil
+	}
+	return run.GitSource.GitSnapshot.UsedCommit, nil
 }
 
 func fixWebhookNotifications(s map[string]*schema.Schema) {
@@ -1068,13 +1112,25 @@ func ResourceJob() common.Resource {
 					return fmt.Errorf("`control_run_state` must be specified only with `max_concurrent_runs = 1`")
 				}
 			}
-			for _, task := range js.Tasks {
+			for i, task := range js.Tasks {
+				envVars := stringMap(d.Get(fmt.Sprintf("task.%d.environment_variables", i)))
+				if err := validateTaskEnvironmentVariables(task, envVars); err != nil {
+					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
+				}
 				if task.NewCluster == nil {
 					continue
 				}
 				if err := clusters.Validate(*task.NewCluster); err != nil {
 					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
 				}
+				if err := clusters.ValidateInitScriptsSpec(task.NewCluster.DataSecurityMode, task.NewCluster.InitScripts); err != nil {
+					return fmt.Errorf("task %s invalid: %w", task.TaskKey, err)
+				}
+			}
+			for _, jc := range js.JobClusters {
+				if err := clusters.ValidateInitScriptsSpec(jc.NewCluster.DataSecurityMode, jc.NewCluster.InitScripts); err != nil {
+					return fmt.Errorf("job cluster %s invalid: %w", jc.JobClusterKey, err)
+				}
 			}
 			if js.NewCluster != nil {
 				if err := clusters.Validate(*js.NewCluster); err != nil {
@@ -1098,6 +1154,10 @@ func ResourceJob() common.Resource {
 				if err != nil {
 					return err
 				}
+				err = validateJobInitScripts(ctx, w, cj.Tasks, cj.JobClusters)
+				if err != nil {
+					return err
+				}
 				jobId, err := Create(cj.CreateJob, w, ctx)
 				if err != nil {
 					return err
@@ -1134,11 +1194,23 @@ func ResourceJob() common.Resource {
 					return err
 				}
 				d.Set("url", c.FormatURL("#job/", d.Id()))
+				if job.Settings.GitSource != nil {
+					resolvedCommit, err := resolveGitCommit(ctx, w, d, jobID, job.Settings.GitSource.GitCommit)
+					if err != nil {
+						return err
+					}
+					d.Set("resolved_commit", resolvedCommit)
+				}
 
+				envVars := extractTaskEnvironmentVariables(d, job.Settings.Tasks)
 				res := JobSettingsResource{
 					JobSettings: *job.Settings,
 				}
-				return common.StructToData(res, jobsGoSdkSchema, d)
+				err = common.StructToData(res, jobsGoSdkSchema, d)
+				if err != nil {
+					return err
+				}
+				return setTaskEnvironmentVariables(d, envVars)
 			} else {
 				// Api 2.0
 				// TODO: Deprecate and remove this code path
@@ -1147,6 +1219,17 @@ func ResourceJob() common.Resource {
 					return err
 				}
 				d.Set("url", c.FormatURL("#job/", d.Id()))
+				if job.Settings.GitSource != nil {
+					w, err := c.WorkspaceClient()
+					if err != nil {
+						return err
+					}
+					resolvedCommit, err := resolveGitCommit(ctx, w, d, job.JobID, job.Settings.GitSource.Commit)
+					if err != nil {
+						return err
+					}
+					d.Set("resolved_commit", resolvedCommit)
+				}
 				return common.StructToData(*job.Settings, jobsGoSdkSchema, d)
 			}
 		},
@@ -1167,6 +1250,10 @@ func ResourceJob() common.Resource {
 				if err != nil {
 					return err
 				}
+				err = validateJobInitScripts(ctx, w, jsr.Tasks, jsr.JobClusters)
+				if err != nil {
+					return err
+				}
 				err = Update(jobID, jsr, w, ctx)
 				if err != nil {
 					return err
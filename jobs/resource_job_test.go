@@ -609,6 +609,252 @@ func TestResourceJobCreate_ForEachTask(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "789", d.Id())
 }
+func TestResourceJobCreate_TaskRetriesZeroValues(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: jobs.CreateJob{
+					Name: "Retries",
+					Tasks: []jobs.Task{
+						{
+							TaskKey: "a",
+							ForEachTask: &jobs.ForEachTask{
+								Inputs: "[1, 2]",
+								Task: jobs.Task{
+									TaskKey:           "nested",
+									ExistingClusterId: "abc",
+									NotebookTask: &jobs.NotebookTask{
+										NotebookPath: "/Stuff",
+									},
+									MaxRetries:      0,
+									ForceSendFields: []string{"MaxRetries"},
+								},
+							},
+							TimeoutSeconds:  0,
+							RetryOnTimeout:  false,
+							ForceSendFields: []string{"RetryOnTimeout", "TimeoutSeconds"},
+						},
+						{
+							TaskKey:                 "b",
+							NotebookTask:            &jobs.NotebookTask{NotebookPath: "/Stuff"},
+							MaxRetries:              2,
+							MinRetryIntervalMillis:  0,
+							DisableAutoOptimization: false,
+							ForceSendFields:         []string{"DisableAutoOptimization", "MaxRetries", "MinRetryIntervalMillis"},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "a",
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Retries"
+
+		task {
+			task_key = "a"
+			timeout_seconds = 0
+			retry_on_timeout = false
+			for_each_task {
+				inputs = "[1, 2]"
+				task {
+					task_key = "nested"
+					existing_cluster_id = "abc"
+					max_retries = 0
+					notebook_task {
+						notebook_path = "/Stuff"
+					}
+				}
+			}
+		}
+
+		task {
+			task_key = "b"
+			max_retries = 2
+			min_retry_interval_millis = 0
+			disable_auto_optimization = false
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "789", d.Id())
+}
+
+func TestResourceJobCreate_TaskEnvironmentVariables(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: jobs.CreateJob{
+					Name: "EnvVars",
+					Tasks: []jobs.Task{
+						{
+							TaskKey: "a",
+							NewCluster: &compute.ClusterSpec{
+								SparkVersion: "a",
+								NodeTypeId:   "b",
+								NumWorkers:   1,
+								SparkEnvVars: map[string]string{
+									"PYSPARK_PYTHON": "/databricks/python3/bin/python3",
+									"ENVIRONMENT":    "dev",
+									"API_TOKEN":      "{{secrets/scope/token}}",
+								},
+							},
+							NotebookTask: &jobs.NotebookTask{NotebookPath: "/Stuff"},
+						},
+					},
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: jobs.Job{
+					JobId: 789,
+					Settings: &jobs.JobSettings{
+						Name: "EnvVars",
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "a",
+								NewCluster: &compute.ClusterSpec{
+									SparkVersion: "a",
+									NodeTypeId:   "b",
+									NumWorkers:   1,
+									SparkEnvVars: map[string]string{
+										"PYSPARK_PYTHON": "/databricks/python3/bin/python3",
+										"ENVIRONMENT":    "dev",
+										"API_TOKEN":      "{{secrets/scope/token}}",
+									},
+								},
+								NotebookTask: &jobs.NotebookTask{NotebookPath: "/Stuff"},
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "EnvVars"
+
+		task {
+			task_key = "a"
+			new_cluster {
+				spark_version = "a"
+				node_type_id = "b"
+				num_workers = 1
+				spark_env_vars = {
+					PYSPARK_PYTHON = "/databricks/python3/bin/python3"
+				}
+			}
+			environment_variables = {
+				ENVIRONMENT = "dev"
+				API_TOKEN = "{{secrets/scope/token}}"
+			}
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, map[string]any{
+		"ENVIRONMENT": "dev",
+		"API_TOKEN":   "{{secrets/scope/token}}",
+	}, d.Get("task.0.environment_variables"))
+	assert.Equal(t, map[string]any{
+		"PYSPARK_PYTHON": "/databricks/python3/bin/python3",
+	}, d.Get("task.0.new_cluster.0.spark_env_vars"))
+}
+
+func TestResourceJobCreate_TaskEnvironmentVariables_Invalid(t *testing.T) {
+	for hcl, expectedErr := range map[string]string{
+		`new_cluster {
+			spark_version = "a"
+			node_type_id = "b"
+			num_workers = 1
+		}
+		environment_variables = {
+			"1_INVALID" = "x"
+		}`: "task a invalid: invalid environment variable name: 1_INVALID",
+		`new_cluster {
+			spark_version = "a"
+			node_type_id = "b"
+			num_workers = 1
+		}
+		environment_variables = {
+			TOKEN = "{{secrets/scope}}"
+		}`: "task a invalid: environment variable TOKEN must be a secret reference in the form of " +
+			"{{secrets/<scope>/<key>}}, got: {{secrets/scope}}",
+		`new_cluster {
+			spark_version = "a"
+			node_type_id = "b"
+			num_workers = 1
+			spark_env_vars = {
+				ENVIRONMENT = "prod"
+			}
+		}
+		environment_variables = {
+			ENVIRONMENT = "dev"
+		}`: "task a invalid: environment variable ENVIRONMENT is already set in spark_env_vars of new_cluster",
+		`environment_key = "default"
+		environment_variables = {
+			ENVIRONMENT = "dev"
+		}`: "task a invalid: environment_variables require new_cluster, serverless environments don't support " +
+			"environment variables",
+		`job_cluster_key = "shared"
+		environment_variables = {
+			ENVIRONMENT = "dev"
+		}`: "task a invalid: environment_variables require new_cluster, job cluster shared is shared between tasks, " +
+			"use its spark_env_vars instead",
+		`existing_cluster_id = "abc"
+		environment_variables = {
+			ENVIRONMENT = "dev"
+		}`: "task a invalid: environment_variables require new_cluster, use spark_env_vars of the existing cluster instead",
+	} {
+		qa.ResourceFixture{
+			Create:   true,
+			Resource: ResourceJob(),
+			HCL: `
+			name = "EnvVars"
+			task {
+				task_key = "a"
+				notebook_task {
+					notebook_path = "/Stuff"
+				}
+				` + hcl + `
+			}`,
+		}.ExpectError(t, expectedErr)
+	}
+}
+
 func TestResourceJobCreate_JobParameters(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -2121,6 +2367,139 @@ func TestResourceJobRead(t *testing.T) {
 	assert.Equal(t, "abc", d.Get("existing_cluster_id"))
 }
 
+const gitSourceJobHcl = `
+name = "GitSourceJob"
+
+git_source {
+	url = "https://github.com/databricks/terraform-provider-databricks"
+	branch = "main"
+}
+
+task {
+	task_key = "b"
+
+	notebook_task {
+		notebook_path = "/GitSourcedNotebook"
+	}
+}`
+
+func gitSourceJobGetFixture(gitSource *jobs.GitSource) qa.HTTPFixture {
+	return qa.HTTPFixture{
+		Method:   "GET",
+		Resource: "/api/2.1/jobs/get?job_id=789",
+		Response: jobs.Job{
+			JobId: 789,
+			Settings: &jobs.JobSettings{
+				Name:      "GitSourceJob",
+				GitSource: gitSource,
+				Tasks: []jobs.Task{
+					{
+						TaskKey: "b",
+						NotebookTask: &jobs.NotebookTask{
+							NotebookPath: "/GitSourcedNotebook",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResourceJobRead_ResolvedCommitFromLatestRun(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			gitSourceJobGetFixture(&jobs.GitSource{
+				GitUrl:      "https://github.com/databricks/terraform-provider-databricks",
+				GitProvider: jobs.GitProviderGitHub,
+				GitBranch:   "main",
+			}),
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/runs/list?job_id=789&limit=1",
+				Response: jobs.ListRunsResponse{
+					Runs: []jobs.BaseRun{
+						{
+							RunId: 1,
+							GitSource: &jobs.GitSource{
+								GitBranch: "main",
+								GitSnapshot: &jobs.GitSnapshot{
+									UsedCommit: "4a2b6f0e",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		HCL:      gitSourceJobHcl + "\nresolve_git_commit = true",
+		Read:     true,
+		ID:       "789",
+	}.ApplyAndExpectData(t, map[string]any{
+		"git_source.0.branch": "main",
+		"resolved_commit":     "4a2b6f0e",
+	})
+}
+
+func TestResourceJobRead_ResolvedCommitNotRequested(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			gitSourceJobGetFixture(&jobs.GitSource{
+				GitUrl:      "https://github.com/databricks/terraform-provider-databricks",
+				GitProvider: jobs.GitProviderGitHub,
+				GitBranch:   "main",
+			}),
+		},
+		Resource: ResourceJob(),
+		HCL:      gitSourceJobHcl,
+		Read:     true,
+		ID:       "789",
+	}.ApplyAndExpectData(t, map[string]any{
+		"git_source.0.branch": "main",
+		"resolved_commit":     "",
+	})
+}
+
+func TestResourceJobRead_ResolvedCommitPinned(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			gitSourceJobGetFixture(&jobs.GitSource{
+				GitUrl:      "https://github.com/databricks/terraform-provider-databricks",
+				GitProvider: jobs.GitProviderGitHub,
+				GitCommit:   "a26bf6c",
+			}),
+		},
+		Resource: ResourceJob(),
+		HCL:      gitSourceJobHcl,
+		Read:     true,
+		ID:       "789",
+	}.ApplyAndExpectData(t, map[string]any{
+		"git_source.0.commit": "a26bf6c",
+		"resolved_commit":     "a26bf6c",
+	})
+}
+
+func TestResourceJobCreateFromGitSource_InvalidCommit(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `name = "GitSourceJob"
+
+		git_source {
+			url = "https://github.com/databricks/terraform-provider-databricks"
+			commit = "main"
+		}
+
+		task {
+			task_key = "b"
+
+			notebook_task {
+				notebook_path = "/GitSourcedNotebook"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [git_source.#.commit] invalid value for git_source.0.commit (commit must be a Git commit SHA)")
+}
+
 func TestResourceJobRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -2668,6 +3047,76 @@ func TestResourceJobUpdate_Tasks(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestResourceJobUpdate_TaskRetryOnTimeoutRemoved(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/reset",
+				ExpectedRequest: jobs.ResetJob{
+					JobId: 789,
+					NewSettings: jobs.JobSettings{
+						Name: "Featurizer",
+						Tasks: []jobs.Task{
+							{
+								TaskKey:           "task1",
+								ExistingClusterId: "abc",
+								SparkJarTask: &jobs.SparkJarTask{
+									MainClassName: "com.labs.BarMain",
+								},
+								RetryOnTimeout:  false,
+								ForceSendFields: []string{"RetryOnTimeout"},
+							},
+						},
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					Settings: &JobSettings{
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "task1",
+								ExistingClusterID: "abc",
+								SparkJarTask: &SparkJarTask{
+									MainClassName: "com.labs.BarMain",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ID:     "789",
+		Update: true,
+		InstanceState: map[string]string{
+			"name":                       "Featurizer",
+			"max_concurrent_runs":        "1",
+			"task.#":                     "1",
+			"task.0.task_key":            "task1",
+			"task.0.existing_cluster_id": "abc",
+			"task.0.retry_on_timeout":    "true",
+			"task.0.spark_jar_task.#":    "1",
+			"task.0.spark_jar_task.0.main_class_name": "com.labs.BarMain",
+		},
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		task {
+			task_key = "task1"
+			existing_cluster_id = "abc"
+			spark_jar_task {
+				main_class_name = "com.labs.BarMain"
+			}
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"task.0.retry_on_timeout": false,
+	})
+}
+
 func TestResourceJobUpdate_Restart(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -0,0 +1,197 @@
+package mws
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type billableUsage struct {
+	// Period is the month (YYYY-MM) or the day (YYYY-MM-DD) of the usage, depending on the granularity
+	Period       string            `json:"period"`
+	WorkspaceId  int64             `json:"workspace_id"`
+	Sku          string            `json:"sku"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Dbus         float64           `json:"dbus"`
+	MachineHours float64           `json:"machine_hours"`
+}
+
+type billableUsageData struct {
+	StartMonth   string          `json:"start_month"`
+	EndMonth     string          `json:"end_month"`
+	Granularity  string          `json:"granularity,omitempty" tf:"default:MONTH"`
+	WorkspaceIds []int64         `json:"workspace_ids,omitempty"`
+	Skus         []string        `json:"skus,omitempty"`
+	TagKeys      []string        `json:"tag_keys,omitempty"`
+	Usage        []billableUsage `json:"usage,omitempty" tf:"computed"`
+	TotalDbus    float64         `json:"total_dbus,omitempty" tf:"computed"`
+}
+
+var billableUsageMonthRegex = regexp.MustCompile(`^\d{4}-\d{2}$`)
+
+// usageRecord is a row of the billable usage log, that has the usage of a cluster in a given hour
+type usageRecord struct {
+	workspaceId  int64
+	timestamp    string
+	sku          string
+	dbus         float64
+	machineHours float64
+	tags         map[string]string
+}
+
+// readUsageRecords parses CSV billable usage logs. Tags are a JSON object, that includes both custom and
+// default tags of the cluster.
+func readUsageRecords(r io.Reader) ([]usageRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read billable usage: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range []string{"workspaceId", "timestamp", "sku", "dbus"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("billable usage doesn't have %s column", name)
+		}
+	}
+	value := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+	var records []usageRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read billable usage: %w", err)
+		}
+		record := usageRecord{
+			timestamp: value(row, "timestamp"),
+			sku:       value(row, "sku"),
+		}
+		record.workspaceId, err = strconv.ParseInt(value(row, "workspaceId"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspace id: %w", err)
+		}
+		record.dbus, err = strconv.ParseFloat(value(row, "dbus"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dbus: %w", err)
+		}
+		if machineHours := value(row, "machineHours"); machineHours != "" {
+			record.machineHours, _ = strconv.ParseFloat(machineHours, 64)
+		}
+		if tags := value(row, "tags"); tags != "" {
+			// malformed tags are treated as missing, as they don't affect the usage
+			_ = json.Unmarshal([]byte(tags), &record.tags)
+		}
+		records = append(records, record)
+	}
+}
+
+// aggregate sums up usage records by period, workspace, SKU and values of requested tags
+func (data *billableUsageData) aggregate(records []usageRecord) {
+	periodLength := len("2006-01")
+	if data.Granularity == "DAY" {
+		periodLength = len("2006-01-02")
+	}
+	aggregated := map[string]*billableUsage{}
+	data.Usage = []billableUsage{}
+	data.TotalDbus = 0
+	for _, r := range records {
+		if len(data.WorkspaceIds) > 0 && !slices.Contains(data.WorkspaceIds, r.workspaceId) {
+			continue
+		}
+		if len(data.Skus) > 0 && !slices.Contains(data.Skus, r.sku) {
+			continue
+		}
+		period := r.timestamp
+		if len(period) > periodLength {
+			period = period[:periodLength]
+		}
+		key := []string{period, strconv.FormatInt(r.workspaceId, 10), r.sku}
+		var tags map[string]string
+		for _, k := range data.TagKeys {
+			if v, ok := r.tags[k]; ok {
+				if tags == nil {
+					tags = map[string]string{}
+				}
+				tags[k] = v
+			}
+			key = append(key, r.tags[k])
+		}
+		id := strings.Join(key, "\x00")
+		usage, ok := aggregated[id]
+		if !ok {
+			usage = &billableUsage{
+				Period:      period,
+				WorkspaceId: r.workspaceId,
+				Sku:         r.sku,
+				Tags:        tags,
+			}
+			aggregated[id] = usage
+		}
+		usage.Dbus += r.dbus
+		usage.MachineHours += r.machineHours
+		data.TotalDbus += r.dbus
+	}
+	ids := make([]string, 0, len(aggregated))
+	for id := range aggregated {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		data.Usage = append(data.Usage, *aggregated[id])
+	}
+}
+
+// DataSourceMwsBillableUsage downloads billable usage logs of the account and aggregates them,
+// so that they could be used for chargeback reports and budget alerts
+func DataSourceMwsBillableUsage() common.Resource {
+	return common.AccountData(func(ctx context.Context, data *billableUsageData, acc *databricks.AccountClient) error {
+		for _, month := range []string{data.StartMonth, data.EndMonth} {
+			if !billableUsageMonthRegex.MatchString(month) {
+				return fmt.Errorf("invalid month %s, expected format is YYYY-MM", month)
+			}
+		}
+		if data.Granularity != "MONTH" && data.Granularity != "DAY" {
+			return fmt.Errorf("granularity must be MONTH or DAY, not %s", data.Granularity)
+		}
+		res, err := acc.BillableUsage.Download(ctx, billing.DownloadRequest{
+			StartMonth: data.StartMonth,
+			EndMonth:   data.EndMonth,
+		})
+		if err != nil {
+			return err
+		}
+		defer res.Contents.Close()
+		records, err := readUsageRecords(res.Contents)
+		if err != nil {
+			return err
+		}
+		data.aggregate(records)
+		return nil
+	})
+}
@@ -0,0 +1,111 @@
+package mws
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+const billableUsageCsv = `workspaceId,timestamp,clusterId,clusterName,sku,dbus,machineHours,tags
+123,2024-01-01T10:00:00.000Z,c1,etl,STANDARD_ALL_PURPOSE_COMPUTE,1.5,1,"{""team"":""data"",""env"":""prod""}"
+123,2024-01-02T10:00:00.000Z,c1,etl,STANDARD_ALL_PURPOSE_COMPUTE,2.5,2,"{""team"":""data"",""env"":""dev""}"
+123,2024-02-01T10:00:00.000Z,c2,bi,STANDARD_JOBS_COMPUTE,4,1,"{""team"":""bi""}"
+456,2024-01-05T10:00:00.000Z,c3,ml,STANDARD_JOBS_COMPUTE,3,1,
+`
+
+func mockBillableUsage(a *mocks.MockAccountClient) {
+	a.GetMockBillableUsageAPI().EXPECT().Download(mock.Anything, billing.DownloadRequest{
+		StartMonth: "2024-01",
+		EndMonth:   "2024-02",
+	}).Return(&billing.DownloadResponse{
+		Contents: io.NopCloser(strings.NewReader(billableUsageCsv)),
+	}, nil)
+}
+
+func TestDataSourceMwsBillableUsage_ByMonth(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: mockBillableUsage,
+		Resource:              DataSourceMwsBillableUsage(),
+		Read:                  true,
+		NonWritable:           true,
+		ID:                    "_",
+		AccountID:             "abc",
+		HCL: `
+		start_month   = "2024-01"
+		end_month     = "2024-02"
+		workspace_ids = [123]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"total_dbus":            8.0,
+		"usage.#":               2,
+		"usage.0.period":        "2024-01",
+		"usage.0.workspace_id":  123,
+		"usage.0.sku":           "STANDARD_ALL_PURPOSE_COMPUTE",
+		"usage.0.dbus":          4.0,
+		"usage.0.machine_hours": 3.0,
+		"usage.1.period":        "2024-02",
+		"usage.1.sku":           "STANDARD_JOBS_COMPUTE",
+		"usage.1.dbus":          4.0,
+	})
+}
+
+func TestDataSourceMwsBillableUsage_ByDayAndTag(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: mockBillableUsage,
+		Resource:              DataSourceMwsBillableUsage(),
+		Read:                  true,
+		NonWritable:           true,
+		ID:                    "_",
+		AccountID:             "abc",
+		HCL: `
+		start_month = "2024-01"
+		end_month   = "2024-02"
+		granularity = "DAY"
+		skus        = ["STANDARD_ALL_PURPOSE_COMPUTE"]
+		tag_keys    = ["env"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"total_dbus":       4.0,
+		"usage.#":          2,
+		"usage.0.period":   "2024-01-01",
+		"usage.0.tags.env": "prod",
+		"usage.0.dbus":     1.5,
+		"usage.1.period":   "2024-01-02",
+		"usage.1.tags.env": "dev",
+		"usage.1.dbus":     2.5,
+	})
+}
+
+func TestDataSourceMwsBillableUsage_InvalidMonth(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceMwsBillableUsage(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		AccountID:   "abc",
+		HCL: `
+		start_month = "2024-1"
+		end_month   = "2024-02"
+		`,
+	}.ExpectError(t, "invalid month 2024-1, expected format is YYYY-MM")
+}
+
+func TestDataSourceMwsBillableUsage_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceMwsBillableUsage(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		AccountID:   "abc",
+		HCL: `
+		start_month = "2024-01"
+		end_month   = "2024-02"
+		`,
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,96 @@
+package mws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type serverlessEgressData struct {
+	WorkspaceId                 int64    `json:"workspace_id,omitempty" tf:"computed"`
+	NetworkConnectivityConfigId string   `json:"network_connectivity_config_id,omitempty" tf:"computed"`
+	Region                      string   `json:"region,omitempty" tf:"computed"`
+	CidrBlocks                  []string `json:"cidr_blocks,omitempty" tf:"computed"`
+	Subnets                     []string `json:"subnets,omitempty" tf:"computed"`
+	TargetRegion                string   `json:"target_region,omitempty" tf:"computed"`
+	TargetServices              []string `json:"target_services,omitempty" tf:"computed"`
+}
+
+// workspaceNetworkConnectivityConfigId returns the id of network connectivity config attached to a workspace
+func workspaceNetworkConnectivityConfigId(ctx context.Context, c *common.DatabricksClient, workspaceId int64) (string, error) {
+	var ws struct {
+		NetworkConnectivityConfigId string `json:"network_connectivity_config_id,omitempty"`
+	}
+	err := c.Get(ctx, fmt.Sprintf("/accounts/%s/workspaces/%d", c.Config.AccountID, workspaceId), nil, &ws)
+	if err != nil {
+		return "", err
+	}
+	if ws.NetworkConnectivityConfigId == "" {
+		return "", fmt.Errorf("workspace %d has no network connectivity config attached", workspaceId)
+	}
+	return ws.NetworkConnectivityConfigId, nil
+}
+
+// DataSourceMwsServerlessEgress returns stable egress IPs and subnets used by serverless compute,
+// so that firewall rules of cloud resources can allow traffic from it
+func DataSourceMwsServerlessEgress() common.Resource {
+	s := common.StructToSchema(serverlessEgressData{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, field := range []string{"workspace_id", "network_connectivity_config_id"} {
+			common.CustomizeSchemaPath(m, field).SetOptional().
+				SetExactlyOneOf([]string{"workspace_id", "network_connectivity_config_id"})
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if c.Config.AccountID == "" {
+				return fmt.Errorf("provider block is missing `account_id` property")
+			}
+			var data serverlessEgressData
+			common.DataToStructPointer(d, s, &data)
+			if data.NetworkConnectivityConfigId == "" {
+				nccId, err := workspaceNetworkConnectivityConfigId(ctx, c, data.WorkspaceId)
+				if err != nil {
+					return err
+				}
+				data.NetworkConnectivityConfigId = nccId
+			}
+			acc, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			ncc, err := acc.NetworkConnectivity.GetNetworkConnectivityConfigurationByNetworkConnectivityConfigId(ctx,
+				data.NetworkConnectivityConfigId)
+			if err != nil {
+				return err
+			}
+			data.Region = ncc.Region
+			if ncc.EgressConfig != nil && ncc.EgressConfig.DefaultRules != nil {
+				rules := ncc.EgressConfig.DefaultRules
+				if rules.AwsStableIpRule != nil {
+					data.CidrBlocks = append([]string{}, rules.AwsStableIpRule.CidrBlocks...)
+				}
+				if rules.AzureServiceEndpointRule != nil {
+					data.Subnets = append([]string{}, rules.AzureServiceEndpointRule.Subnets...)
+					data.TargetRegion = rules.AzureServiceEndpointRule.TargetRegion
+					data.TargetServices = rules.AzureServiceEndpointRule.TargetServices
+				}
+			}
+			// keep the output stable, so that dependent firewall rules don't change on every plan
+			sort.Strings(data.CidrBlocks)
+			sort.Strings(data.Subnets)
+			if data.WorkspaceId != 0 {
+				d.SetId(strconv.FormatInt(data.WorkspaceId, 10))
+			} else {
+				d.SetId(data.NetworkConnectivityConfigId)
+			}
+			return common.StructToData(data, s, d)
+		},
+	}
+}
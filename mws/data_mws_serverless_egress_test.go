@@ -0,0 +1,116 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsServerlessEgress_ByNcc(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-connectivity-configs/ncc?",
+				Response: settings.NetworkConnectivityConfiguration{
+					NetworkConnectivityConfigId: "ncc",
+					Region:                      "us-east-1",
+					EgressConfig: &settings.NccEgressConfig{
+						DefaultRules: &settings.NccEgressDefaultRules{
+							AwsStableIpRule: &settings.NccAwsStableIpRule{
+								CidrBlocks: []string{"10.0.0.2/32", "10.0.0.1/32"},
+							},
+						},
+					},
+				},
+			},
+		},
+		AccountID:   "abc",
+		Resource:    DataSourceMwsServerlessEgress(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `network_connectivity_config_id = "ncc"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "ncc",
+		"region":      "us-east-1",
+		"cidr_blocks": []any{"10.0.0.1/32", "10.0.0.2/32"},
+	})
+}
+
+func TestDataSourceMwsServerlessEgress_ByWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123",
+				Response: map[string]any{
+					"workspace_id":                   123,
+					"network_connectivity_config_id": "ncc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-connectivity-configs/ncc?",
+				Response: settings.NetworkConnectivityConfiguration{
+					NetworkConnectivityConfigId: "ncc",
+					Region:                      "westeurope",
+					EgressConfig: &settings.NccEgressConfig{
+						DefaultRules: &settings.NccEgressDefaultRules{
+							AzureServiceEndpointRule: &settings.NccAzureServiceEndpointRule{
+								Subnets:        []string{"/subscriptions/a/subnets/b"},
+								TargetRegion:   "westeurope",
+								TargetServices: []string{"DATABRICKS_STORAGE"},
+							},
+						},
+					},
+				},
+			},
+		},
+		AccountID:   "abc",
+		Resource:    DataSourceMwsServerlessEgress(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `workspace_id = 123`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                             "123",
+		"network_connectivity_config_id": "ncc",
+		"subnets":                        []any{"/subscriptions/a/subnets/b"},
+		"target_region":                  "westeurope",
+		"target_services":                []any{"DATABRICKS_STORAGE"},
+	})
+}
+
+func TestDataSourceMwsServerlessEgress_NoNcc(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123",
+				Response: map[string]any{
+					"workspace_id": 123,
+				},
+			},
+		},
+		AccountID:   "abc",
+		Resource:    DataSourceMwsServerlessEgress(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `workspace_id = 123`,
+	}.ExpectError(t, "workspace 123 has no network connectivity config attached")
+}
+
+func TestDataSourceMwsServerlessEgress_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsServerlessEgress(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `network_connectivity_config_id = "ncc"`,
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -3,11 +3,15 @@ package mws
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // AwsKeyInfo has information about the KMS key for BYOK
@@ -22,6 +26,17 @@ type GcpKeyInfo struct {
 	KmsKeyId string `json:"kms_key_id"`
 }
 
+var gcpKmsKeyIdRegex = regexp.MustCompile(`^projects/[^/]+/locations/([^/]+)/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
+// gcpKeyLocation returns the location of the Cloud KMS key, e.g. us-central1
+func gcpKeyLocation(kmsKeyId string) string {
+	match := gcpKmsKeyIdRegex.FindStringSubmatch(kmsKeyId)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
 // CustomerManagedKey contains key information and metadata for BYOK for E2
 // You must specify either AwsKeyInfo for AWS or GcpKeyInfo for GCP, but not both
 type CustomerManagedKey struct {
@@ -33,6 +48,44 @@ type CustomerManagedKey struct {
 	UseCases             []string    `json:"use_cases"`
 }
 
+// validateGcpCustomerManagedKeys checks that keys of the GCP workspace are registered for the right use case
+// and are located in the region of the workspace, so that misconfiguration is reported before the workspace
+// is provisioned. IAM bindings of the key ring can't be read through Databricks APIs.
+func validateGcpCustomerManagedKeys(ctx context.Context, c *common.DatabricksClient, ws Workspace) error {
+	keys := []struct {
+		id      string
+		useCase string
+	}{
+		{ws.ManagedServicesCustomerManagedKeyID, "MANAGED_SERVICES"},
+		{ws.StorageCustomerManagedKeyID, "STORAGE"},
+	}
+	api := NewCustomerManagedKeysAPI(ctx, c)
+	for _, key := range keys {
+		if key.id == "" {
+			continue
+		}
+		cmk, err := api.Read(ws.AccountID, key.id)
+		if err != nil {
+			return fmt.Errorf("cannot read customer managed key %s: %w", key.id, err)
+		}
+		if !slices.Contains(cmk.UseCases, key.useCase) {
+			return fmt.Errorf("customer managed key %s isn't registered for %s use case, but only for %s",
+				key.id, key.useCase, strings.Join(cmk.UseCases, ", "))
+		}
+		if cmk.GcpKeyInfo == nil {
+			return fmt.Errorf("customer managed key %s has no gcp_key_info", key.id)
+		}
+		location := gcpKeyLocation(cmk.GcpKeyInfo.KmsKeyId)
+		// multi-region locations, like us or europe, cover all regions of the continent
+		if location == "" || location == ws.Location || strings.HasPrefix(ws.Location, location+"-") {
+			continue
+		}
+		return fmt.Errorf("KMS key %s of customer managed key %s is in %s, but the workspace is in %s",
+			cmk.GcpKeyInfo.KmsKeyId, key.id, location, ws.Location)
+	}
+	return nil
+}
+
 // NewCustomerManagedKeysAPI creates CustomerManagedKeysAPI instance from provider meta
 func NewCustomerManagedKeysAPI(ctx context.Context, m any) CustomerManagedKeysAPI {
 	return CustomerManagedKeysAPI{m.(*common.DatabricksClient), ctx}
@@ -72,7 +125,11 @@ func (a CustomerManagedKeysAPI) List(accountID string) (kl []CustomerManagedKey,
 }
 
 func ResourceMwsCustomerManagedKeys() common.Resource {
-	s := common.StructToSchema(CustomerManagedKey{}, nil)
+	s := common.StructToSchema(CustomerManagedKey{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.MustSchemaPath(m, "gcp_key_info", "kms_key_id").ValidateFunc = validation.StringMatch(gcpKmsKeyIdRegex,
+			"must be a Cloud KMS key in form of projects/<project>/locations/<location>/keyRings/<key_ring>/cryptoKeys/<key>")
+		return m
+	})
 	p := common.NewPairSeparatedID("account_id", "customer_managed_key_id", "/")
 	return common.Resource{
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
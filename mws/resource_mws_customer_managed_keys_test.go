@@ -263,3 +263,23 @@ func TestAwsKeyInfoKeyAliasOptional(t *testing.T) {
 	assert.Equal(t, "abc/cmkid", d.Id())
 	assert.Equal(t, "key-arn", d.Get("aws_key_info.0.key_arn"))
 }
+
+func TestGcpKeyInfoInvalidKmsKeyId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMwsCustomerManagedKeys(),
+		HCL: `
+			account_id = "abc"
+			gcp_key_info {
+				kms_key_id = "my-key"
+			}
+			use_cases = ["STORAGE"]
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [gcp_key_info.#.kms_key_id] invalid value for gcp_key_info.0.kms_key_id "+
+		"(must be a Cloud KMS key in form of projects/<project>/locations/<location>/keyRings/<key_ring>/cryptoKeys/<key>)")
+}
+
+func TestGcpKeyLocation(t *testing.T) {
+	assert.Equal(t, "us-central1", gcpKeyLocation("projects/p/locations/us-central1/keyRings/r/cryptoKeys/k"))
+	assert.Equal(t, "", gcpKeyLocation("my-key"))
+}
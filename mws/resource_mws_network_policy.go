@@ -0,0 +1,144 @@
+package mws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type NetworkPolicyInternetDestination struct {
+	Destination             string `json:"destination"`
+	InternetDestinationType string `json:"internet_destination_type,omitempty" tf:"default:DNS_NAME"`
+}
+
+type NetworkPolicyStorageDestination struct {
+	StorageDestinationType string `json:"storage_destination_type"`
+	BucketName             string `json:"bucket_name,omitempty"`
+	Region                 string `json:"region,omitempty"`
+	AzureStorageAccount    string `json:"azure_storage_account,omitempty"`
+	AzureStorageService    string `json:"azure_storage_service,omitempty"`
+}
+
+type NetworkPolicyEnforcement struct {
+	EnforcementMode         string   `json:"enforcement_mode,omitempty" tf:"default:ENFORCED"`
+	DryRunModeProductFilter []string `json:"dry_run_mode_product_filter,omitempty"`
+}
+
+type NetworkPolicyNetworkAccess struct {
+	RestrictionMode             string                             `json:"restriction_mode"`
+	AllowedInternetDestinations []NetworkPolicyInternetDestination `json:"allowed_internet_destinations,omitempty" tf:"alias:allowed_internet_destination"`
+	AllowedStorageDestinations  []NetworkPolicyStorageDestination  `json:"allowed_storage_destinations,omitempty" tf:"alias:allowed_storage_destination"`
+	PolicyEnforcement           *NetworkPolicyEnforcement          `json:"policy_enforcement,omitempty"`
+}
+
+type NetworkPolicyEgress struct {
+	NetworkAccess NetworkPolicyNetworkAccess `json:"network_access"`
+}
+
+// NetworkPolicy governs egress of serverless compute in the workspaces it's assigned to
+type NetworkPolicy struct {
+	AccountID       string              `json:"account_id,omitempty" tf:"computed"`
+	NetworkPolicyID string              `json:"network_policy_id" tf:"force_new"`
+	Egress          NetworkPolicyEgress `json:"egress"`
+}
+
+// NewNetworkPoliciesAPI creates NetworkPoliciesAPI instance from provider meta
+func NewNetworkPoliciesAPI(ctx context.Context, m any) NetworkPoliciesAPI {
+	return NetworkPoliciesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// NetworkPoliciesAPI exposes the account network policies API
+type NetworkPoliciesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func (a NetworkPoliciesAPI) accountID() (string, error) {
+	if a.client.Config.AccountID == "" {
+		return "", fmt.Errorf("account_id must be set in the provider configuration to manage network policies")
+	}
+	return a.client.Config.AccountID, nil
+}
+
+// Create creates a network policy
+func (a NetworkPoliciesAPI) Create(policy NetworkPolicy) (np NetworkPolicy, err error) {
+	accountID, err := a.accountID()
+	if err != nil {
+		return
+	}
+	err = a.client.Post(a.context, fmt.Sprintf("/accounts/%s/network-policies", accountID), policy, &np)
+	return
+}
+
+// Read returns a network policy by its id
+func (a NetworkPoliciesAPI) Read(networkPolicyID string) (np NetworkPolicy, err error) {
+	accountID, err := a.accountID()
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, fmt.Sprintf("/accounts/%s/network-policies/%s", accountID, networkPolicyID), nil, &np)
+	return
+}
+
+// Update replaces the definition of a network policy
+func (a NetworkPoliciesAPI) Update(policy NetworkPolicy) error {
+	accountID, err := a.accountID()
+	if err != nil {
+		return err
+	}
+	return a.client.Put(a.context, fmt.Sprintf("/accounts/%s/network-policies/%s", accountID, policy.NetworkPolicyID), policy)
+}
+
+// Delete deletes a network policy
+func (a NetworkPoliciesAPI) Delete(networkPolicyID string) error {
+	accountID, err := a.accountID()
+	if err != nil {
+		return err
+	}
+	return a.client.Delete(a.context, fmt.Sprintf("/accounts/%s/network-policies/%s", accountID, networkPolicyID), nil)
+}
+
+func ResourceMwsNetworkPolicy() common.Resource {
+	s := common.StructToSchema(NetworkPolicy{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "egress", "network_access", "restriction_mode").SetValidateFunc(
+			validation.StringInSlice([]string{"FULL_ACCESS", "RESTRICTED_ACCESS"}, false))
+		common.CustomizeSchemaPath(m, "egress", "network_access", "policy_enforcement", "enforcement_mode").SetValidateFunc(
+			validation.StringInSlice([]string{"ENFORCED", "DRY_RUN"}, false))
+		common.CustomizeSchemaPath(m, "egress", "network_access", "allowed_storage_destination", "storage_destination_type").SetValidateFunc(
+			validation.StringInSlice([]string{"AWS_S3", "AZURE_STORAGE", "GOOGLE_CLOUD_STORAGE"}, false))
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy NetworkPolicy
+			common.DataToStructPointer(d, s, &policy)
+			np, err := NewNetworkPoliciesAPI(ctx, c).Create(policy)
+			if err != nil {
+				return err
+			}
+			d.SetId(np.NetworkPolicyID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			np, err := NewNetworkPoliciesAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(np, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var policy NetworkPolicy
+			common.DataToStructPointer(d, s, &policy)
+			policy.NetworkPolicyID = d.Id()
+			return NewNetworkPoliciesAPI(ctx, c).Update(policy)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNetworkPoliciesAPI(ctx, c).Delete(d.Id())
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package mws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Policy, that is assigned to workspaces without an explicit network policy
+const defaultNetworkPolicyID = "default-policy"
+
+type workspaceNetworkOption struct {
+	WorkspaceId     int64  `json:"workspace_id" tf:"force_new"`
+	NetworkPolicyID string `json:"network_policy_id"`
+}
+
+func workspaceNetworkOptionPath(accountID string, workspaceId int64) string {
+	return fmt.Sprintf("/accounts/%s/workspaces/%d/network", accountID, workspaceId)
+}
+
+func (a NetworkPoliciesAPI) getWorkspaceNetworkOption(workspaceId int64) (option workspaceNetworkOption, err error) {
+	accountID, err := a.accountID()
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, workspaceNetworkOptionPath(accountID, workspaceId), nil, &option)
+	return
+}
+
+func (a NetworkPoliciesAPI) setWorkspaceNetworkOption(option workspaceNetworkOption) error {
+	accountID, err := a.accountID()
+	if err != nil {
+		return err
+	}
+	return a.client.Put(a.context, workspaceNetworkOptionPath(accountID, option.WorkspaceId), option)
+}
+
+func parseWorkspaceId(d *schema.ResourceData) (int64, error) {
+	workspaceId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid workspace id: %w", err)
+	}
+	return workspaceId, nil
+}
+
+// ResourceMwsNetworkPolicyBinding assigns a network policy to a workspace
+func ResourceMwsNetworkPolicyBinding() common.Resource {
+	s := common.StructToSchema(workspaceNetworkOption{}, common.NoCustomize)
+	createOrUpdate := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var option workspaceNetworkOption
+		common.DataToStructPointer(d, s, &option)
+		err := NewNetworkPoliciesAPI(ctx, c).setWorkspaceNetworkOption(option)
+		if err != nil {
+			return err
+		}
+		d.SetId(strconv.FormatInt(option.WorkspaceId, 10))
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: createOrUpdate,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			workspaceId, err := parseWorkspaceId(d)
+			if err != nil {
+				return err
+			}
+			option, err := NewNetworkPoliciesAPI(ctx, c).getWorkspaceNetworkOption(workspaceId)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(option, s, d)
+		},
+		Update: createOrUpdate,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			workspaceId, err := parseWorkspaceId(d)
+			if err != nil {
+				return err
+			}
+			// workspaces always have a network policy, so the binding is reverted to the default one
+			return NewNetworkPoliciesAPI(ctx, c).setWorkspaceNetworkOption(workspaceNetworkOption{
+				WorkspaceId:     workspaceId,
+				NetworkPolicyID: defaultNetworkPolicyID,
+			})
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var testNetworkPolicy = NetworkPolicy{
+	AccountID:       "abc",
+	NetworkPolicyID: "restricted",
+	Egress: NetworkPolicyEgress{
+		NetworkAccess: NetworkPolicyNetworkAccess{
+			RestrictionMode: "RESTRICTED_ACCESS",
+			AllowedInternetDestinations: []NetworkPolicyInternetDestination{
+				{
+					Destination:             "pypi.org",
+					InternetDestinationType: "DNS_NAME",
+				},
+			},
+			AllowedStorageDestinations: []NetworkPolicyStorageDestination{
+				{
+					StorageDestinationType: "AWS_S3",
+					BucketName:             "landing",
+					Region:                 "us-east-1",
+				},
+			},
+			PolicyEnforcement: &NetworkPolicyEnforcement{
+				EnforcementMode: "ENFORCED",
+			},
+		},
+	},
+}
+
+const testNetworkPolicyHCL = `
+network_policy_id = "restricted"
+egress {
+	network_access {
+		restriction_mode = "RESTRICTED_ACCESS"
+		allowed_internet_destination {
+			destination = "pypi.org"
+		}
+		allowed_storage_destination {
+			storage_destination_type = "AWS_S3"
+			bucket_name              = "landing"
+			region                   = "us-east-1"
+		}
+		policy_enforcement {
+			enforcement_mode = "ENFORCED"
+		}
+	}
+}
+`
+
+func TestResourceNetworkPolicyCreate(t *testing.T) {
+	request := testNetworkPolicy
+	request.AccountID = ""
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/accounts/abc/network-policies",
+				ExpectedRequest: request,
+				Response:        testNetworkPolicy,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+				Response: testNetworkPolicy,
+			},
+		},
+		Resource:  ResourceMwsNetworkPolicy(),
+		AccountID: "abc",
+		HCL:       testNetworkPolicyHCL,
+		Create:    true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":         "restricted",
+		"account_id": "abc",
+		"egress.0.network_access.0.allowed_internet_destination.0.internet_destination_type": "DNS_NAME",
+	})
+}
+
+func TestResourceNetworkPolicyCreate_NoAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMwsNetworkPolicy(),
+		HCL:      testNetworkPolicyHCL,
+		Create:   true,
+	}.ExpectError(t, "account_id must be set in the provider configuration to manage network policies")
+}
+
+func TestResourceNetworkPolicyUpdate(t *testing.T) {
+	policy := testNetworkPolicy
+	policy.Egress.NetworkAccess.RestrictionMode = "FULL_ACCESS"
+	policy.Egress.NetworkAccess.AllowedInternetDestinations = nil
+	policy.Egress.NetworkAccess.AllowedStorageDestinations = nil
+	policy.Egress.NetworkAccess.PolicyEnforcement = nil
+	request := policy
+	request.AccountID = ""
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/accounts/abc/network-policies/restricted",
+				ExpectedRequest: request,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+				Response: policy,
+			},
+		},
+		Resource:  ResourceMwsNetworkPolicy(),
+		AccountID: "abc",
+		ID:        "restricted",
+		InstanceState: map[string]string{
+			"network_policy_id":         "restricted",
+			"egress.#":                  "1",
+			"egress.0.network_access.#": "1",
+			"egress.0.network_access.0.restriction_mode": "RESTRICTED_ACCESS",
+		},
+		HCL: `
+		network_policy_id = "restricted"
+		egress {
+			network_access {
+				restriction_mode = "FULL_ACCESS"
+			}
+		}
+		`,
+		Update: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"egress.0.network_access.0.restriction_mode": "FULL_ACCESS",
+	})
+}
+
+func TestResourceNetworkPolicyDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/accounts/abc/network-policies/restricted",
+			},
+		},
+		Resource:  ResourceMwsNetworkPolicy(),
+		AccountID: "abc",
+		ID:        "restricted",
+		Delete:    true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceNetworkPolicyBindingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				ExpectedRequest: workspaceNetworkOption{
+					WorkspaceId:     123,
+					NetworkPolicyID: "restricted",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				Response: workspaceNetworkOption{
+					WorkspaceId:     123,
+					NetworkPolicyID: "restricted",
+				},
+			},
+		},
+		Resource:  ResourceMwsNetworkPolicyBinding(),
+		AccountID: "abc",
+		HCL: `
+		workspace_id      = 123
+		network_policy_id = "restricted"
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "123",
+		"network_policy_id": "restricted",
+	})
+}
+
+func TestResourceNetworkPolicyBindingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/accounts/abc/workspaces/123/network",
+				ExpectedRequest: workspaceNetworkOption{
+					WorkspaceId:     123,
+					NetworkPolicyID: "default-policy",
+				},
+			},
+		},
+		Resource:  ResourceMwsNetworkPolicyBinding(),
+		AccountID: "abc",
+		ID:        "123",
+		InstanceState: map[string]string{
+			"workspace_id":      "123",
+			"network_policy_id": "restricted",
+		},
+		Delete: true,
+	}.ApplyNoError(t)
+}
@@ -146,6 +146,39 @@ func (w *Workspace) MarshalJSON() ([]byte, error) {
 // Create deploys the workspace and waits till it's properly running.
 // In case of error, it removes the failed deployment and returns the message
 func (a WorkspacesAPI) Create(ws *Workspace, timeout time.Duration) error {
+	return a.create(ws, timeout, false)
+}
+
+// CreateOrAdopt retries provisioning of a previously failed workspace with the same name, or deploys
+// a new workspace, if there's none. Workspaces that fail to provision are kept for the next attempt.
+func (a WorkspacesAPI) CreateOrAdopt(ws *Workspace, timeout time.Duration) error {
+	existing, err := a.findUnfinishedWorkspace(ws.AccountID, ws.WorkspaceName)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return a.create(ws, timeout, true)
+	}
+	ws.WorkspaceID = existing.WorkspaceID
+	ws.DeploymentName = existing.DeploymentName
+	ws.WorkspaceURL = existing.WorkspaceURL
+	if existing.WorkspaceStatus == WorkspaceStatusFailed {
+		log.Printf("[INFO] Retrying provisioning of failed workspace %s (%d)", ws.WorkspaceName, ws.WorkspaceID)
+		err = a.client.Patch(a.context, fmt.Sprintf("/accounts/%s/workspaces/%d", ws.AccountID, ws.WorkspaceID),
+			failedWorkspaceUpdateRequest(*ws))
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Printf("[INFO] Adopting workspace %s (%d) in %s status", ws.WorkspaceName, ws.WorkspaceID, existing.WorkspaceStatus)
+	}
+	if err = a.WaitForRunning(*ws, timeout); err != nil {
+		return keptFailedWorkspaceError(*ws, err)
+	}
+	return nil
+}
+
+func (a WorkspacesAPI) create(ws *Workspace, timeout time.Duration, keepFailed bool) error {
 	if a.client.IsGcp() {
 		ws.Cloud = "gcp"
 	}
@@ -155,6 +188,9 @@ func (a WorkspacesAPI) Create(ws *Workspace, timeout time.Duration) error {
 		return err
 	}
 	if err = a.WaitForRunning(*ws, timeout); err != nil {
+		if keepFailed {
+			return keptFailedWorkspaceError(*ws, err)
+		}
 		log.Printf("[ERROR] Deleting failed workspace: %s", err)
 		if derr := a.Delete(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID)); derr != nil {
 			return fmt.Errorf("%s - %s", err, derr)
@@ -169,6 +205,49 @@ func (a WorkspacesAPI) Create(ws *Workspace, timeout time.Duration) error {
 	return nil
 }
 
+func keptFailedWorkspaceError(ws Workspace, err error) error {
+	return fmt.Errorf("%w. Workspace %d is kept, fix the configuration and apply again to retry provisioning",
+		err, ws.WorkspaceID)
+}
+
+// findUnfinishedWorkspace returns a workspace with the given name, that failed or didn't finish provisioning
+func (a WorkspacesAPI) findUnfinishedWorkspace(accountID, workspaceName string) (*Workspace, error) {
+	workspaces, err := a.List(accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ws := range workspaces {
+		if ws.WorkspaceName != workspaceName {
+			continue
+		}
+		switch ws.WorkspaceStatus {
+		case WorkspaceStatusFailed, WorkspaceStatusProvisioning, WorkspaceStatusNotProvisioned:
+			return &ws, nil
+		}
+	}
+	return nil, nil
+}
+
+// failedWorkspaceUpdateRequest contains the fields, that could be changed on a workspace that failed to provision
+func failedWorkspaceUpdateRequest(ws Workspace) map[string]any {
+	request := map[string]any{}
+	fields := map[string]string{
+		"aws_region":               ws.AwsRegion,
+		"credentials_id":           ws.CredentialsID,
+		"storage_configuration_id": ws.StorageConfigurationID,
+		"network_id":               ws.NetworkID,
+		"managed_services_customer_managed_key_id": ws.ManagedServicesCustomerManagedKeyID,
+		"storage_customer_managed_key_id":          ws.StorageCustomerManagedKeyID,
+		"private_access_settings_id":               ws.PrivateAccessSettingsID,
+	}
+	for k, v := range fields {
+		if v != "" {
+			request[k] = v
+		}
+	}
+	return request
+}
+
 // generateWorkspaceHostname computes the hostname for the specified workspace,
 // given the account console hostname.
 func generateWorkspaceHostname(client *common.DatabricksClient, ws Workspace) string {
@@ -215,9 +294,55 @@ func (a WorkspacesAPI) verifyWorkspaceReachable(ws Workspace) *resource.RetryErr
 	return nil
 }
 
+// workspaceProvisioningPhases describe configuration objects, that are validated while the workspace is provisioned.
+// The phase is detected from keywords in the workspace status message.
+var workspaceProvisioningPhases = []struct {
+	phase    string
+	keywords []string
+	field    func(ws Workspace) (string, string)
+}{
+	{"credentials validation", []string{"credential", "assumerole", "cross-account", "iam role", "not authorized"},
+		func(ws Workspace) (string, string) { return "credentials_id", ws.CredentialsID }},
+	{"storage validation", []string{"storage configuration", "bucket", "root storage"},
+		func(ws Workspace) (string, string) { return "storage_configuration_id", ws.StorageConfigurationID }},
+	{"encryption key validation", []string{"kms", "customer managed key", "encryption key"},
+		func(ws Workspace) (string, string) {
+			if ws.StorageCustomerManagedKeyID != "" {
+				return "storage_customer_managed_key_id", ws.StorageCustomerManagedKeyID
+			}
+			return "managed_services_customer_managed_key_id", ws.ManagedServicesCustomerManagedKeyID
+		}},
+	{"private access validation", []string{"private access", "privatelink", "private link"},
+		func(ws Workspace) (string, string) { return "private_access_settings_id", ws.PrivateAccessSettingsID }},
+	{"network validation", []string{"network", "vpc", "subnet", "security group"},
+		func(ws Workspace) (string, string) { return "network_id", ws.NetworkID }},
+}
+
+// diagnoseWorkspaceFailure returns the provisioning phase, that most likely failed, with the configuration object to check
+func diagnoseWorkspaceFailure(ws Workspace) string {
+	message := strings.ToLower(ws.WorkspaceStatusMessage)
+	for _, p := range workspaceProvisioningPhases {
+		for _, keyword := range p.keywords {
+			if !strings.Contains(message, keyword) {
+				continue
+			}
+			field, value := p.field(ws)
+			if value == "" {
+				return fmt.Sprintf("failed phase: %s", p.phase)
+			}
+			return fmt.Sprintf("failed phase: %s, check %s %s", p.phase, field, value)
+		}
+	}
+	return ""
+}
+
 func (a WorkspacesAPI) explainWorkspaceFailure(ws Workspace) error {
+	diagnostics := ""
+	if phase := diagnoseWorkspaceFailure(ws); phase != "" {
+		diagnostics = "; " + phase
+	}
 	if ws.NetworkID == "" {
-		return fmt.Errorf(ws.WorkspaceStatusMessage)
+		return fmt.Errorf("%s%s", ws.WorkspaceStatusMessage, diagnostics)
 	}
 	network, nerr := NewNetworksAPI(a.context, a.client).Read(ws.AccountID, ws.NetworkID)
 	if nerr != nil {
@@ -228,16 +353,16 @@ func (a WorkspacesAPI) explainWorkspaceFailure(ws Workspace) error {
 		strBuffer.WriteString(fmt.Sprintf("error: %s;error_msg: %s;",
 			networkHealth.ErrorType, networkHealth.ErrorMessage))
 	}
-	return fmt.Errorf("Workspace failed to create: %v, network error message: %v",
-		ws.WorkspaceStatusMessage, strBuffer.String())
+	return fmt.Errorf("Workspace failed to create: %v, network error message: %v%s",
+		ws.WorkspaceStatusMessage, strBuffer.String(), diagnostics)
 }
 
 // WaitForRunning will wait until workspace is running, otherwise will try to explain why it failed
 func (a WorkspacesAPI) WaitForRunning(ws Workspace, timeout time.Duration) error {
-	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+	return common.WaitForProgress(a.context, timeout, func(ctx context.Context) (common.Progress, error) {
 		workspace, err := a.Read(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID))
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return common.Progress{}, err
 		}
 		switch workspace.WorkspaceStatus {
 		case WorkspaceStatusRunning:
@@ -245,18 +370,22 @@ func (a WorkspacesAPI) WaitForRunning(ws Workspace, timeout time.Duration) error
 			if strings.Contains(ws.DeploymentName, "900150983cd24fb0") {
 				// nobody would probably name workspace as 900150983cd24fb0,
 				// so we'll use it as unit testing shim
-				return nil
+				return common.Completed(), nil
+			}
+			rerr := a.verifyWorkspaceReachable(workspace)
+			if rerr == nil {
+				return common.Completed(), nil
+			}
+			if !rerr.Retryable {
+				return common.Progress{}, rerr.Err
 			}
-			return a.verifyWorkspaceReachable(workspace)
+			return common.InProgress("%s", rerr.Err), nil
 		case WorkspaceStatusCanceled, WorkspaceStatusFailed:
 			log.Printf("[ERROR] Cannot start workspace: %s", workspace.WorkspaceStatusMessage)
-			err = a.explainWorkspaceFailure(workspace)
-			return resource.NonRetryableError(err)
-		default:
-			log.Printf("[INFO] Workspace %s is %s: %s", workspace.DeploymentName,
-				workspace.WorkspaceStatus, workspace.WorkspaceStatusMessage)
-			return resource.RetryableError(fmt.Errorf(workspace.WorkspaceStatusMessage))
+			return common.Progress{}, a.explainWorkspaceFailure(workspace)
 		}
+		return common.InProgress("workspace %d is %s: %s", ws.WorkspaceID,
+			workspace.WorkspaceStatus, workspace.WorkspaceStatusMessage), nil
 	})
 }
 
@@ -537,6 +666,12 @@ func ResourceMwsWorkspaces() common.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			}
+			// keep workspaces that fail to provision and retry provisioning on the next apply
+			s["adopt_failed_workspace"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
 			return s
 		})
 	p := common.NewPairSeparatedID("account_id", "workspace_id", "/").Schema(
@@ -582,7 +717,16 @@ func ResourceMwsWorkspaces() common.Resource {
 				workspace.ManagedServicesCustomerManagedKeyID = workspace.CustomerManagedKeyID
 				workspace.CustomerManagedKeyID = ""
 			}
-			if err := workspacesAPI.Create(&workspace, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if c.IsGcp() {
+				if err := validateGcpCustomerManagedKeys(ctx, c, workspace); err != nil {
+					return err
+				}
+			}
+			create := workspacesAPI.Create
+			if d.Get("adopt_failed_workspace").(bool) {
+				create = workspacesAPI.CreateOrAdopt
+			}
+			if err := create(&workspace, d.Timeout(schema.TimeoutCreate)); err != nil {
 				return err
 			}
 			d.Set("workspace_id", workspace.WorkspaceID)
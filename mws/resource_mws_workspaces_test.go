@@ -327,54 +327,52 @@ func TestResourceWorkspaceCreateGcpPsc(t *testing.T) {
 
 func TestResourceWorkspaceCreateGcpCmk(t *testing.T) {
 	qa.ResourceFixture{
-		Fixtures: []qa.HTTPFixture{
-			{
-				Method:   "POST",
-				Resource: "/api/2.0/accounts/abc/workspaces",
-				ExpectedRequest: map[string]any{
-					"account_id": "abc",
-					"cloud":      "gcp",
-					"cloud_resource_container": map[string]any{
-						"gcp": map[string]any{
-							"project_id": "def",
-						},
-					},
-					"location":                   "bcd",
-					"private_access_settings_id": "pas_id_a",
-					"network_id":                 "net_id_a",
-					"gke_config": map[string]any{
-						"master_ip_range":   "e",
-						"connectivity_type": "PRIVATE_NODE_PUBLIC_MASTER",
-					},
-					"gcp_managed_network_config": map[string]any{
-						"gke_cluster_pod_ip_range":     "b",
-						"gke_cluster_service_ip_range": "c",
-						"subnet_cidr":                  "a",
+		Fixtures: append(gcpCmkFixtures("projects/p/locations/bcd/keyRings/r/cryptoKeys/k"), qa.HTTPFixture{
+			Method:   "POST",
+			Resource: "/api/2.0/accounts/abc/workspaces",
+			ExpectedRequest: map[string]any{
+				"account_id": "abc",
+				"cloud":      "gcp",
+				"cloud_resource_container": map[string]any{
+					"gcp": map[string]any{
+						"project_id": "def",
 					},
-					"workspace_name": "labdata",
-					"managed_services_customer_managed_key_id": "managed_services_cmk",
-					"storage_customer_managed_key_id":          "storage_cmk",
 				},
-				Response: Workspace{
-					WorkspaceID:    1234,
-					AccountID:      "abc",
-					DeploymentName: "900150983cd24fb0",
-					WorkspaceName:  "labdata",
+				"location":                   "bcd",
+				"private_access_settings_id": "pas_id_a",
+				"network_id":                 "net_id_a",
+				"gke_config": map[string]any{
+					"master_ip_range":   "e",
+					"connectivity_type": "PRIVATE_NODE_PUBLIC_MASTER",
 				},
-			},
-			{
-				Method:       "GET",
-				ReuseRequest: true,
-				Resource:     "/api/2.0/accounts/abc/workspaces/1234",
-				Response: Workspace{
-					AccountID:       "abc",
-					WorkspaceID:     1234,
-					WorkspaceStatus: WorkspaceStatusRunning,
-					DeploymentName:  "900150983cd24fb0",
-					WorkspaceName:   "labdata",
+				"gcp_managed_network_config": map[string]any{
+					"gke_cluster_pod_ip_range":     "b",
+					"gke_cluster_service_ip_range": "c",
+					"subnet_cidr":                  "a",
 				},
+				"workspace_name": "labdata",
+				"managed_services_customer_managed_key_id": "managed_services_cmk",
+				"storage_customer_managed_key_id":          "storage_cmk",
+			},
+			Response: Workspace{
+				WorkspaceID:    1234,
+				AccountID:      "abc",
+				DeploymentName: "900150983cd24fb0",
+				WorkspaceName:  "labdata",
+			},
+		}, qa.HTTPFixture{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/accounts/abc/workspaces/1234",
+			Response: Workspace{
+				AccountID:       "abc",
+				WorkspaceID:     1234,
+				WorkspaceStatus: WorkspaceStatusRunning,
+				DeploymentName:  "900150983cd24fb0",
+				WorkspaceName:   "labdata",
 			},
 		},
+		),
 		Resource: ResourceMwsWorkspaces(),
 		HCL: `
 		account_id      = "abc"
@@ -405,6 +403,80 @@ func TestResourceWorkspaceCreateGcpCmk(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func gcpCmkFixtures(kmsKeyId string) []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/customer-managed-keys/managed_services_cmk",
+			Response: CustomerManagedKey{
+				CustomerManagedKeyID: "managed_services_cmk",
+				GcpKeyInfo:           &GcpKeyInfo{KmsKeyId: kmsKeyId},
+				UseCases:             []string{"MANAGED_SERVICES"},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/customer-managed-keys/storage_cmk",
+			Response: CustomerManagedKey{
+				CustomerManagedKeyID: "storage_cmk",
+				GcpKeyInfo:           &GcpKeyInfo{KmsKeyId: kmsKeyId},
+				UseCases:             []string{"STORAGE"},
+			},
+		},
+	}
+}
+
+func TestResourceWorkspaceCreateGcpCmk_WrongLocation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: gcpCmkFixtures("projects/p/locations/europe-west1/keyRings/r/cryptoKeys/k")[:1],
+		Resource: ResourceMwsWorkspaces(),
+		HCL: `
+		account_id      = "abc"
+		workspace_name  = "labdata"
+		location        = "us-central1"
+		cloud_resource_container {
+			gcp {
+				project_id = "def"
+			}
+		}
+		managed_services_customer_managed_key_id = "managed_services_cmk"
+		`,
+		Gcp:    true,
+		Create: true,
+	}.ExpectError(t, "KMS key projects/p/locations/europe-west1/keyRings/r/cryptoKeys/k of customer managed key "+
+		"managed_services_cmk is in europe-west1, but the workspace is in us-central1")
+}
+
+func TestResourceWorkspaceCreateGcpCmk_WrongUseCase(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/customer-managed-keys/managed_services_cmk",
+				Response: CustomerManagedKey{
+					CustomerManagedKeyID: "managed_services_cmk",
+					GcpKeyInfo:           &GcpKeyInfo{KmsKeyId: "projects/p/locations/us/keyRings/r/cryptoKeys/k"},
+					UseCases:             []string{"MANAGED_SERVICES"},
+				},
+			},
+		},
+		Resource: ResourceMwsWorkspaces(),
+		HCL: `
+		account_id      = "abc"
+		workspace_name  = "labdata"
+		location        = "us-central1"
+		cloud_resource_container {
+			gcp {
+				project_id = "def"
+			}
+		}
+		storage_customer_managed_key_id = "managed_services_cmk"
+		`,
+		Gcp:    true,
+		Create: true,
+	}.ExpectError(t, "customer managed key managed_services_cmk isn't registered for STORAGE use case, but only for MANAGED_SERVICES")
+}
+
 func TestResourceWorkspaceCreateWithIsNoPublicIPEnabledFalse(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -1770,3 +1842,155 @@ func TestSensitiveDataInLogs(t *testing.T) {
 	assert.NotContains(t, fmt.Sprintf("%#v", tk), "sensitive")
 	assert.NotContains(t, fmt.Sprintf("%+v", tk), "sensitive")
 }
+
+func TestDiagnoseWorkspaceFailure(t *testing.T) {
+	ws := Workspace{
+		CredentialsID:          "bcd",
+		StorageConfigurationID: "ghi",
+		NetworkID:              "fgh",
+	}
+	ws.WorkspaceStatusMessage = "Cross-account IAM role is not authorized to perform ec2:CreateVpc"
+	assert.Equal(t, "failed phase: credentials validation, check credentials_id bcd", diagnoseWorkspaceFailure(ws))
+	ws.WorkspaceStatusMessage = "Subnet subnet-123 is not in the VPC"
+	assert.Equal(t, "failed phase: network validation, check network_id fgh", diagnoseWorkspaceFailure(ws))
+	ws.WorkspaceStatusMessage = "Access denied to the KMS key"
+	assert.Equal(t, "failed phase: encryption key validation", diagnoseWorkspaceFailure(ws))
+	ws.WorkspaceStatusMessage = "Always fails"
+	assert.Equal(t, "", diagnoseWorkspaceFailure(ws))
+}
+
+func TestExplainWorkspaceFailure_Phase(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewWorkspacesAPI(ctx, client).explainWorkspaceFailure(Workspace{
+			CredentialsID:          "bcd",
+			WorkspaceStatusMessage: "Failed to assume the credential role",
+		})
+		assert.EqualError(t, err, "Failed to assume the credential role; failed phase: credentials validation, check credentials_id bcd")
+	})
+}
+
+func TestCreateOrAdopt_KeepsFailedWorkspace(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/workspaces",
+			Response: []Workspace{
+				{WorkspaceID: 1, WorkspaceName: "other", WorkspaceStatus: WorkspaceStatusFailed},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/accounts/abc/workspaces",
+			Response: Workspace{
+				WorkspaceID:    1234,
+				AccountID:      "abc",
+				DeploymentName: "900150983cd24fb0",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/workspaces/1234",
+			Response: Workspace{
+				WorkspaceID:            1234,
+				WorkspaceStatus:        WorkspaceStatusFailed,
+				WorkspaceStatusMessage: "Storage configuration is invalid",
+				StorageConfigurationID: "ghi",
+				AccountID:              "abc",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewWorkspacesAPI(ctx, client).CreateOrAdopt(&Workspace{
+			AccountID:              "abc",
+			WorkspaceName:          "labdata",
+			DeploymentName:         "900150983cd24fb0",
+			AwsRegion:              "us-east-1",
+			CredentialsID:          "bcd",
+			StorageConfigurationID: "ghi",
+		}, DefaultProvisionTimeout)
+		assert.EqualError(t, err, "Storage configuration is invalid; failed phase: storage validation, "+
+			"check storage_configuration_id ghi. Workspace 1234 is kept, fix the configuration and apply again to retry provisioning")
+	})
+}
+
+func TestResourceWorkspaceCreate_AdoptFailedWorkspace(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/workspaces",
+				Response: []Workspace{
+					{
+						WorkspaceID:     1234,
+						WorkspaceName:   "labdata",
+						DeploymentName:  "900150983cd24fb0",
+						WorkspaceStatus: WorkspaceStatusFailed,
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/accounts/abc/workspaces/1234",
+				ExpectedRequest: map[string]any{
+					"aws_region":               "us-east-1",
+					"credentials_id":           "bcd",
+					"storage_configuration_id": "ghi",
+					"network_id":               "fixed",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/accounts/abc/workspaces/1234",
+				ReuseRequest: true,
+				Response: Workspace{
+					AccountID:              "abc",
+					WorkspaceID:            1234,
+					WorkspaceName:          "labdata",
+					DeploymentName:         "900150983cd24fb0",
+					AwsRegion:              "us-east-1",
+					CredentialsID:          "bcd",
+					StorageConfigurationID: "ghi",
+					NetworkID:              "fixed",
+					WorkspaceStatus:        WorkspaceStatusRunning,
+				},
+			},
+		},
+		Resource: ResourceMwsWorkspaces(),
+		HCL: `
+		account_id               = "abc"
+		aws_region               = "us-east-1"
+		credentials_id           = "bcd"
+		deployment_name          = "900150983cd24fb0"
+		workspace_name           = "labdata"
+		storage_configuration_id = "ghi"
+		network_id               = "fixed"
+		adopt_failed_workspace   = true
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "abc/1234",
+		"workspace_id": 1234,
+	})
+}
+
+func TestWaitForRunning_Timeout(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/accounts/abc/workspaces/1234",
+			ReuseRequest: true,
+			Response: Workspace{
+				AccountID:              "abc",
+				WorkspaceID:            1234,
+				WorkspaceStatus:        WorkspaceStatusProvisioning,
+				WorkspaceStatusMessage: "Workspace resources are being set up",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewWorkspacesAPI(ctx, client).WaitForRunning(Workspace{
+			AccountID:   "abc",
+			WorkspaceID: 1234,
+		}, 100*time.Millisecond)
+		assert.EqualError(t, err, "operation didn't complete in 100ms, "+
+			"last progress: workspace 1234 is PROVISIONING: Workspace resources are being set up")
+	})
+}
@@ -0,0 +1,106 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EffectivePermission is a single permission of a principal, either direct or inherited from a parent object
+type EffectivePermission struct {
+	UserName             string   `json:"user_name,omitempty" tf:"computed"`
+	GroupName            string   `json:"group_name,omitempty" tf:"computed"`
+	ServicePrincipalName string   `json:"service_principal_name,omitempty" tf:"computed"`
+	PermissionLevel      string   `json:"permission_level,omitempty" tf:"computed"`
+	Inherited            bool     `json:"inherited,omitempty" tf:"computed"`
+	InheritedFromObject  []string `json:"inherited_from_object,omitempty" tf:"computed"`
+}
+
+type effectivePermissions struct {
+	ObjectType        string                `json:"object_type,omitempty" tf:"computed"`
+	AccessControlList []EffectivePermission `json:"access_control,omitempty" tf:"computed"`
+}
+
+// toEffectivePermissions flattens the access control list into one entry per principal and permission level
+func (oa *ObjectACL) toEffectivePermissions() []EffectivePermission {
+	permissions := []EffectivePermission{}
+	for _, ac := range oa.AccessControlList {
+		newPermission := func(level string) EffectivePermission {
+			return EffectivePermission{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      level,
+			}
+		}
+		for _, p := range ac.AllPermissions {
+			permission := newPermission(p.PermissionLevel)
+			permission.Inherited = p.Inherited
+			permission.InheritedFromObject = p.InheritedFromObject
+			permissions = append(permissions, permission)
+		}
+		if len(ac.AllPermissions) == 0 && ac.PermissionLevel != "" {
+			// SQL objects don't report inheritance
+			permissions = append(permissions, newPermission(ac.PermissionLevel))
+		}
+	}
+	return permissions
+}
+
+// DataSourceEffectivePermissions returns both direct and inherited permissions of an object
+func DataSourceEffectivePermissions() common.Resource {
+	s := common.StructToSchema(effectivePermissions{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		fields := []string{}
+		for _, mapping := range permissionsResourceIDFields() {
+			if _, ok := m[mapping.field]; ok {
+				continue
+			}
+			fields = append(fields, mapping.field)
+			m[mapping.field] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+		}
+		for _, field := range fields {
+			m[field].ExactlyOneOf = fields
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			for _, mapping := range permissionsResourceIDFields() {
+				v, ok := d.GetOk(mapping.field)
+				if !ok {
+					continue
+				}
+				if mapping.field == "authorization" && v.(string) != mapping.objectType {
+					// tokens and passwords share the same field
+					continue
+				}
+				id, err := mapping.idRetriever(ctx, w, v.(string))
+				if err != nil {
+					return err
+				}
+				objectID := fmt.Sprintf("/%s/%s", mapping.resourceType, id)
+				objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+				if err != nil {
+					return err
+				}
+				d.SetId(objectID)
+				return common.StructToData(effectivePermissions{
+					ObjectType:        mapping.objectType,
+					AccessControlList: objectACL.toEffectivePermissions(),
+				}, s, d)
+			}
+			return fmt.Errorf("cannot find permissions mapping for %s", d.Get("authorization"))
+		},
+	}
+}
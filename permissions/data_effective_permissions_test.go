@@ -0,0 +1,139 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+)
+
+func TestDataSourceEffectivePermissions_NotebookPath(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fetl",
+				Response: workspace.ObjectStatus{
+					ObjectID:   988765,
+					ObjectType: "NOTEBOOK",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/notebooks/988765",
+				Response: ObjectACL{
+					ObjectID:   "/notebooks/988765",
+					ObjectType: "notebook",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RUN",
+								},
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/directories/123"},
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/directories/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `notebook_path = "/Shared/etl"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                       "/notebooks/988765",
+		"object_type":                              "notebook",
+		"access_control.#":                         3,
+		"access_control.0.user_name":               TestingUser,
+		"access_control.0.permission_level":        "CAN_RUN",
+		"access_control.0.inherited":               false,
+		"access_control.1.permission_level":        "CAN_MANAGE",
+		"access_control.1.inherited":               true,
+		"access_control.1.inherited_from_object.0": "/directories/123",
+		"access_control.2.group_name":              "admins",
+		"access_control.2.inherited_from_object.0": "/directories/",
+	})
+}
+
+func TestDataSourceEffectivePermissions_SqlObject(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/preview/sql/permissions/queries/abc",
+				Response: ObjectACL{
+					ObjectID:   "queries/abc",
+					ObjectType: "query",
+					AccessControlList: []AccessControl{
+						{
+							GroupName:       "analysts",
+							PermissionLevel: "CAN_RUN",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `sql_query_id = "abc"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_type":                       "query",
+		"access_control.#":                  1,
+		"access_control.0.group_name":       "analysts",
+		"access_control.0.permission_level": "CAN_RUN",
+	})
+}
+
+func TestDataSourceEffectivePermissions_Tokens(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/authorization/tokens",
+				Response: ObjectACL{
+					ObjectID:   "/authorization/tokens",
+					ObjectType: "tokens",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "users",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceEffectivePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `authorization = "tokens"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "/authorization/tokens",
+		"object_type":      "tokens",
+		"access_control.#": 1,
+	})
+}
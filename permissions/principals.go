@@ -0,0 +1,71 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// built-in groups exist in every workspace, so they aren't looked up
+var builtInGroups = map[string]bool{
+	"admins": true,
+	"users":  true,
+}
+
+// validatePrincipals checks that users, groups and service principals of the access control list exist in the
+// workspace, so that all missing principals are reported at once before any permissions are changed. It needs
+// API calls, so it runs at apply time and not in CustomizeDiff.
+func validatePrincipals(ctx context.Context, w *databricks.WorkspaceClient, acl []AccessControlChange) error {
+	checked := map[string]bool{}
+	missing := []string{}
+	for _, ac := range acl {
+		var kind, name, filter string
+		switch {
+		case ac.UserName != "":
+			kind, name, filter = "user", ac.UserName, "userName"
+		case ac.GroupName != "":
+			if builtInGroups[ac.GroupName] {
+				continue
+			}
+			kind, name, filter = "group", ac.GroupName, "displayName"
+		case ac.ServicePrincipalName != "":
+			kind, name, filter = "service principal", ac.ServicePrincipalName, "applicationId"
+		default:
+			continue
+		}
+		key := kind + " " + name
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+		exists, err := principalExists(ctx, w, kind, fmt.Sprintf(`%s eq "%s"`, filter, common.ScimFilterValue(name)))
+		if err != nil {
+			return fmt.Errorf("cannot check %s: %w", key, err)
+		}
+		if !exists {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("principals don't exist in the workspace: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func principalExists(ctx context.Context, w *databricks.WorkspaceClient, kind, filter string) (bool, error) {
+	switch kind {
+	case "user":
+		users, err := w.Users.ListAll(ctx, iam.ListUsersRequest{Filter: filter, Attributes: "id"})
+		return len(users) > 0, err
+	case "group":
+		groups, err := w.Groups.ListAll(ctx, iam.ListGroupsRequest{Filter: filter, Attributes: "id"})
+		return len(groups) > 0, err
+	default:
+		sps, err := w.ServicePrincipals.ListAll(ctx, iam.ListServicePrincipalsRequest{Filter: filter, Attributes: "id"})
+		return len(sps) > 0, err
+	}
+}
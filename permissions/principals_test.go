@@ -0,0 +1,68 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidatePrincipals_AllExist(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockUsersAPI().EXPECT().ListAll(mock.Anything, iam.ListUsersRequest{
+		Filter:     `userName eq "alice@example.com"`,
+		Attributes: "id",
+	}).Return([]iam.User{{Id: "1"}}, nil).Once()
+	w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, iam.ListGroupsRequest{
+		Filter:     `displayName eq "data-eng"`,
+		Attributes: "id",
+	}).Return([]iam.Group{{Id: "2"}}, nil)
+	w.GetMockServicePrincipalsAPI().EXPECT().ListAll(mock.Anything, iam.ListServicePrincipalsRequest{
+		Filter:     `applicationId eq "00000000-0000-0000-0000-000000000001"`,
+		Attributes: "id",
+	}).Return([]iam.ServicePrincipal{{Id: "3"}}, nil)
+	err := validatePrincipals(context.Background(), w.WorkspaceClient, []AccessControlChange{
+		{UserName: "alice@example.com", PermissionLevel: "CAN_READ"},
+		{UserName: "alice@example.com", PermissionLevel: "CAN_RUN"},
+		{GroupName: "data-eng", PermissionLevel: "CAN_RUN"},
+		{GroupName: "users", PermissionLevel: "CAN_READ"},
+		{ServicePrincipalName: "00000000-0000-0000-0000-000000000001", PermissionLevel: "CAN_MANAGE"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidatePrincipals_ReportsAllMissing(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockUsersAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return([]iam.User{}, nil)
+	w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, nil)
+	err := validatePrincipals(context.Background(), w.WorkspaceClient, []AccessControlChange{
+		{UserName: "alice@example.com", PermissionLevel: "CAN_READ"},
+		{GroupName: "data-eng", PermissionLevel: "CAN_RUN"},
+	})
+	assert.EqualError(t, err, "principals don't exist in the workspace: user alice@example.com, group data-eng")
+}
+
+func TestValidatePrincipals_Error(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, mock.Anything).Return(nil, apierr.ErrPermissionDenied)
+	err := validatePrincipals(context.Background(), w.WorkspaceClient, []AccessControlChange{
+		{GroupName: "data-eng", PermissionLevel: "CAN_RUN"},
+	})
+	assert.ErrorContains(t, err, "cannot check group data-eng")
+}
+
+func TestValidatePrincipals_EscapesFilter(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockGroupsAPI().EXPECT().ListAll(mock.Anything, iam.ListGroupsRequest{
+		Filter:     `displayName eq "data \"eng\" \\ ops"`,
+		Attributes: "id",
+	}).Return([]iam.Group{{Id: "2"}}, nil)
+	err := validatePrincipals(context.Background(), w.WorkspaceClient, []AccessControlChange{
+		{GroupName: `data "eng" \ ops`, PermissionLevel: "CAN_RUN"},
+	})
+	assert.NoError(t, err)
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -94,6 +96,12 @@ func (acc AccessControlChange) String() string {
 		acc.PermissionLevel)
 }
 
+// principalKey identifies the principal of the change regardless of the permission level
+func (acc AccessControlChange) principalKey() AccessControlChange {
+	acc.PermissionLevel = ""
+	return acc
+}
+
 // NewPermissionsAPI creates PermissionsAPI instance from provider meta
 func NewPermissionsAPI(ctx context.Context, m any) PermissionsAPI {
 	return PermissionsAPI{
@@ -216,9 +224,13 @@ func (a PermissionsAPI) safePutWithOwner(objectID string, objectACL AccessContro
 	return nil
 }
 
+func isAdminsPermissionEnforced(objectID string) bool {
+	return objectID == "/authorization/tokens" || objectID == "/registered-models/root" || objectID == "/directories/0"
+}
+
 // Update updates object permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeList) error {
-	if objectID == "/authorization/tokens" || objectID == "/registered-models/root" || objectID == "/directories/0" {
+	if isAdminsPermissionEnforced(objectID) {
 		// Prevent "Cannot change permissions for group 'admins' to None."
 		objectACL.AccessControlList = append(objectACL.AccessControlList, AccessControlChange{
 			GroupName:       "admins",
@@ -253,6 +265,58 @@ func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeLis
 	return a.safePutWithOwner(objectID, objectACL, originalAcl)
 }
 
+// nonAuthoritativeMutex serializes changes of non-authoritative resources, because several of them could manage
+// the same object in parallel, and each of them reads and writes the whole access control list
+var nonAuthoritativeMutex sync.Mutex
+
+// UpdateNonAuthoritative changes permissions only of the removed and the configured principals,
+// keeping direct permissions of all other principals intact
+func (a PermissionsAPI) UpdateNonAuthoritative(objectID string, removed, configured []AccessControlChange) error {
+	nonAuthoritativeMutex.Lock()
+	defer nonAuthoritativeMutex.Unlock()
+	objectACL, err := a.Read(objectID)
+	if err != nil {
+		return err
+	}
+	managed := map[AccessControlChange]bool{}
+	for _, acc := range removed {
+		managed[acc.principalKey()] = true
+	}
+	for _, acc := range configured {
+		managed[acc.principalKey()] = true
+	}
+	me := ""
+	if a.shouldExplicitlyGrantCallingUserManagePermissions(objectID) {
+		w, err := a.client.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		user, err := w.CurrentUser.Me(a.context)
+		if err != nil {
+			return err
+		}
+		me = user.UserName
+	}
+	accl := AccessControlChangeList{AccessControlList: []AccessControlChange{}}
+	for _, acl := range objectACL.AccessControlList {
+		change, direct := acl.toAccessControlChange()
+		if !direct || managed[change.principalKey()] {
+			continue
+		}
+		if change.GroupName == "admins" && isAdminsPermissionEnforced(objectID) {
+			// added by Update
+			continue
+		}
+		if me != "" && (change.UserName == me || change.ServicePrincipalName == me) && change.PermissionLevel == "CAN_MANAGE" {
+			// added by put
+			continue
+		}
+		accl.AccessControlList = append(accl.AccessControlList, change)
+	}
+	accl.AccessControlList = append(accl.AccessControlList, configured...)
+	return a.Update(objectID, accl)
+}
+
 // Delete gracefully removes permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Delete(objectID string) error {
 	objectACL, err := a.Read(objectID)
@@ -359,6 +423,8 @@ func permissionsResourceIDFields() []permissionsIDFieldMapping {
 type PermissionsEntity struct {
 	ObjectType        string                `json:"object_type,omitempty" tf:"computed"`
 	AccessControlList []AccessControlChange `json:"access_control" tf:"slice_set"`
+	// ValidatePrincipals checks that principals of the access control list exist before permissions are changed
+	ValidatePrincipals bool `json:"validate_principals,omitempty"`
 }
 
 func (oa *ObjectACL) isMatchingMapping(mapping permissionsIDFieldMapping) bool {
@@ -414,6 +480,26 @@ func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (Per
 	return entity, fmt.Errorf("unknown object type %s", oa.ObjectType)
 }
 
+// isAuthoritative is true, unless authoritative is explicitly set to false. There's no default value,
+// so that existing resources don't get a diff after upgrade.
+func isAuthoritative(d *schema.ResourceData) bool {
+	v, ok := d.GetOkExists("authoritative")
+	return !ok || v.(bool)
+}
+
+func accessControlChangesFrom(v any) (changes []AccessControlChange) {
+	for _, item := range v.(*schema.Set).List() {
+		m := item.(map[string]any)
+		changes = append(changes, AccessControlChange{
+			UserName:             m["user_name"].(string),
+			GroupName:            m["group_name"].(string),
+			ServicePrincipalName: m["service_principal_name"].(string),
+			PermissionLevel:      m["permission_level"].(string),
+		})
+	}
+	return
+}
+
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {
@@ -440,6 +526,10 @@ func ResourcePermissions() common.Resource {
 			}
 		}
 		s["access_control"].MinItems = 1
+		s["authoritative"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
 		return s
 	})
 	return common.Resource{
@@ -480,11 +570,23 @@ func ResourcePermissions() common.Resource {
 			if err != nil {
 				return err
 			}
+			managed := map[AccessControlChange]bool{}
+			for _, acc := range accessControlChangesFrom(d.Get("access_control")) {
+				managed[acc.principalKey()] = true
+			}
+			// all permissions are kept on import, as the configured principals aren't known yet
+			if !isAuthoritative(d) && len(managed) > 0 {
+				// permissions of principals, that are managed elsewhere, aren't a configuration drift
+				entity.AccessControlList = slices.DeleteFunc(entity.AccessControlList, func(acc AccessControlChange) bool {
+					return !managed[acc.principalKey()]
+				})
+			}
 			if len(entity.AccessControlList) == 0 {
 				// empty "modifiable" access control list is the same as resource absence
 				d.SetId("")
 				return nil
 			}
+			entity.ValidatePrincipals = d.Get("validate_principals").(bool)
 			return common.StructToData(entity, s, d)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -498,6 +600,11 @@ func ResourcePermissions() common.Resource {
 			if err != nil {
 				return err
 			}
+			if entity.ValidatePrincipals {
+				if err = validatePrincipals(ctx, w, entity.AccessControlList); err != nil {
+					return err
+				}
+			}
 			for _, mapping := range permissionsResourceIDFields() {
 				if v, ok := d.GetOk(mapping.field); ok {
 					id, err := mapping.idRetriever(ctx, w, v.(string))
@@ -519,9 +626,13 @@ func ResourcePermissions() common.Resource {
 							return fmt.Errorf("it is not possible to restrict any permissions from `admins`")
 						}
 					}
-					err = NewPermissionsAPI(ctx, c).Update(objectID, AccessControlChangeList{
-						AccessControlList: entity.AccessControlList,
-					})
+					if isAuthoritative(d) {
+						err = NewPermissionsAPI(ctx, c).Update(objectID, AccessControlChangeList{
+							AccessControlList: entity.AccessControlList,
+						})
+					} else {
+						err = NewPermissionsAPI(ctx, c).UpdateNonAuthoritative(objectID, nil, entity.AccessControlList)
+					}
 					if err != nil {
 						return err
 					}
@@ -534,11 +645,29 @@ func ResourcePermissions() common.Resource {
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var entity PermissionsEntity
 			common.DataToStructPointer(d, s, &entity)
+			if entity.ValidatePrincipals {
+				w, err := c.WorkspaceClient()
+				if err != nil {
+					return err
+				}
+				if err = validatePrincipals(ctx, w, entity.AccessControlList); err != nil {
+					return err
+				}
+			}
+			if !isAuthoritative(d) {
+				old, new := d.GetChange("access_control")
+				removed := accessControlChangesFrom(old.(*schema.Set).Difference(new.(*schema.Set)))
+				return NewPermissionsAPI(ctx, c).UpdateNonAuthoritative(d.Id(), removed, entity.AccessControlList)
+			}
 			return NewPermissionsAPI(ctx, c).Update(d.Id(), AccessControlChangeList{
 				AccessControlList: entity.AccessControlList,
 			})
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if !isAuthoritative(d) {
+				return NewPermissionsAPI(ctx, c).UpdateNonAuthoritative(d.Id(),
+					accessControlChangesFrom(d.Get("access_control")), nil)
+			}
 			return NewPermissionsAPI(ctx, c).Delete(d.Id())
 		},
 	}
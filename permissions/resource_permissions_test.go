@@ -2033,3 +2033,424 @@ func TestResourcePermissionsRootDirectory(t *testing.T) {
 	assert.Equal(t, TestingUser, firstElem["user_name"])
 	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
 }
+
+func TestResourcePermissionsCreate_ValidatePrincipals(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=100&filter=userName+eq+%22ben%22&startIndex=1",
+				Response: map[string]any{
+					"Resources": []any{},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		cluster_id          = "abc"
+		validate_principals = true
+
+		access_control {
+			user_name        = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "principals don't exist in the workspace: user ben")
+}
+
+func TestResourcePermissionsCreate_NonAuthoritative(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				ReuseRequest: true,
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: "platform",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+									Inherited:       true,
+								},
+							},
+						},
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_ATTACH_TO",
+								},
+							},
+						},
+						{
+							UserName: TestingAdminUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        "platform",
+							PermissionLevel: "CAN_RESTART",
+						},
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_ATTACH_TO",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Create:   true,
+		HCL: `
+		cluster_id = "abc"
+		authoritative = false
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "/clusters/abc", d.Id())
+	assert.Equal(t, false, d.Get("authoritative"))
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+	firstElem := ac.List()[0].(map[string]any)
+	assert.Equal(t, TestingUser, firstElem["user_name"])
+	assert.Equal(t, "CAN_ATTACH_TO", firstElem["permission_level"])
+}
+
+func TestResourcePermissionsDelete_NonAuthoritative(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/9",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/9",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_VIEW",
+								},
+							},
+						},
+						{
+							GroupName: "platform",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE_RUN",
+								},
+							},
+						},
+						{
+							UserName: TestingOwner,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "IS_OWNER",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/9",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "platform",
+							PermissionLevel: "CAN_MANAGE_RUN",
+						},
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Delete:   true,
+		ID:       "/jobs/9",
+		HCL: `
+		job_id = 9
+		authoritative = false
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_VIEW"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestUpdateNonAuthoritativeRemovesOnlyManagedPrincipals(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/permissions/jobs/9",
+			Response: ObjectACL{
+				ObjectID:   "/jobs/9",
+				ObjectType: "job",
+				AccessControlList: []AccessControl{
+					{
+						UserName: TestingUser,
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_VIEW",
+							},
+						},
+					},
+					{
+						GroupName: "platform",
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_MANAGE_RUN",
+							},
+						},
+					},
+					{
+						GroupName: "data",
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_VIEW",
+							},
+						},
+					},
+					{
+						UserName: TestingOwner,
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "IS_OWNER",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   http.MethodPut,
+			Resource: "/api/2.0/permissions/jobs/9",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						GroupName:       "platform",
+						PermissionLevel: "CAN_MANAGE_RUN",
+					},
+					{
+						UserName:        TestingOwner,
+						PermissionLevel: "IS_OWNER",
+					},
+					{
+						UserName:        TestingUser,
+						PermissionLevel: "CAN_MANAGE_RUN",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewPermissionsAPI(ctx, client).UpdateNonAuthoritative("/jobs/9",
+			[]AccessControlChange{
+				{
+					GroupName:       "data",
+					PermissionLevel: "CAN_VIEW",
+				},
+				{
+					UserName:        TestingUser,
+					PermissionLevel: "CAN_VIEW",
+				},
+			},
+			[]AccessControlChange{
+				{
+					UserName:        TestingUser,
+					PermissionLevel: "CAN_MANAGE_RUN",
+				},
+			})
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateNonAuthoritativeKeepsAdminsForTokens(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/permissions/authorization/tokens",
+			Response: ObjectACL{
+				ObjectID:   "/authorization/tokens",
+				ObjectType: "tokens",
+				AccessControlList: []AccessControl{
+					{
+						GroupName: "admins",
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_MANAGE",
+							},
+						},
+					},
+					{
+						GroupName: "platform",
+						AllPermissions: []Permission{
+							{
+								PermissionLevel: "CAN_USE",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   http.MethodPut,
+			Resource: "/api/2.0/permissions/authorization/tokens",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						GroupName:       "platform",
+						PermissionLevel: "CAN_USE",
+					},
+					{
+						GroupName:       "data",
+						PermissionLevel: "CAN_USE",
+					},
+					{
+						GroupName:       "admins",
+						PermissionLevel: "CAN_MANAGE",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewPermissionsAPI(ctx, client).UpdateNonAuthoritative("/authorization/tokens", nil,
+			[]AccessControlChange{
+				{
+					GroupName:       "data",
+					PermissionLevel: "CAN_USE",
+				},
+			})
+		assert.NoError(t, err)
+	})
+}
+
+func TestResourcePermissionsRead_NonAuthoritative(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: "platform",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		ID:       "/clusters/abc",
+		HCL: `
+		cluster_id = "abc"
+		authoritative = false
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_ATTACH_TO"
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+	firstElem := ac.List()[0].(map[string]any)
+	assert.Equal(t, TestingUser, firstElem["user_name"])
+	assert.Equal(t, "CAN_MANAGE", firstElem["permission_level"])
+}
+
+func TestResourcePermissionsRead_NonAuthoritativeImport(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: "platform",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/clusters/abc",
+		InstanceState: map[string]string{
+			"authoritative": "false",
+		},
+		// there's no configuration, that is compared with the imported state
+		RequiresNew: true,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "/clusters/abc", d.Id(), "imported resource isn't removed from the state")
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+	assert.Equal(t, "platform", ac.List()[0].(map[string]any)["user_name"])
+}
@@ -2,10 +2,10 @@ package pipelines
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"slices"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -13,7 +13,6 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -86,43 +85,63 @@ func Delete(w *databricks.WorkspaceClient, ctx context.Context, id string, timeo
 	if err != nil {
 		return err
 	}
-	return retry.RetryContext(ctx, timeout,
-		func() *retry.RetryError {
-			i, err := Read(w, ctx, id)
-			if err != nil {
-				if apierr.IsMissing(err) {
-					return nil
-				}
-				return retry.NonRetryableError(err)
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
+		i, err := Read(w, ctx, id)
+		if err != nil {
+			if apierr.IsMissing(err) {
+				return common.Completed(), nil
 			}
-			message := fmt.Sprintf("Pipeline %s is in state %s, not yet deleted", id, i.State)
-			log.Printf("[DEBUG] %s", message)
-			return retry.RetryableError(errors.New(message))
-		})
+			return common.Progress{}, err
+		}
+		return common.InProgress("Pipeline %s is in state %s, not yet deleted", id, i.State), nil
+	})
 }
 
 func waitForState(w *databricks.WorkspaceClient, ctx context.Context, id string, timeout time.Duration, desiredState pipelines.PipelineState) error {
-	return retry.RetryContext(ctx, timeout,
-		func() *retry.RetryError {
-			i, err := Read(w, ctx, id)
-			if err != nil {
-				return retry.NonRetryableError(err)
-			}
-			state := i.State
-			if state == desiredState {
-				return nil
-			}
-			if state == pipelines.PipelineStateFailed {
-				return retry.NonRetryableError(fmt.Errorf("pipeline %s has failed", id))
-			}
-			if !i.Spec.Continuous {
-				// continuous pipelines just need a non-FAILED check
-				return nil
-			}
-			message := fmt.Sprintf("Pipeline %s is in state %s, not yet in state %s", id, state, desiredState)
-			log.Printf("[DEBUG] %s", message)
-			return retry.RetryableError(errors.New(message))
-		})
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
+		i, err := Read(w, ctx, id)
+		if err != nil {
+			return common.Progress{}, err
+		}
+		state := i.State
+		if state == desiredState {
+			return common.Completed(), nil
+		}
+		if state == pipelines.PipelineStateFailed {
+			return common.Progress{}, fmt.Errorf("pipeline %s has failed", id)
+		}
+		if !i.Spec.Continuous {
+			// continuous pipelines just need a non-FAILED check
+			return common.Completed(), nil
+		}
+		progress := common.InProgress("Pipeline %s is in state %s, not yet in state %s", id, state, desiredState)
+		if len(i.LatestUpdates) > 0 {
+			update := i.LatestUpdates[0].State
+			progress.Message += fmt.Sprintf(", latest update is %s", update)
+			progress.Percent = pipelineUpdatePercent(update)
+		}
+		return progress, nil
+	})
+}
+
+// pipelineUpdateStages are the states of a starting pipeline update in the order they are passed. The API doesn't
+// report completion of an update, so the percentage of progress is derived from the current stage.
+var pipelineUpdateStages = []pipelines.UpdateStateInfoState{
+	pipelines.UpdateStateInfoStateQueued,
+	pipelines.UpdateStateInfoStateCreated,
+	pipelines.UpdateStateInfoStateWaitingForResources,
+	pipelines.UpdateStateInfoStateInitializing,
+	pipelines.UpdateStateInfoStateResetting,
+	pipelines.UpdateStateInfoStateSettingUpTables,
+	pipelines.UpdateStateInfoStateRunning,
+}
+
+func pipelineUpdatePercent(state pipelines.UpdateStateInfoState) float64 {
+	stage := slices.Index(pipelineUpdateStages, state)
+	if stage < 0 {
+		return -1
+	}
+	return float64(100 * stage / len(pipelineUpdateStages))
 }
 
 type createPipelineRequestStruct struct {
@@ -194,6 +213,47 @@ func suppressStorageDiff(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
+func clusterLabel(label string) string {
+	if label == "" {
+		return "default"
+	}
+	return label
+}
+
+// onlyConfiguredKeys returns entries of the map, which keys are present in the prior value
+func onlyConfiguredKeys(values map[string]string, prior any) map[string]string {
+	priorValues, _ := prior.(map[string]any)
+	filtered := map[string]string{}
+	for k, v := range values {
+		if _, ok := priorValues[k]; ok {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// removePolicyInjectedValues removes custom tags and Spark configuration, that are added to pipeline clusters
+// by their cluster policies, so that they aren't shown as drift. Values are only removed, if the cluster is
+// already in the state, so that all of them are kept on import.
+func removePolicyInjectedValues(d *schema.ResourceData, clusters []pipelines.PipelineCluster) {
+	prior := map[string]map[string]any{}
+	for _, raw := range d.Get("cluster").([]any) {
+		if cluster, ok := raw.(map[string]any); ok {
+			label, _ := cluster["label"].(string)
+			prior[clusterLabel(label)] = cluster
+		}
+	}
+	for i := range clusters {
+		cluster := &clusters[i]
+		priorCluster, ok := prior[clusterLabel(cluster.Label)]
+		if cluster.PolicyId == "" || !ok {
+			continue
+		}
+		cluster.CustomTags = onlyConfiguredKeys(cluster.CustomTags, priorCluster["custom_tags"])
+		cluster.SparkConf = onlyConfiguredKeys(cluster.SparkConf, priorCluster["spark_conf"])
+	}
+}
+
 func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
 
 	// ForceNew fields
@@ -210,6 +270,7 @@ func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.Customizab
 	s.SchemaPath("cluster", "node_type_id").SetComputed()
 	s.SchemaPath("cluster", "driver_node_type_id").SetComputed()
 	s.SchemaPath("cluster", "enable_local_disk_encryption").SetComputed()
+	s.SchemaPath("cluster", "driver_instance_pool_id").SetComputed()
 	s.SchemaPath("url").SetComputed()
 
 	s.SchemaPath("state").SetComputed()
@@ -225,7 +286,11 @@ func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.Customizab
 	s.SchemaPath("edition").SetSuppressDiff()
 	s.SchemaPath("channel").SetSuppressDiff()
 	s.SchemaPath("cluster", "spark_conf").SetCustomSuppressDiff(clusters.SparkConfDiffSuppressFunc)
+	// cloud attributes could be set by cluster policies, the same way as for databricks_cluster
+	s.SchemaPath("cluster", "aws_attributes").SetSuppressDiff()
 	s.SchemaPath("cluster", "aws_attributes", "zone_id").SetCustomSuppressDiff(clusters.ZoneDiffSuppress)
+	s.SchemaPath("cluster", "azure_attributes").SetSuppressDiff()
+	s.SchemaPath("cluster", "gcp_attributes").SetSuppressDiff()
 	s.SchemaPath("cluster", "autoscale", "mode").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
 	s.SchemaPath("edition").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
 	s.SchemaPath("storage").SetCustomSuppressDiff(suppressStorageDiff)
@@ -292,6 +357,7 @@ func ResourcePipeline() common.Resource {
 			if readPipeline.Spec == nil {
 				return fmt.Errorf("pipeline spec is nil for '%v'", readPipeline.PipelineId)
 			}
+			removePolicyInjectedValues(d, readPipeline.Spec.Clusters)
 			p := Pipeline{
 				PipelineSpec:    *readPipeline.Spec,
 				Cause:           readPipeline.Cause,
@@ -344,6 +344,94 @@ func TestResourcePipelineRead(t *testing.T) {
 	})
 }
 
+func policyPipelineResponse() *pipelines.GetPipelineResponse {
+	return &pipelines.GetPipelineResponse{
+		PipelineId: "abcd",
+		Spec: &pipelines.PipelineSpec{
+			Name:    "test-pipeline",
+			Storage: "/test/storage",
+			Clusters: []pipelines.PipelineCluster{
+				{
+					Label:    "default",
+					PolicyId: "policy",
+					CustomTags: map[string]string{
+						"team":        "data",
+						"cost_center": "1234",
+					},
+					SparkConf: map[string]string{
+						"spark.databricks.acl.needAdminPermissionToViewLogs": "true",
+					},
+				},
+			},
+			Edition: "ADVANCED",
+			Channel: "CURRENT",
+		},
+	}
+}
+
+func TestResourcePipelineRead_PolicyInjectedValues(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().Get(mock.Anything, pipelines.GetPipelineRequest{
+				PipelineId: "abcd",
+			}).Return(policyPipelineResponse(), nil)
+		},
+		HCL: `
+		name    = "test-pipeline"
+		storage = "/test/storage"
+		cluster {
+			label       = "default"
+			policy_id   = "policy"
+			custom_tags = {
+				team = "data"
+			}
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":                        "test-pipeline",
+			"storage":                     "/test/storage",
+			"cluster.#":                   "1",
+			"cluster.0.label":             "default",
+			"cluster.0.policy_id":         "policy",
+			"cluster.0.custom_tags.%":     "1",
+			"cluster.0.custom_tags.team":  "data",
+			"cluster.0.spark_conf.%":      "0",
+			"cluster.0.spark_env_vars.%":  "0",
+			"cluster.0.ssh_public_keys.#": "0",
+		},
+		Resource: ResourcePipeline(),
+		Read:     true,
+		ID:       "abcd",
+	}.ApplyAndExpectData(t, map[string]any{
+		"cluster.0.custom_tags": map[string]any{
+			"team": "data",
+		},
+		"cluster.0.spark_conf": map[string]any{},
+	})
+}
+
+func TestResourcePipelineRead_PolicyInjectedValuesOnImport(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().Get(mock.Anything, pipelines.GetPipelineRequest{
+				PipelineId: "abcd",
+			}).Return(policyPipelineResponse(), nil)
+		},
+		Resource: ResourcePipeline(),
+		Read:     true,
+		New:      true,
+		ID:       "abcd",
+	}.ApplyAndExpectData(t, map[string]any{
+		"cluster.0.custom_tags": map[string]any{
+			"team":        "data",
+			"cost_center": "1234",
+		},
+		"cluster.0.spark_conf": map[string]any{
+			"spark.databricks.acl.needAdminPermissionToViewLogs": "true",
+		},
+	})
+}
+
 func TestResourcePipelineRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
@@ -805,3 +893,10 @@ func TestDefault(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "abcd", d.Id())
 }
+
+func TestPipelineUpdatePercent(t *testing.T) {
+	assert.Equal(t, float64(0), pipelineUpdatePercent(pipelines.UpdateStateInfoStateQueued))
+	assert.Equal(t, float64(42), pipelineUpdatePercent(pipelines.UpdateStateInfoStateInitializing))
+	assert.Equal(t, float64(85), pipelineUpdatePercent(pipelines.UpdateStateInfoStateRunning))
+	assert.Equal(t, float64(-1), pipelineUpdatePercent(pipelines.UpdateStateInfoStateFailed))
+}
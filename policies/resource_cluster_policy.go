@@ -2,6 +2,8 @@ package policies
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/compute"
@@ -25,6 +27,137 @@ func isBuiltinPolicyFamily(ctx context.Context, w *databricks.WorkspaceClient, f
 	return false, nil
 }
 
+// ClusterPolicyGrant is a principal, that is granted CAN_USE permission on the policy
+type ClusterPolicyGrant struct {
+	UserName             string `json:"user_name,omitempty"`
+	GroupName            string `json:"group_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+}
+
+func getPolicyGrants(d *schema.ResourceData) (grants []ClusterPolicyGrant, err error) {
+	for _, v := range d.Get("grant").(*schema.Set).List() {
+		m := v.(map[string]any)
+		grant := ClusterPolicyGrant{
+			UserName:             m["user_name"].(string),
+			GroupName:            m["group_name"].(string),
+			ServicePrincipalName: m["service_principal_name"].(string),
+		}
+		specified := 0
+		for _, principal := range []string{grant.UserName, grant.GroupName, grant.ServicePrincipalName} {
+			if principal != "" {
+				specified++
+			}
+		}
+		if specified != 1 {
+			return nil, fmt.Errorf("exactly one of user_name, group_name or service_principal_name must be specified in grant")
+		}
+		grants = append(grants, grant)
+	}
+	return
+}
+
+// updatePolicyGrants gives CAN_USE to the added principals and revokes direct permissions of the removed ones,
+// keeping permissions of all other principals, e.g. those managed by databricks_permissions
+func updatePolicyGrants(ctx context.Context, w *databricks.WorkspaceClient, policyId string,
+	removed, added []ClusterPolicyGrant) error {
+	acl := []compute.ClusterPolicyAccessControlRequest{}
+	if len(removed) > 0 {
+		// permissions could only be revoked by setting the whole access control list
+		permissions, err := w.ClusterPolicies.GetPermissions(ctx, compute.GetClusterPolicyPermissionsRequest{
+			ClusterPolicyId: policyId,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ac := range permissions.AccessControlList {
+			grant := ClusterPolicyGrant{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+			}
+			if slices.Contains(removed, grant) || slices.Contains(added, grant) {
+				continue
+			}
+			for _, p := range ac.AllPermissions {
+				if p.Inherited {
+					continue
+				}
+				acl = append(acl, compute.ClusterPolicyAccessControlRequest{
+					UserName:             ac.UserName,
+					GroupName:            ac.GroupName,
+					ServicePrincipalName: ac.ServicePrincipalName,
+					PermissionLevel:      p.PermissionLevel,
+				})
+			}
+		}
+	}
+	for _, grant := range added {
+		acl = append(acl, compute.ClusterPolicyAccessControlRequest{
+			UserName:             grant.UserName,
+			GroupName:            grant.GroupName,
+			ServicePrincipalName: grant.ServicePrincipalName,
+			PermissionLevel:      compute.ClusterPolicyPermissionLevelCanUse,
+		})
+	}
+	request := compute.ClusterPolicyPermissionsRequest{
+		ClusterPolicyId:   policyId,
+		AccessControlList: acl,
+	}
+	var err error
+	if len(removed) > 0 {
+		_, err = w.ClusterPolicies.SetPermissions(ctx, request)
+	} else if len(added) > 0 {
+		_, err = w.ClusterPolicies.UpdatePermissions(ctx, request)
+	}
+	return err
+}
+
+func grantsFrom(v any) (grants []ClusterPolicyGrant) {
+	for _, item := range v.(*schema.Set).List() {
+		m := item.(map[string]any)
+		grants = append(grants, ClusterPolicyGrant{
+			UserName:             m["user_name"].(string),
+			GroupName:            m["group_name"].(string),
+			ServicePrincipalName: m["service_principal_name"].(string),
+		})
+	}
+	return
+}
+
+// readPolicyGrants returns the managed principals, that have direct CAN_USE permission on the policy
+func readPolicyGrants(ctx context.Context, w *databricks.WorkspaceClient, policyId string,
+	managed []ClusterPolicyGrant) ([]any, error) {
+	permissions, err := w.ClusterPolicies.GetPermissions(ctx, compute.GetClusterPolicyPermissionsRequest{
+		ClusterPolicyId: policyId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	grants := []any{}
+	for _, ac := range permissions.AccessControlList {
+		grant := ClusterPolicyGrant{
+			UserName:             ac.UserName,
+			GroupName:            ac.GroupName,
+			ServicePrincipalName: ac.ServicePrincipalName,
+		}
+		if !slices.Contains(managed, grant) {
+			// permissions of other principals aren't managed by grant blocks
+			continue
+		}
+		for _, p := range ac.AllPermissions {
+			if p.Inherited || p.PermissionLevel != compute.ClusterPolicyPermissionLevelCanUse {
+				continue
+			}
+			grants = append(grants, map[string]any{
+				"user_name":              ac.UserName,
+				"group_name":             ac.GroupName,
+				"service_principal_name": ac.ServicePrincipalName,
+			})
+		}
+	}
+	return grants, nil
+}
+
 var rcpSchema = common.StructToSchema(
 	compute.CreatePolicy{},
 	func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -41,6 +174,14 @@ var rcpSchema = common.StructToSchema(
 		m["policy_family_id"].ConflictsWith = []string{"definition"}
 		m["policy_family_definition_overrides"].RequiredWith = []string{"policy_family_id"}
 
+		m["grant"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: common.StructToSchema(ClusterPolicyGrant{}, nil),
+			},
+		}
+
 		return m
 	})
 
@@ -64,6 +205,10 @@ func ResourceClusterPolicy() common.Resource {
 
 			var request compute.CreatePolicy
 			common.DataToStructPointer(d, rcpSchema, &request)
+			grants, err := getPolicyGrants(d)
+			if err != nil {
+				return err
+			}
 
 			var clusterPolicy *compute.CreatePolicyResponse
 			if request.PolicyFamilyId != "" {
@@ -91,6 +236,12 @@ func ResourceClusterPolicy() common.Resource {
 				return err
 			}
 			d.SetId(clusterPolicy.PolicyId)
+			if len(grants) > 0 {
+				err = updatePolicyGrants(ctx, w, clusterPolicy.PolicyId, nil, grants)
+				if err != nil {
+					return fmt.Errorf("cannot grant permissions on cluster policy %s: %w", clusterPolicy.PolicyId, err)
+				}
+			}
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -102,7 +253,20 @@ func ResourceClusterPolicy() common.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(resp, rcpSchema, d)
+			err = common.StructToData(resp, rcpSchema, d)
+			if err != nil {
+				return err
+			}
+			// permissions could be managed by databricks_permissions as well, so only granted principals are read
+			managed := grantsFrom(d.Get("grant"))
+			if len(managed) == 0 {
+				return nil
+			}
+			grants, err := readPolicyGrants(ctx, w, d.Id(), managed)
+			if err != nil {
+				return err
+			}
+			return d.Set("grant", grants)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -117,7 +281,20 @@ func ResourceClusterPolicy() common.Resource {
 				request.Definition = ""
 			}
 
-			return w.ClusterPolicies.Edit(ctx, request)
+			err = w.ClusterPolicies.Edit(ctx, request)
+			if err != nil {
+				return err
+			}
+			if d.HasChange("grant") {
+				grants, err := getPolicyGrants(d)
+				if err != nil {
+					return err
+				}
+				old, new := d.GetChange("grant")
+				removed := grantsFrom(old.(*schema.Set).Difference(new.(*schema.Set)))
+				return updatePolicyGrants(ctx, w, d.Id(), removed, grants)
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -1,11 +1,13 @@
 package policies
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,6 +120,102 @@ func TestResourceClusterPolicyCreate(t *testing.T) {
 	assert.Equal(t, 3, d.Get("max_clusters_per_user"))
 }
 
+func TestResourceClusterPolicyCreate_Grants(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/policies/clusters/create",
+				ExpectedRequest: compute.CreatePolicy{
+					Name:               "Dummy",
+					Definition:         "{}",
+					MaxClustersPerUser: 1,
+				},
+				Response: compute.CreatePolicyResponse{
+					PolicyId: "abc",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/permissions/cluster-policies/abc",
+				ExpectedRequest: compute.ClusterPolicyPermissionsRequest{
+					AccessControlList: []compute.ClusterPolicyAccessControlRequest{
+						{
+							GroupName:       "data-engineers",
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+				Response: compute.ClusterPolicyPermissions{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: compute.Policy{
+					PolicyId:           "abc",
+					Name:               "Dummy",
+					Definition:         "{}",
+					MaxClustersPerUser: 1,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/cluster-policies/abc?",
+				Response: compute.ClusterPolicyPermissions{
+					AccessControlList: []compute.ClusterPolicyAccessControlResponse{
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []compute.ClusterPolicyPermission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []compute.ClusterPolicyPermission{
+								{
+									PermissionLevel:     "CAN_USE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/cluster-policies/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name                  = "Dummy"
+		definition            = "{}"
+		max_clusters_per_user = 1
+		grant {
+			group_name = "data-engineers"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":      "abc",
+		"grant.#": 1,
+	})
+}
+
+func TestResourceClusterPolicyCreate_InvalidGrant(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name       = "Dummy"
+		definition = "{}"
+		grant {
+			group_name = "data-engineers"
+			user_name  = "me@example.com"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "exactly one of user_name, group_name or service_principal_name must be specified in grant")
+}
+
 func TestResourceClusterPolicyCreateNewFromPolicyFamily(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -375,6 +473,98 @@ func TestResourceClusterPolicyUpdate(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceClusterPolicyUpdate_RemoveGrants(t *testing.T) {
+	grantHash := fmt.Sprint(schema.HashResource(rcpSchema["grant"].Elem.(*schema.Resource))(map[string]any{
+		"user_name": "me@example.com",
+	}))
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/policies/clusters/edit",
+				ExpectedRequest: compute.EditPolicy{
+					PolicyId:   "abc",
+					Name:       "Dummy",
+					Definition: "{}",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/cluster-policies/abc?",
+				Response: compute.ClusterPolicyPermissions{
+					AccessControlList: []compute.ClusterPolicyAccessControlResponse{
+						{
+							UserName: "me@example.com",
+							AllPermissions: []compute.ClusterPolicyPermission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []compute.ClusterPolicyPermission{
+								{
+									PermissionLevel: "CAN_USE",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []compute.ClusterPolicyPermission{
+								{
+									PermissionLevel:     "CAN_USE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/cluster-policies/"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/permissions/cluster-policies/abc",
+				ExpectedRequest: compute.ClusterPolicyPermissionsRequest{
+					AccessControlList: []compute.ClusterPolicyAccessControlRequest{
+						{
+							GroupName:       "data-engineers",
+							PermissionLevel: "CAN_USE",
+						},
+					},
+				},
+				Response: compute.ClusterPolicyPermissions{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: compute.Policy{
+					PolicyId:   "abc",
+					Name:       "Dummy",
+					Definition: "{}",
+				},
+			},
+		},
+		Resource: ResourceClusterPolicy(),
+		InstanceState: map[string]string{
+			"name":                               "Dummy",
+			"definition":                         "{}",
+			"grant.#":                            "1",
+			"grant." + grantHash + ".user_name":  "me@example.com",
+			"grant." + grantHash + ".group_name": "",
+			"grant." + grantHash + ".service_principal_name": "",
+		},
+		HCL: `
+		name       = "Dummy"
+		definition = "{}"
+		`,
+		Update: true,
+		ID:     "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"grant.#": 0,
+	})
+}
+
 func TestResourceClusterPolicyUpdateWithPolicyFamily(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
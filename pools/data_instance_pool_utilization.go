@@ -0,0 +1,85 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type instancePoolUtilization struct {
+	InstancePoolID   string `json:"instance_pool_id,omitempty" tf:"computed"`
+	Name             string `json:"name,omitempty" tf:"computed"`
+	MinIdleInstances int32  `json:"min_idle_instances,omitempty" tf:"computed"`
+	MaxCapacity      int32  `json:"max_capacity,omitempty" tf:"computed"`
+	UsedCount        int32  `json:"used_count" tf:"computed"`
+	IdleCount        int32  `json:"idle_count" tf:"computed"`
+	PendingUsedCount int32  `json:"pending_used_count" tf:"computed"`
+	PendingIdleCount int32  `json:"pending_idle_count" tf:"computed"`
+	// TotalCount includes both running and pending instances
+	TotalCount int32 `json:"total_count" tf:"computed"`
+	// Utilization is the share of instances used by clusters, from 0 to 1
+	Utilization float64 `json:"utilization" tf:"computed"`
+}
+
+func newInstancePoolUtilization(pool InstancePoolAndStats) instancePoolUtilization {
+	u := instancePoolUtilization{
+		InstancePoolID:   pool.InstancePoolID,
+		Name:             pool.InstancePoolName,
+		MinIdleInstances: pool.MinIdleInstances,
+		MaxCapacity:      pool.MaxCapacity,
+	}
+	if pool.Stats != nil {
+		u.UsedCount = pool.Stats.UsedCount
+		u.IdleCount = pool.Stats.IdleCount
+		u.PendingUsedCount = pool.Stats.PendingUsedCount
+		u.PendingIdleCount = pool.Stats.PendingIdleCount
+	}
+	u.TotalCount = u.UsedCount + u.IdleCount + u.PendingUsedCount + u.PendingIdleCount
+	if u.TotalCount > 0 {
+		u.Utilization = float64(u.UsedCount+u.PendingUsedCount) / float64(u.TotalCount)
+	}
+	return u
+}
+
+// DataSourceInstancePoolUtilization returns current usage statistics of an instance pool specified by id or name
+func DataSourceInstancePoolUtilization() common.Resource {
+	s := common.StructToSchema(instancePoolUtilization{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, field := range []string{"instance_pool_id", "name"} {
+			common.CustomizeSchemaPath(m, field).SetOptional().SetExactlyOneOf([]string{"instance_pool_id", "name"})
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, m *common.DatabricksClient) error {
+			poolsAPI := NewInstancePoolsAPI(ctx, m)
+			var pool *InstancePoolAndStats
+			if name, ok := d.GetOk("name"); ok {
+				var err error
+				pool, err = getPool(poolsAPI, name.(string))
+				if err != nil {
+					return err
+				}
+			} else {
+				poolID := d.Get("instance_pool_id").(string)
+				poolList, err := poolsAPI.List()
+				if err != nil {
+					return err
+				}
+				for i := range poolList.InstancePools {
+					if poolList.InstancePools[i].InstancePoolID == poolID {
+						pool = &poolList.InstancePools[i]
+						break
+					}
+				}
+				if pool == nil {
+					return fmt.Errorf("instance pool '%s' doesn't exist", poolID)
+				}
+			}
+			d.SetId(pool.InstancePoolID)
+			return common.StructToData(newInstancePoolUtilization(*pool), s, d)
+		},
+	}
+}
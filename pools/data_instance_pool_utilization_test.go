@@ -0,0 +1,93 @@
+package pools
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var poolsWithStats = InstancePoolList{
+	InstancePools: []InstancePoolAndStats{
+		{
+			InstancePoolID:   "abc",
+			InstancePoolName: "pool",
+			MinIdleInstances: 2,
+			MaxCapacity:      20,
+			Stats: &InstancePoolStats{
+				UsedCount:        5,
+				IdleCount:        2,
+				PendingUsedCount: 1,
+			},
+		},
+		{
+			InstancePoolID:   "def",
+			InstancePoolName: "empty",
+		},
+	},
+}
+
+func TestDataSourceInstancePoolUtilization_ByName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/list",
+				Response: poolsWithStats,
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceInstancePoolUtilization(),
+		ID:          ".",
+		HCL:         `name = "pool"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                 "abc",
+		"instance_pool_id":   "abc",
+		"min_idle_instances": 2,
+		"max_capacity":       20,
+		"used_count":         5,
+		"idle_count":         2,
+		"pending_used_count": 1,
+		"pending_idle_count": 0,
+		"total_count":        8,
+		"utilization":        0.75,
+	})
+}
+
+func TestDataSourceInstancePoolUtilization_ById(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/list",
+				Response: poolsWithStats,
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceInstancePoolUtilization(),
+		ID:          ".",
+		HCL:         `instance_pool_id = "def"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":        "empty",
+		"total_count": 0,
+		"utilization": 0.0,
+	})
+}
+
+func TestDataSourceInstancePoolUtilization_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/list",
+				Response: poolsWithStats,
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceInstancePoolUtilization(),
+		ID:          ".",
+		HCL:         `instance_pool_id = "xyz"`,
+	}.ExpectError(t, "instance pool 'xyz' doesn't exist")
+}
@@ -0,0 +1,9 @@
+// Package qa contains the unit testing framework of the provider. It's also used by module authors to test their own
+// resources, that are built with the common package, against simulated Databricks APIs without a real workspace:
+//
+//   - ResourceFixture runs a single create, read, update or delete of a resource against HTTP fixtures,
+//     mocked Go SDK clients or mocked command execution, and returns the resulting resource data.
+//   - HTTPFixture describes an expected request to the mock server and the response to it.
+//   - ResourceCornerCases checks that all CRUD operations of a resource handle API errors.
+//   - HTTPFixturesApply, MockWorkspaceApply and MockAccountsApply call custom code with a client of the mock server.
+package qa
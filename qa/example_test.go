@@ -0,0 +1,104 @@
+package qa_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+)
+
+// directoryResource is an example of a resource, that is defined outside of the provider
+func directoryResource() common.Resource {
+	return common.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			path := d.Get("path").(string)
+			if err = w.Workspace.MkdirsByPath(ctx, path); err != nil {
+				return err
+			}
+			d.SetId(path)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			status, err := w.Workspace.GetStatusByPath(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("path", status.Path)
+			d.Set("object_id", status.ObjectId)
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.Workspace.Delete(ctx, workspace.Delete{Path: d.Id()})
+		},
+	}
+}
+
+func TestExternalResource_HTTPFixtures(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: workspace.Mkdirs{Path: "/Shared/example"},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fexample",
+				Response: workspace.ObjectInfo{Path: "/Shared/example", ObjectId: 123},
+			},
+		},
+		Resource: directoryResource(),
+		Create:   true,
+		HCL:      `path = "/Shared/example"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "/Shared/example",
+		"object_id": 123,
+	})
+}
+
+func TestExternalResource_MockWorkspaceClient(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockWorkspaceAPI().EXPECT().GetStatusByPath(mock.Anything, "/Shared/example").Return(
+				&workspace.ObjectInfo{Path: "/Shared/example", ObjectId: 123}, nil)
+		},
+		Resource: directoryResource(),
+		Read:     true,
+		ID:       "/Shared/example",
+	}.ApplyAndExpectData(t, map[string]any{
+		"path":      "/Shared/example",
+		"object_id": 123,
+	})
+}
+
+func TestExternalResource_CornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, directoryResource(), qa.CornerCaseID("/Shared/example"))
+}
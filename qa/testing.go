@@ -61,13 +61,20 @@ func RandomName(prefix ...string) string {
 
 // HTTPFixture defines request structure for test
 type HTTPFixture struct {
-	Method          string
-	Resource        string
-	Response        any
-	Status          int
+	// HTTP method of the expected request, e.g. GET or POST
+	Method string
+	// Path of the expected request including the query string, e.g. /api/2.0/clusters/get?cluster_id=abc
+	Resource string
+	// Response is serialized to JSON and returned as the response body
+	Response any
+	// Status of the response, 200 by default
+	Status int
+	// ExpectedRequest is compared with the JSON body of the request, if set
 	ExpectedRequest any
-	ReuseRequest    bool
-	MatchAny        bool
+	// ReuseRequest allows the fixture to match more than one request
+	ReuseRequest bool
+	// MatchAny matches any request regardless of its method and resource
+	MatchAny bool
 }
 
 // ResourceFixture is a helper to unit test terraform resources. It does this by
@@ -121,6 +128,12 @@ type ResourceFixture struct {
 	Gcp         bool
 	AccountID   string
 	Token       string
+	// provider-level owner of created Unity Catalog objects
+	DefaultOwner string
+	// provider-level flag, that disallows creation of default clusters
+	DisallowImplicitCompute bool
+	// provider-level policy rules, that are checked on plan
+	PlanGuardrails common.PlanGuardrails
 	// new resource
 	New bool
 }
@@ -280,6 +293,9 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.AccountID != "" {
 		config.AccountID = f.AccountID
 	}
+	client.DefaultOwner = f.DefaultOwner
+	client.DisallowImplicitCompute = f.DisallowImplicitCompute
+	client.PlanGuardrails = f.PlanGuardrails
 	f.setDatabricksEnvironmentForTest(client, server.URL)
 	if len(f.HCL) > 0 {
 		var out any
@@ -3,11 +3,17 @@ package repos
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"path"
 	"regexp"
 	"strings"
 
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	ws "github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -71,6 +77,156 @@ func (a ReposAPI) Create(r reposCreateRequest) (ReposInformation, error) {
 	return resp, err
 }
 
+// prepareDirectoryConversion removes an empty workspace directory, so that a git folder could be created
+// at its path. The Repos API can't convert directories with content, so an error is returned for them.
+func (a ReposAPI) prepareDirectoryConversion(p string) error {
+	notebooksAPI := workspace.NewNotebooksAPI(a.context, a.client)
+	status, err := notebooksAPI.Read(p)
+	if apierr.IsMissing(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if status.ObjectType != workspace.Directory {
+		return fmt.Errorf("%s is a %s and can't be converted to a git folder", p, strings.ToLower(status.ObjectType))
+	}
+	objects, err := notebooksAPI.ListInternalImpl(p)
+	if err != nil {
+		return err
+	}
+	if len(objects) > 0 {
+		return fmt.Errorf("directory %s isn't empty and can't be converted to a git folder", p)
+	}
+	log.Printf("[INFO] Removing empty directory %s to create a git folder", p)
+	return notebooksAPI.Delete(p, false)
+}
+
+// firstPageHasItems checks only the first page of the listing, because a single match is enough
+func firstPageHasItems[T any](ctx context.Context, it listing.Iterator[T]) (bool, error) {
+	if !it.HasNext(ctx) {
+		return false, nil
+	}
+	_, err := it.Next(ctx)
+	return err == nil, err
+}
+
+// ownerAccessControl looks up the owner among users, service principals and groups of the workspace, so that
+// CAN_MANAGE is granted to the right kind of principal, e.g. to a group with `@` in its name
+func (a ReposAPI) ownerAccessControl(w *databricks.WorkspaceClient, owner string) (ws.RepoAccessControlRequest, error) {
+	acl := ws.RepoAccessControlRequest{PermissionLevel: ws.RepoPermissionLevelCanManage}
+	value := common.ScimFilterValue(owner)
+	found, err := firstPageHasItems(a.context, w.Users.List(a.context, iam.ListUsersRequest{
+		Filter: fmt.Sprintf(`userName eq "%s"`, value), Attributes: "id"}))
+	if err != nil {
+		return acl, err
+	}
+	if found {
+		acl.UserName = owner
+		return acl, nil
+	}
+	found, err = firstPageHasItems(a.context, w.ServicePrincipals.List(a.context, iam.ListServicePrincipalsRequest{
+		Filter: fmt.Sprintf(`applicationId eq "%s"`, value), Attributes: "id"}))
+	if err != nil {
+		return acl, err
+	}
+	if found {
+		acl.ServicePrincipalName = owner
+		return acl, nil
+	}
+	found, err = firstPageHasItems(a.context, w.Groups.List(a.context, iam.ListGroupsRequest{
+		Filter: fmt.Sprintf(`displayName eq "%s"`, value), Attributes: "id"}))
+	if err != nil {
+		return acl, err
+	}
+	if found {
+		acl.GroupName = owner
+		return acl, nil
+	}
+	return acl, fmt.Errorf("owner %s isn't a user, service principal or group in the workspace", owner)
+}
+
+func repoPrincipalName(ac ws.RepoAccessControlResponse) string {
+	if ac.UserName != "" {
+		return ac.UserName
+	}
+	if ac.ServicePrincipalName != "" {
+		return ac.ServicePrincipalName
+	}
+	return ac.GroupName
+}
+
+// grantOwner gives CAN_MANAGE permission on the repo to the principal, as git folders have no explicit owner.
+// Direct permissions of the previous owner are revoked.
+func (a ReposAPI) grantOwner(id, owner, previousOwner string) error {
+	w, err := a.client.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	acl, err := a.ownerAccessControl(w, owner)
+	if err != nil {
+		return err
+	}
+	if previousOwner == "" || previousOwner == owner {
+		_, err = w.Repos.UpdatePermissions(a.context, ws.RepoPermissionsRequest{
+			RepoId:            id,
+			AccessControlList: []ws.RepoAccessControlRequest{acl},
+		})
+		return err
+	}
+	// permissions could only be revoked by replacing all direct permissions of the git folder
+	current, err := w.Repos.GetPermissionsByRepoId(a.context, id)
+	if err != nil {
+		return err
+	}
+	acls := []ws.RepoAccessControlRequest{}
+	for _, ac := range current.AccessControlList {
+		name := repoPrincipalName(ac)
+		if name == owner || name == previousOwner {
+			continue
+		}
+		for _, permission := range ac.AllPermissions {
+			if permission.Inherited {
+				continue
+			}
+			acls = append(acls, ws.RepoAccessControlRequest{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      permission.PermissionLevel,
+			})
+		}
+	}
+	_, err = w.Repos.SetPermissions(a.context, ws.RepoPermissionsRequest{
+		RepoId:            id,
+		AccessControlList: append(acls, acl),
+	})
+	return err
+}
+
+// hasOwner checks that the principal still has direct CAN_MANAGE permission on the repo
+func (a ReposAPI) hasOwner(id, owner string) (bool, error) {
+	w, err := a.client.WorkspaceClient()
+	if err != nil {
+		return false, err
+	}
+	current, err := w.Repos.GetPermissionsByRepoId(a.context, id)
+	if err != nil {
+		return false, err
+	}
+	for _, ac := range current.AccessControlList {
+		if repoPrincipalName(ac) != owner {
+			continue
+		}
+		for _, permission := range ac.AllPermissions {
+			if !permission.Inherited && permission.PermissionLevel == ws.RepoPermissionLevelCanManage {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func (a ReposAPI) Delete(id string) error {
 	return a.client.Delete(a.context, fmt.Sprintf("/repos/%s", id), nil)
 }
@@ -150,8 +306,16 @@ func GetGitProviderFromUrl(uri string) string {
 	return provider
 }
 
+// trimWorkspacePrefix removes the `/Workspace` prefix, as the Repos API returns paths without it
+func trimWorkspacePrefix(p string) string {
+	if strings.HasPrefix(p, "/Workspace/") {
+		return strings.TrimPrefix(p, "/Workspace")
+	}
+	return p
+}
+
 func validatePath(i interface{}, k string) (_ []string, errors []error) {
-	v := i.(string)
+	v := trimWorkspacePrefix(i.(string))
 	if v == "" || !strings.HasPrefix(v, "/Repos/") {
 		return
 	}
@@ -170,6 +334,9 @@ func ResourceRepo() common.Resource {
 		s["branch"].ConflictsWith = []string{"tag"}
 		s["branch"].ValidateFunc = validation.StringIsNotWhiteSpace
 		s["path"].ValidateFunc = validatePath
+		s["path"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return old != "" && strings.TrimSuffix(trimWorkspacePrefix(old), "/") == strings.TrimSuffix(trimWorkspacePrefix(new), "/")
+		}
 
 		s["tag"] = &schema.Schema{
 			Type:          schema.TypeString,
@@ -181,6 +348,15 @@ func ResourceRepo() common.Resource {
 			Type:     schema.TypeString,
 			Computed: true,
 		}
+		s["owner"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		}
+		s["convert_existing_directory"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
 
 		delete(s, "id")
 		return s
@@ -194,8 +370,14 @@ func ResourceRepo() common.Resource {
 			var repo ReposInformation
 			common.DataToStructPointer(d, s, &repo)
 
-			req := reposCreateRequest{Path: repo.Path, Provider: repo.Provider,
+			req := reposCreateRequest{Path: trimWorkspacePrefix(repo.Path), Provider: repo.Provider,
 				Url: repo.Url, SparseCheckout: repo.SparseCheckout}
+			if req.Path != "" && d.Get("convert_existing_directory").(bool) {
+				err := reposAPI.prepareDirectoryConversion(strings.TrimSuffix(req.Path, "/"))
+				if err != nil {
+					return err
+				}
+			}
 			resp, err := reposAPI.Create(req)
 			if err != nil {
 				return err
@@ -209,7 +391,14 @@ func ResourceRepo() common.Resource {
 			} else if branch != "" && branch != resp.Branch {
 				updateReq["branch"] = branch
 			}
-			return reposAPI.Update(d.Id(), updateReq)
+			err = reposAPI.Update(d.Id(), updateReq)
+			if err != nil {
+				return err
+			}
+			if owner, ok := d.GetOk("owner"); ok {
+				return reposAPI.grantOwner(d.Id(), owner.(string), "")
+			}
+			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			reposAPI := NewReposAPI(ctx, c)
@@ -222,6 +411,16 @@ func ResourceRepo() common.Resource {
 				return err
 			}
 			d.Set("workspace_path", "/Workspace"+resp.Path)
+			if owner := d.Get("owner").(string); owner != "" {
+				// an owner without CAN_MANAGE permission is reported as a drift
+				isOwner, err := reposAPI.hasOwner(d.Id(), owner)
+				if err != nil {
+					return err
+				}
+				if !isOwner {
+					d.Set("owner", "")
+				}
+			}
 			return nil
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -250,7 +449,14 @@ func ResourceRepo() common.Resource {
 			if repo.SparseCheckout != nil {
 				req["sparse_checkout"] = map[string]any{"patterns": repo.SparseCheckout.Patterns}
 			}
-			return reposAPI.Update(d.Id(), req)
+			err := reposAPI.Update(d.Id(), req)
+			if err != nil {
+				return err
+			}
+			if previous, owner := d.GetChange("owner"); owner.(string) != "" && d.HasChange("owner") {
+				return reposAPI.grantOwner(d.Id(), owner.(string), previous.(string))
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewReposAPI(ctx, c).Delete(d.Id())
@@ -6,11 +6,15 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	ws "github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestGetGitProviderFromUrl(t *testing.T) {
@@ -521,3 +525,358 @@ func TestReposListWithPrefix(t *testing.T) {
 	assert.Equal(t, len(reposList), 1)
 	assert.Equal(t, resp.Branch, reposList[0].Branch)
 }
+
+func TestResourceRepoCreateGitFolderWithOwner(t *testing.T) {
+	resp := ReposInformation{
+		ID:           121232342,
+		Url:          "https://github.com/user/test.git",
+		Provider:     "gitHub",
+		Branch:       "main",
+		Path:         "/Users/user@domain/test",
+		HeadCommitID: "1124323423abc23424",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain%2Ftest",
+				Response: workspace.ObjectStatus{
+					ObjectType: "DIRECTORY",
+					Path:       "/Users/user@domain/test",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FUsers%2Fuser%40domain%2Ftest",
+				Response: workspace.ObjectList{},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: workspace.DeletePath{
+					Path: "/Users/user@domain/test",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Users/user@domain",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/repos",
+				ExpectedRequest: reposCreateRequest{
+					Url:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+					Path:     "/Users/user@domain/test",
+				},
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=100&filter=userName+eq+%22data-engineers%22&startIndex=1",
+				Response: iam.ListUsersResponse{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id&count=100&filter=applicationId+eq+%22data-engineers%22&startIndex=1",
+				Response: iam.ListServicePrincipalResponse{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?attributes=id&count=100&filter=displayName+eq+%22data-engineers%22&startIndex=1",
+				Response: iam.ListGroupsResponse{
+					Resources:    []iam.Group{{Id: "123"}},
+					StartIndex:   1,
+					TotalResults: 1,
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/permissions/repos/121232342",
+				ExpectedRequest: map[string]any{
+					"access_control_list": []map[string]any{
+						{"group_name": "data-engineers", "permission_level": "CAN_MANAGE"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/repos/121232342?",
+				Response: ws.RepoPermissions{
+					AccessControlList: []ws.RepoAccessControlResponse{
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []ws.RepoPermission{
+								{PermissionLevel: ws.RepoPermissionLevelCanManage},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		HCL: `
+		url                        = "https://github.com/user/test.git"
+		path                       = "/Workspace/Users/user@domain/test"
+		owner                      = "data-engineers"
+		convert_existing_directory = true
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":             resp.RepoID(),
+		"path":           "/Users/user@domain/test",
+		"workspace_path": "/Workspace/Users/user@domain/test",
+		"owner":          "data-engineers",
+	})
+}
+
+func TestResourceRepoCreateConvertNonEmptyDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain%2Ftest",
+				Response: workspace.ObjectStatus{
+					ObjectType: "DIRECTORY",
+					Path:       "/Users/user@domain/test",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/list?path=%2FUsers%2Fuser%40domain%2Ftest",
+				Response: workspace.ObjectList{
+					Objects: []workspace.ObjectStatus{
+						{ObjectType: "NOTEBOOK", Path: "/Users/user@domain/test/nb"},
+					},
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		HCL: `
+		url                        = "https://github.com/user/test.git"
+		path                       = "/Users/user@domain/test"
+		convert_existing_directory = true
+		`,
+		Create: true,
+	}.ExpectError(t, "directory /Users/user@domain/test isn't empty and can't be converted to a git folder")
+}
+
+func TestResourceRepoPathWithWorkspacePrefix_NoDiff(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":            "https://github.com/user/test.git",
+			"git_provider":   "gitHub",
+			"path":           "/Users/user@domain/test",
+			"branch":         "main",
+			"commit_hash":    "1124323423abc23424",
+			"workspace_path": "/Workspace/Users/user@domain/test",
+		},
+		HCL: `
+		url  = "https://github.com/user/test.git"
+		path = "/Workspace/Users/user@domain/test/"
+		`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{},
+		ID:           "121232342",
+	}.ApplyNoError(t)
+}
+
+func TestResourceReposUpdateOwner(t *testing.T) {
+	resp := ReposInformation{
+		ID:       121232342,
+		Url:      "https://github.com/user/test.git",
+		Provider: "gitHub",
+		Branch:   "main",
+		Path:     "/Repos/user@domain/test",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]any{"branch": "main"},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=100&filter=userName+eq+%22abc%22&startIndex=1",
+				Response: iam.ListUsersResponse{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id&count=100&filter=applicationId+eq+%22abc%22&startIndex=1",
+				Response: iam.ListServicePrincipalResponse{
+					Resources:    []iam.ServicePrincipal{{Id: "123"}},
+					StartIndex:   1,
+					TotalResults: 1,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/repos/121232342?",
+				Response: ws.RepoPermissions{
+					AccessControlList: []ws.RepoAccessControlResponse{
+						{
+							UserName: "user@domain",
+							AllPermissions: []ws.RepoPermission{
+								{PermissionLevel: ws.RepoPermissionLevelCanManage},
+							},
+						},
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []ws.RepoPermission{
+								{PermissionLevel: ws.RepoPermissionLevelCanRead},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []ws.RepoPermission{
+								{
+									PermissionLevel:     ws.RepoPermissionLevelCanManage,
+									Inherited:           true,
+									InheritedFromObject: []string{"/directories/"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/permissions/repos/121232342",
+				ExpectedRequest: map[string]any{
+					"access_control_list": []map[string]any{
+						{"group_name": "data-engineers", "permission_level": "CAN_READ"},
+						{"service_principal_name": "abc", "permission_level": "CAN_MANAGE"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/repos/121232342?",
+				Response: ws.RepoPermissions{
+					AccessControlList: []ws.RepoAccessControlResponse{
+						{
+							ServicePrincipalName: "abc",
+							AllPermissions: []ws.RepoPermission{
+								{PermissionLevel: ws.RepoPermissionLevelCanManage},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          "https://github.com/user/test.git",
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"branch":       "main",
+			"owner":        "user@domain",
+		},
+		HCL: `
+		url    = "https://github.com/user/test.git"
+		path   = "/Repos/user@domain/test"
+		branch = "main"
+		owner  = "abc"
+		`,
+		ID:     "121232342",
+		Update: true,
+	}.ApplyAndExpectData(t, map[string]any{"owner": "abc"})
+}
+
+func TestResourceReposUpdateOwner_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]any{"branch": "main"},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=id&count=100&filter=userName+eq+%22abc%22&startIndex=1",
+				Response: iam.ListUsersResponse{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id&count=100&filter=applicationId+eq+%22abc%22&startIndex=1",
+				Response: iam.ListServicePrincipalResponse{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?attributes=id&count=100&filter=displayName+eq+%22abc%22&startIndex=1",
+				Response: iam.ListGroupsResponse{},
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          "https://github.com/user/test.git",
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"branch":       "main",
+		},
+		HCL: `
+		url    = "https://github.com/user/test.git"
+		path   = "/Repos/user@domain/test"
+		branch = "main"
+		owner  = "abc"
+		`,
+		ID:     "121232342",
+		Update: true,
+	}.ExpectError(t, "owner abc isn't a user, service principal or group in the workspace")
+}
+
+func TestResourceReposReadOwnerDrift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:       121232342,
+					Url:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+					Branch:   "main",
+					Path:     "/Repos/user@domain/test",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/permissions/repos/121232342?",
+				Response: ws.RepoPermissions{
+					AccessControlList: []ws.RepoAccessControlResponse{
+						{
+							GroupName: "data-engineers",
+							AllPermissions: []ws.RepoPermission{
+								{PermissionLevel: ws.RepoPermissionLevelCanRead},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          "https://github.com/user/test.git",
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"branch":       "main",
+			"owner":        "data-engineers",
+		},
+		ID:   "121232342",
+		Read: true,
+	}.ApplyAndExpectData(t, map[string]any{"owner": ""})
+}
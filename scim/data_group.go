@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// DataSourceGroup returns information about group specified by display name
+// DataSourceGroup returns information about group specified by display name or external ID
 func DataSourceGroup() common.Resource {
 	type entity struct {
-		DisplayName       string   `json:"display_name"`
+		DisplayName       string   `json:"display_name,omitempty" tf:"computed"`
 		Recursive         bool     `json:"recursive,omitempty"`
 		Members           []string `json:"members,omitempty" tf:"slice_set,computed"`
 		Users             []string `json:"users,omitempty" tf:"slice_set,computed"`
@@ -30,6 +30,9 @@ func DataSourceGroup() common.Resource {
 		s map[string]*schema.Schema) map[string]*schema.Schema {
 		// nolint once SDKv2 has Diagnostics-returning validators, change
 		s["display_name"].ValidateFunc = validation.StringIsNotEmpty
+		s["display_name"].ExactlyOneOf = []string{"display_name", "external_id"}
+		s["external_id"].ValidateFunc = validation.StringIsNotEmpty
+		s["external_id"].ExactlyOneOf = []string{"display_name", "external_id"}
 		s["recursive"].Default = true
 		s["members"].Deprecated = "Please use `users`, `service_principals`, and `child_groups` instead"
 		addEntitlementsToSchema(s)
@@ -43,7 +46,14 @@ func DataSourceGroup() common.Resource {
 			common.DataToStructPointer(d, s, &this)
 			groupsAPI := NewGroupsAPI(ctx, m)
 			groupAttributes := "members,roles,entitlements,externalId"
-			group, err := groupsAPI.ReadByDisplayName(this.DisplayName, groupAttributes)
+			var group Group
+			var err error
+			if this.ExternalID != "" {
+				// external IDs are unique, unlike display names of groups synchronized from different sources
+				group, err = groupsAPI.ReadByExternalID(this.ExternalID)
+			} else {
+				group, err = groupsAPI.ReadByDisplayName(this.DisplayName, groupAttributes)
+			}
 			if err != nil {
 				return err
 			}
@@ -79,6 +89,7 @@ func DataSourceGroup() common.Resource {
 					}
 				}
 			}
+			this.DisplayName = group.DisplayName
 			this.ExternalID = group.ExternalID
 			this.AclPrincipalID = fmt.Sprintf("groups/%s", group.DisplayName)
 			sort.Strings(this.Groups)
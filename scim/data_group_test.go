@@ -104,3 +104,100 @@ func TestDataSourceGroup(t *testing.T) {
 	assertContains(t, d.Get("service_principals"), "1113")
 	assertContains(t, d.Get("child_groups"), "1114")
 }
+
+func TestDataSourceGroup_ExternalID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: `/api/2.0/preview/scim/v2/Groups?filter=externalId%20eq%20%22e1%22`,
+				Response: GroupList{
+					Resources: []Group{
+						{
+							DisplayName: "ds",
+							ID:          "eerste",
+							ExternalID:  "e1",
+							Members: []ComplexValue{
+								{
+									Ref:   "Users/1112",
+									Value: "1112",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL:         `external_id = "e1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "eerste",
+		"display_name":     "ds",
+		"external_id":      "e1",
+		"acl_principal_id": "groups/ds",
+		"users":            []string{"1112"},
+	})
+}
+
+func TestDataSourceGroup_ExternalIDNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: `/api/2.0/preview/scim/v2/Groups?filter=externalId%20eq%20%22e1%22`,
+				Response: GroupList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL:         `external_id = "e1"`,
+	}.ExpectError(t, "cannot find group with external id: e1")
+}
+
+func TestDataSourceGroup_ExternalIDAmbiguous(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: `/api/2.0/preview/scim/v2/Groups?filter=externalId%20eq%20%22e1%22`,
+				Response: GroupList{
+					Resources: []Group{
+						{
+							DisplayName: "a",
+							ID:          "1",
+							ExternalID:  "e1",
+						},
+						{
+							DisplayName: "b",
+							ID:          "2",
+							ExternalID:  "e1",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL:         `external_id = "e1"`,
+	}.ExpectError(t, "there are 2 groups with external id: e1")
+}
+
+func TestDataSourceGroup_DisplayNameAndExternalID(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL: `
+		display_name = "ds"
+		external_id = "e1"
+		`,
+	}.ExpectError(t, "invalid config supplied. [display_name] Invalid combination of arguments. [external_id] Invalid combination of arguments")
+}
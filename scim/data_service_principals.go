@@ -3,37 +3,155 @@ package scim
 import (
 	"context"
 	"fmt"
+	"path"
 	"sort"
+	"strings"
 
+	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type servicePrincipalData struct {
+	SpID           string `json:"sp_id,omitempty" tf:"computed"`
+	ApplicationID  string `json:"application_id,omitempty" tf:"computed"`
+	DisplayName    string `json:"display_name,omitempty" tf:"computed"`
+	Active         bool   `json:"active,omitempty" tf:"computed"`
+	ExternalID     string `json:"external_id,omitempty" tf:"computed"`
+	Home           string `json:"home,omitempty" tf:"computed"`
+	AclPrincipalID string `json:"acl_principal_id,omitempty" tf:"computed"`
+}
+
+// servicePrincipalManager is the role, that Databricks assigns to the creator of the service principal
+const servicePrincipalManager = "roles/servicePrincipal.manager"
+
+// isManagedBy checks that the user or service principal has the manager role on the service principal, which is
+// assigned to its creator, as the SCIM API doesn't return the creator
+func isManagedBy(ctx context.Context, c *common.DatabricksClient, applicationID, principal string) (bool, error) {
+	request := iam.GetRuleSetRequest{
+		Name: fmt.Sprintf("accounts/%s/servicePrincipals/%s/ruleSets/default", c.Config.AccountID, applicationID),
+	}
+	var ruleSet *iam.RuleSetResponse
+	if c.Config.IsAccountClient() {
+		a, err := c.AccountClient()
+		if err != nil {
+			return false, err
+		}
+		ruleSet, err = a.AccessControl.GetRuleSet(ctx, request)
+		if err != nil {
+			return false, err
+		}
+	} else {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return false, err
+		}
+		ruleSet, err = w.AccountAccessControlProxy.GetRuleSet(ctx, request)
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, rule := range ruleSet.GrantRules {
+		if rule.Role != servicePrincipalManager {
+			continue
+		}
+		for _, p := range rule.Principals {
+			if p == "users/"+principal || p == "servicePrincipals/"+principal {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// describeServicePrincipalFilters lists the filters, that are set, for the error about missing service principals
+func describeServicePrincipalFilters(filters map[string]string) string {
+	described := []string{}
+	for name, value := range filters {
+		if value != "" {
+			described = append(described, fmt.Sprintf("%s = %s", name, value))
+		}
+	}
+	if len(described) == 0 {
+		return "in the workspace"
+	}
+	sort.Strings(described)
+	return "matching " + strings.Join(described, ", ")
+}
+
 // DataSourceServicePrincipals searches for service principals based on display_name
 func DataSourceServicePrincipals() common.Resource {
 	type spnsData struct {
-		DisplayNameContains string   `json:"display_name_contains,omitempty" tf:"computed"`
-		ApplicationIDs      []string `json:"application_ids,omitempty" tf:"computed,slice_set"`
+		DisplayNameContains string                 `json:"display_name_contains,omitempty" tf:"computed"`
+		DisplayNameGlob     string                 `json:"display_name_glob,omitempty"`
+		ApplicationID       string                 `json:"application_id,omitempty"`
+		CreatedBy           string                 `json:"created_by,omitempty"`
+		ApplicationIDs      []string               `json:"application_ids,omitempty" tf:"computed,slice_set"`
+		ServicePrincipals   []servicePrincipalData `json:"service_principals,omitempty" tf:"computed"`
 	}
 	return common.DataResource(spnsData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		response := e.(*spnsData)
 		spnAPI := NewServicePrincipalsAPI(ctx, c)
 
-		var filter string
-
+		if response.DisplayNameGlob != "" {
+			if _, err := path.Match(response.DisplayNameGlob, ""); err != nil {
+				return fmt.Errorf("invalid display_name_glob %s: %w", response.DisplayNameGlob, err)
+			}
+		}
+		if response.CreatedBy != "" && c.Config.AccountID == "" {
+			return fmt.Errorf("account_id must be set in the provider configuration to filter by created_by")
+		}
+		filters := []string{}
 		if response.DisplayNameContains != "" {
-			filter = fmt.Sprintf(`displayName co "%s"`, response.DisplayNameContains)
+			filters = append(filters, fmt.Sprintf(`displayName co "%s"`, response.DisplayNameContains))
 		}
-		spList, err := spnAPI.Filter(filter, true)
+		if response.ApplicationID != "" {
+			filters = append(filters, fmt.Sprintf(`applicationId eq "%s"`, response.ApplicationID))
+		}
+		spList, err := spnAPI.Filter(strings.Join(filters, " and "), true)
 		if err != nil {
 			return err
 		}
-		if len(spList) == 0 {
-			return fmt.Errorf("cannot find SPs with display name containing %s", response.DisplayNameContains)
-		}
+		response.ApplicationIDs = []string{}
+		response.ServicePrincipals = []servicePrincipalData{}
 		for _, sp := range spList {
+			if response.DisplayNameGlob != "" {
+				// pattern is validated above, so the error could be ignored
+				if matched, _ := path.Match(response.DisplayNameGlob, sp.DisplayName); !matched {
+					continue
+				}
+			}
+			if response.CreatedBy != "" {
+				managed, err := isManagedBy(ctx, c, sp.ApplicationID, response.CreatedBy)
+				if err != nil {
+					return fmt.Errorf("cannot read roles of service principal %s: %w", sp.ApplicationID, err)
+				}
+				if !managed {
+					continue
+				}
+			}
 			response.ApplicationIDs = append(response.ApplicationIDs, sp.ApplicationID)
+			response.ServicePrincipals = append(response.ServicePrincipals, servicePrincipalData{
+				SpID:           sp.ID,
+				ApplicationID:  sp.ApplicationID,
+				DisplayName:    sp.DisplayName,
+				Active:         sp.Active,
+				ExternalID:     sp.ExternalID,
+				Home:           fmt.Sprintf("/Users/%s", sp.ApplicationID),
+				AclPrincipalID: fmt.Sprintf("servicePrincipals/%s", sp.ApplicationID),
+			})
+		}
+		if len(response.ApplicationIDs) == 0 {
+			return fmt.Errorf("cannot find service principals %s", describeServicePrincipalFilters(map[string]string{
+				"display_name_contains": response.DisplayNameContains,
+				"display_name_glob":     response.DisplayNameGlob,
+				"application_id":        response.ApplicationID,
+				"created_by":            response.CreatedBy,
+			}))
 		}
 		sort.Strings(response.ApplicationIDs)
+		sort.Slice(response.ServicePrincipals, func(i, j int) bool {
+			return response.ServicePrincipals[i].ApplicationID < response.ServicePrincipals[j].ApplicationID
+		})
 		return nil
 	})
 }
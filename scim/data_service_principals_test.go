@@ -3,6 +3,7 @@ package scim
 import (
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +60,79 @@ func TestDataServicePrincipalsReadNotFound(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestDataServicePrincipalsReadNotFoundError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%20eq%20%22123%22",
+				Response: UserList{},
+			},
+		},
+		Resource: DataSourceServicePrincipals(),
+		HCL: `
+		application_id    = "123"
+		display_name_glob = "automation-*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "cannot find service principals matching application_id = 123, display_name_glob = automation-*")
+}
+
+func TestDataServicePrincipalsReadByCreatedBy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/xyz/scim/v2/ServicePrincipals?excludedAttributes=roles",
+				Response: UserList{
+					Resources: []User{
+						{ID: "abc1", DisplayName: "ci", ApplicationID: "123"},
+						{ID: "abc2", DisplayName: "other", ApplicationID: "124"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/accounts/xyz/access-control/rule-sets?etag=&name=accounts%2Fxyz%2FservicePrincipals%2F123%2FruleSets%2Fdefault",
+				Response: iam.RuleSetResponse{
+					GrantRules: []iam.GrantRule{
+						{Role: "roles/servicePrincipal.manager", Principals: []string{"users/me@example.com"}},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/accounts/xyz/access-control/rule-sets?etag=&name=accounts%2Fxyz%2FservicePrincipals%2F124%2FruleSets%2Fdefault",
+				Response: iam.RuleSetResponse{
+					GrantRules: []iam.GrantRule{
+						{Role: "roles/servicePrincipal.user", Principals: []string{"users/me@example.com"}},
+						{Role: "roles/servicePrincipal.manager", Principals: []string{"users/other@example.com"}},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceServicePrincipals(),
+		AccountID:   "xyz",
+		HCL:         `created_by = "me@example.com"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"application_ids": []string{"123"},
+	})
+}
+
+func TestDataServicePrincipalsReadByCreatedByWithoutAccountID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceServicePrincipals(),
+		HCL:         `created_by = "me@example.com"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "account_id must be set in the provider configuration to filter by created_by")
+}
+
 func TestDataServicePrincipalsReadNoFilter(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -110,3 +184,91 @@ func TestDataServicePrincipalsReadError(t *testing.T) {
 	}.Apply(t)
 	require.Error(t, err)
 }
+
+func TestDataServicePrincipalsReadByGlobAndApplicationID(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%20eq%20%22123%22",
+				Response: UserList{
+					Resources: []User{
+						{
+							ID:            "abc1",
+							DisplayName:   "automation-etl",
+							Active:        true,
+							ApplicationID: "123",
+							ExternalID:    "ext-1",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceServicePrincipals(),
+		HCL:         `application_id = "123"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"application_ids":                       []string{"123"},
+		"service_principals.#":                  1,
+		"service_principals.0.sp_id":            "abc1",
+		"service_principals.0.display_name":     "automation-etl",
+		"service_principals.0.external_id":      "ext-1",
+		"service_principals.0.acl_principal_id": "servicePrincipals/123",
+	})
+}
+
+func TestDataServicePrincipalsReadByGlob(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles",
+				Response: UserList{
+					Resources: []User{
+						{
+							ID:            "abc1",
+							DisplayName:   "automation-etl",
+							Active:        true,
+							ApplicationID: "124",
+						},
+						{
+							ID:            "abc2",
+							DisplayName:   "human-like",
+							Active:        true,
+							ApplicationID: "125",
+						},
+						{
+							ID:            "abc3",
+							DisplayName:   "automation-ml",
+							ApplicationID: "123",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceServicePrincipals(),
+		HCL:         `display_name_glob = "automation-*"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"application_ids":                   []string{"123", "124"},
+		"service_principals.#":              2,
+		"service_principals.0.display_name": "automation-ml",
+		"service_principals.0.active":       false,
+		"service_principals.1.display_name": "automation-etl",
+		"service_principals.1.active":       true,
+	})
+}
+
+func TestDataServicePrincipalsReadInvalidGlob(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceServicePrincipals(),
+		HCL:         `display_name_glob = "automation-["`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "invalid display_name_glob automation-[: syntax error in pattern")
+}
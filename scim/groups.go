@@ -63,6 +63,23 @@ func (a GroupsAPI) ReadByDisplayName(displayName, attributes string) (group Grou
 	return
 }
 
+// ReadByExternalID returns the only group with the given ID in an external identity provider
+func (a GroupsAPI) ReadByExternalID(externalID string) (group Group, err error) {
+	groupList, err := a.Filter(fmt.Sprintf(`externalId eq "%s"`, externalID))
+	if err != nil {
+		return
+	}
+	switch len(groupList.Resources) {
+	case 0:
+		err = fmt.Errorf("cannot find group with external id: %s", externalID)
+	case 1:
+		group = groupList.Resources[0]
+	default:
+		err = fmt.Errorf("there are %d groups with external id: %s", len(groupList.Resources), externalID)
+	}
+	return
+}
+
 func (a GroupsAPI) Patch(groupID string, r patchRequest) error {
 	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
 }
@@ -0,0 +1,145 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// listSecretAcls returns permissions of all principals on the secret scope
+func listSecretAcls(ctx context.Context, w *databricks.WorkspaceClient, scope string) (map[string]string, error) {
+	items, err := w.Secrets.ListAclsAll(ctx, workspace.ListAclsRequest{Scope: scope})
+	if err != nil {
+		return nil, err
+	}
+	acls := make(map[string]string, len(items))
+	for _, item := range items {
+		acls[item.Principal] = item.Permission.String()
+	}
+	return acls, nil
+}
+
+// applySecretAcls changes only the ACLs that differ from the desired ones and removes ACLs of
+// principals that aren't in the desired map
+func applySecretAcls(ctx context.Context, w *databricks.WorkspaceClient, scope string, desired map[string]any) error {
+	current, err := listSecretAcls(ctx, w, scope)
+	if err != nil {
+		return err
+	}
+	principals := make([]string, 0, len(desired))
+	for principal := range desired {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+	for _, principal := range principals {
+		permission := desired[principal].(string)
+		if current[principal] == permission {
+			continue
+		}
+		err = w.Secrets.PutAcl(ctx, workspace.PutAcl{
+			Scope:      scope,
+			Principal:  principal,
+			Permission: workspace.AclPermission(permission),
+		})
+		if err != nil {
+			return fmt.Errorf("cannot set %s permission for %s: %w", permission, principal, err)
+		}
+	}
+	removed := []string{}
+	for principal := range current {
+		if _, ok := desired[principal]; !ok {
+			removed = append(removed, principal)
+		}
+	}
+	sort.Strings(removed)
+	for _, principal := range removed {
+		err = w.Secrets.DeleteAcl(ctx, workspace.DeleteAcl{
+			Scope:     scope,
+			Principal: principal,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot remove permission of %s: %w", principal, err)
+		}
+	}
+	return nil
+}
+
+// ResourceSecretACLs authoritatively manages all ACLs on a secret scope
+func ResourceSecretACLs() common.Resource {
+	s := map[string]*schema.Schema{
+		"scope": {
+			Type:         schema.TypeString,
+			ValidateFunc: validScope,
+			Required:     true,
+			ForceNew:     true,
+		},
+		"acls": {
+			Type:     schema.TypeMap,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			ValidateDiagFunc: validation.MapValueMatch(regexp.MustCompile(`^(READ|WRITE|MANAGE)$`),
+				"permission must be one of READ, WRITE or MANAGE"),
+		},
+	}
+	createOrUpdate := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		scope := d.Get("scope").(string)
+		err = applySecretAcls(ctx, w, scope, d.Get("acls").(map[string]any))
+		if err != nil {
+			return err
+		}
+		d.SetId(scope)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: createOrUpdate,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			acls, err := listSecretAcls(ctx, w, d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("scope", d.Id())
+			return d.Set("acls", acls)
+		},
+		Update: createOrUpdate,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			for principal := range d.Get("acls").(map[string]any) {
+				err = w.Secrets.DeleteAcl(ctx, workspace.DeleteAcl{
+					Scope:     d.Id(),
+					Principal: principal,
+				})
+				if apierr.IsMissing(err) {
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("cannot remove permission of %s: %w", principal, err)
+				}
+			}
+			return nil
+		},
+	}
+}
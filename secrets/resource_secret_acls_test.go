@@ -0,0 +1,159 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestResourceSecretACLsCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/acls/list?scope=global",
+				Response: workspace.ListAclsResponse{
+					Items: []workspace.AclItem{
+						{Principal: "admin@example.com", Permission: "MANAGE"},
+						{Principal: "readers", Permission: "WRITE"},
+						{Principal: "old", Permission: "READ"},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/put",
+				ExpectedRequest: workspace.PutAcl{
+					Scope:      "global",
+					Principal:  "readers",
+					Permission: "READ",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/put",
+				ExpectedRequest: workspace.PutAcl{
+					Scope:      "global",
+					Principal:  "writers",
+					Permission: "WRITE",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: workspace.DeleteAcl{
+					Scope:     "global",
+					Principal: "old",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/acls/list?scope=global",
+				Response: workspace.ListAclsResponse{
+					Items: []workspace.AclItem{
+						{Principal: "admin@example.com", Permission: "MANAGE"},
+						{Principal: "readers", Permission: "READ"},
+						{Principal: "writers", Permission: "WRITE"},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretACLs(),
+		Create:   true,
+		HCL: `
+		scope = "global"
+		acls = {
+			"admin@example.com" = "MANAGE"
+			"readers"           = "READ"
+			"writers"           = "WRITE"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":     "global",
+		"acls.%": "3",
+	})
+}
+
+func TestResourceSecretACLsRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/acls/list?scope=global",
+				Response: workspace.ListAclsResponse{
+					Items: []workspace.AclItem{
+						{Principal: "readers", Permission: "MANAGE"},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretACLs(),
+		Read:     true,
+		New:      true,
+		ID:       "global",
+	}.ApplyAndExpectData(t, map[string]any{
+		"scope":        "global",
+		"acls.readers": "MANAGE",
+	})
+}
+
+func TestResourceSecretACLsRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/acls/list?scope=global",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Scope global does not exist!",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceSecretACLs(),
+		Read:     true,
+		Removed:  true,
+		ID:       "global",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSecretACLsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: workspace.DeleteAcl{
+					Scope:     "global",
+					Principal: "readers",
+				},
+				Status: 404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Principal readers does not exist!",
+				},
+			},
+		},
+		Resource: ResourceSecretACLs(),
+		Delete:   true,
+		ID:       "global",
+		InstanceState: map[string]string{
+			"scope":        "global",
+			"acls.%":       "1",
+			"acls.readers": "READ",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceSecretACLs_InvalidPermission(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecretACLs(),
+		Create:   true,
+		HCL: `
+		scope = "global"
+		acls = {
+			"readers" = "OWNER"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [acls.#] Invalid map value")
+}
@@ -2,10 +2,11 @@ package serving
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
-	"github.com/databricks/databricks-sdk-go/retries"
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,68 @@ import (
 const DefaultProvisionTimeout = 45 * time.Minute
 const deleteCallTimeout = 10 * time.Second
 
+// waitForServingEndpoint waits until the configuration of the endpoint is rolled out and reports how many served
+// entities of the pending configuration are ready as progress
+func waitForServingEndpoint(ctx context.Context, w *databricks.WorkspaceClient, name string,
+	timeout time.Duration) (*serving.ServingEndpointDetailed, error) {
+	var endpoint *serving.ServingEndpointDetailed
+	err := common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
+		var err error
+		endpoint, err = w.ServingEndpoints.GetByName(ctx, name)
+		if err != nil {
+			return common.Progress{}, err
+		}
+		if endpoint.State == nil {
+			return common.InProgress("serving endpoint %s state is not available yet", name), nil
+		}
+		switch endpoint.State.ConfigUpdate {
+		case serving.EndpointStateConfigUpdateNotUpdating:
+			return common.Completed(), nil
+		case serving.EndpointStateConfigUpdateUpdateFailed, serving.EndpointStateConfigUpdateUpdateCanceled:
+			return common.Progress{}, fmt.Errorf("failed to reach %s, got %s: current status: %s",
+				serving.EndpointStateConfigUpdateNotUpdating, endpoint.State.ConfigUpdate, endpoint.State.ConfigUpdate)
+		}
+		return servingEndpointRollout(endpoint), nil
+	})
+	return endpoint, err
+}
+
+func servingEndpointRollout(endpoint *serving.ServingEndpointDetailed) common.Progress {
+	progress := common.InProgress("serving endpoint %s is updating its config", endpoint.Name)
+	if endpoint.PendingConfig == nil {
+		return progress
+	}
+	states := []*serving.ServedModelState{}
+	for _, se := range endpoint.PendingConfig.ServedEntities {
+		states = append(states, se.State)
+	}
+	for _, sm := range endpoint.PendingConfig.ServedModels {
+		states = append(states, sm.State)
+	}
+	if len(states) == 0 {
+		return progress
+	}
+	ready := 0
+	message := ""
+	for _, state := range states {
+		if state == nil {
+			continue
+		}
+		if state.Deployment == serving.ServedModelStateDeploymentReady {
+			ready++
+		} else if message == "" {
+			message = state.DeploymentStateMessage
+		}
+	}
+	progress.Message = fmt.Sprintf("serving endpoint %s is rolling out config version %d: %d of %d served entities are ready",
+		endpoint.Name, endpoint.PendingConfig.ConfigVersion, ready, len(states))
+	if message != "" {
+		progress.Message += ", " + message
+	}
+	progress.Percent = float64(100 * ready / len(states))
+	return progress
+}
+
 func ResourceModelServing() common.Resource {
 	s := common.StructToSchema(
 		serving.CreateServingEndpoint{},
@@ -55,11 +118,11 @@ func ResourceModelServing() common.Resource {
 			}
 			var e serving.CreateServingEndpoint
 			common.DataToStructPointer(d, s, &e)
-			wait, err := w.ServingEndpoints.Create(ctx, e)
+			_, err = w.ServingEndpoints.Create(ctx, e)
 			if err != nil {
 				return err
 			}
-			endpoint, err := wait.GetWithTimeout(d.Timeout(schema.TimeoutCreate) - deleteCallTimeout)
+			endpoint, err := waitForServingEndpoint(ctx, w, e.Name, d.Timeout(schema.TimeoutCreate)-deleteCallTimeout)
 			if err != nil {
 				log.Printf("[ERROR] Error waiting for serving endpoint to be created: %s", err.Error())
 				nestedErr := w.ServingEndpoints.DeleteByName(ctx, e.Name)
@@ -108,7 +171,11 @@ func ResourceModelServing() common.Resource {
 			var e serving.CreateServingEndpoint
 			common.DataToStructPointer(d, s, &e)
 			e.Config.Name = e.Name
-			_, err = w.ServingEndpoints.UpdateConfigAndWait(ctx, e.Config, retries.Timeout[serving.ServingEndpointDetailed](d.Timeout(schema.TimeoutUpdate)))
+			_, err = w.ServingEndpoints.UpdateConfig(ctx, e.Config)
+			if err != nil {
+				return err
+			}
+			_, err = waitForServingEndpoint(ctx, w, e.Name, d.Timeout(schema.TimeoutUpdate))
 			return err
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
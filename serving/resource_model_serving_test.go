@@ -7,6 +7,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestModelServingCornerCases(t *testing.T) {
@@ -632,3 +633,27 @@ func TestModelServingDelete_Error(t *testing.T) {
 		ID:       "test-endpoint",
 	}.ExpectError(t, "Internal error happened")
 }
+
+func TestServingEndpointRollout(t *testing.T) {
+	progress := servingEndpointRollout(&serving.ServingEndpointDetailed{
+		Name: "test-endpoint",
+		PendingConfig: &serving.EndpointPendingConfig{
+			ConfigVersion: 2,
+			ServedEntities: []serving.ServedEntityOutput{
+				{
+					State: &serving.ServedModelState{
+						Deployment: serving.ServedModelStateDeploymentReady,
+					},
+				},
+				{
+					State: &serving.ServedModelState{
+						Deployment:             serving.ServedModelStateDeploymentCreating,
+						DeploymentStateMessage: "Creating container image",
+					},
+				},
+			},
+		},
+	})
+	assert.Equal(t, "serving endpoint test-endpoint is rolling out config version 2: "+
+		"1 of 2 served entities are ready, Creating container image (50%)", progress.String())
+}
@@ -20,5 +20,6 @@ func AllSettingsResources() map[string]common.Resource {
 		"compliance_security_profile_workspace":  makeSettingResource[settings.ComplianceSecurityProfileSetting, *databricks.WorkspaceClient](complianceSecurityProfileSetting),
 		"enhanced_security_monitoring_workspace": makeSettingResource[settings.EnhancedSecurityMonitoringSetting, *databricks.WorkspaceClient](enhancedSecurityMonitoringSetting),
 		"automatic_cluster_update_workspace":     makeSettingResource[settings.AutomaticClusterUpdateSetting, *databricks.WorkspaceClient](automaticClusterUpdateSetting),
+		"disable_legacy_dbfs":                    makeSettingResource[DisableLegacyDbfsSetting, *databricks.WorkspaceClient](disableLegacyDbfsSetting),
 	}
 }
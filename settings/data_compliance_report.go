@@ -0,0 +1,141 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// ComplianceReport aggregates security posture facts of a workspace
+type ComplianceReport struct {
+	TokensEnabled                        bool     `json:"tokens_enabled" tf:"computed"`
+	MaxTokenLifetimeDays                 int      `json:"max_token_lifetime_days" tf:"computed"`
+	IpAccessListsEnabled                 bool     `json:"ip_access_lists_enabled" tf:"computed"`
+	IpAccessListsCount                   int      `json:"ip_access_lists_count" tf:"computed"`
+	EnhancedSecurityMonitoringEnabled    bool     `json:"enhanced_security_monitoring_enabled" tf:"computed"`
+	ComplianceSecurityProfileEnabled     bool     `json:"compliance_security_profile_enabled" tf:"computed"`
+	ComplianceStandards                  []string `json:"compliance_standards,omitempty" tf:"computed"`
+	RestrictWorkspaceAdmins              string   `json:"restrict_workspace_admins" tf:"computed"`
+	LegacyDbfsDisabled                   bool     `json:"legacy_dbfs_disabled" tf:"computed"`
+	LegacyGlobalInitScriptsEnabled       bool     `json:"legacy_global_init_scripts_enabled" tf:"computed"`
+	LegacyClusterNamedInitScriptsEnabled bool     `json:"legacy_cluster_named_init_scripts_enabled" tf:"computed"`
+	AdminsCount                          int      `json:"admins_count" tf:"computed"`
+}
+
+// workspace configuration keys, that are included in the compliance report
+const (
+	tokensEnabledConfKey                 = "enableTokensConfig"
+	maxTokenLifetimeDaysConfKey          = "maxTokenLifetimeDays"
+	ipAccessListsEnabledConfKey          = "enableIpAccessLists"
+	legacyGlobalInitScriptsConfKey       = "enableDeprecatedGlobalInitScripts"
+	legacyClusterNamedInitScriptsConfKey = "enableDeprecatedClusterNamedInitScripts"
+	complianceReportConfKeys             = tokensEnabledConfKey + "," + maxTokenLifetimeDaysConfKey + "," +
+		ipAccessListsEnabledConfKey + "," + legacyGlobalInitScriptsConfKey + "," + legacyClusterNamedInitScriptsConfKey
+)
+
+// confBool returns the value of the boolean workspace configuration key, or the default, if the key was never set
+func confBool(conf map[string]string, key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(conf[key])
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// readSetting returns nil, if the setting was never changed from its default value
+func readSetting[T any](ctx context.Context, w *databricks.WorkspaceClient, s workspaceSetting[T]) (*T, error) {
+	setting, err := s.readFunc(ctx, w, "")
+	if apierr.IsMissing(err) {
+		return nil, nil
+	}
+	return setting, err
+}
+
+func (r *ComplianceReport) readWorkspaceConf(ctx context.Context, w *databricks.WorkspaceClient) error {
+	conf, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+		Keys: complianceReportConfKeys,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot read workspace configuration: %w", err)
+	}
+	r.TokensEnabled = confBool(*conf, tokensEnabledConfKey, true)
+	if days, err := strconv.Atoi((*conf)[maxTokenLifetimeDaysConfKey]); err == nil {
+		r.MaxTokenLifetimeDays = days
+	}
+	r.IpAccessListsEnabled = confBool(*conf, ipAccessListsEnabledConfKey, false)
+	r.LegacyGlobalInitScriptsEnabled = confBool(*conf, legacyGlobalInitScriptsConfKey, false)
+	r.LegacyClusterNamedInitScriptsEnabled = confBool(*conf, legacyClusterNamedInitScriptsConfKey, false)
+	return nil
+}
+
+func (r *ComplianceReport) readSettings(ctx context.Context, w *databricks.WorkspaceClient) error {
+	esm, err := readSetting(ctx, w, enhancedSecurityMonitoringSetting)
+	if err != nil {
+		return fmt.Errorf("cannot read enhanced security monitoring setting: %w", err)
+	}
+	if esm != nil {
+		r.EnhancedSecurityMonitoringEnabled = esm.EnhancedSecurityMonitoringWorkspace.IsEnabled
+	}
+	csp, err := readSetting(ctx, w, complianceSecurityProfileSetting)
+	if err != nil {
+		return fmt.Errorf("cannot read compliance security profile setting: %w", err)
+	}
+	if csp != nil {
+		r.ComplianceSecurityProfileEnabled = csp.ComplianceSecurityProfileWorkspace.IsEnabled
+		for _, standard := range csp.ComplianceSecurityProfileWorkspace.ComplianceStandards {
+			r.ComplianceStandards = append(r.ComplianceStandards, string(standard))
+		}
+	}
+	r.RestrictWorkspaceAdmins = string(settings.RestrictWorkspaceAdminsMessageStatusAllowAll)
+	restrictAdmins, err := readSetting(ctx, w, restrictWsAdminsSetting)
+	if err != nil {
+		return fmt.Errorf("cannot read restrict workspace admins setting: %w", err)
+	}
+	if restrictAdmins != nil {
+		r.RestrictWorkspaceAdmins = string(restrictAdmins.RestrictWorkspaceAdmins.Status)
+	}
+	legacyDbfs, err := readSetting(ctx, w, disableLegacyDbfsSetting)
+	if err != nil {
+		return fmt.Errorf("cannot read disable legacy DBFS setting: %w", err)
+	}
+	if legacyDbfs != nil {
+		r.LegacyDbfsDisabled = legacyDbfs.DisableLegacyDbfs.Value
+	}
+	return nil
+}
+
+// DataSourceComplianceReport returns security posture facts of the workspace, so that compliance of many workspaces
+// could be checked with Terraform outputs
+func DataSourceComplianceReport() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, r *ComplianceReport, w *databricks.WorkspaceClient) error {
+		if err := r.readWorkspaceConf(ctx, w); err != nil {
+			return err
+		}
+		ipAccessLists, err := w.IpAccessLists.ListAll(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot list IP access lists: %w", err)
+		}
+		r.IpAccessListsCount = len(ipAccessLists)
+		if err = r.readSettings(ctx, w); err != nil {
+			return err
+		}
+		admins, err := w.Groups.ListAll(ctx, iam.ListGroupsRequest{
+			Filter:     `displayName eq "admins"`,
+			Attributes: "members",
+		})
+		if err != nil {
+			return fmt.Errorf("cannot read admins group: %w", err)
+		}
+		r.AdminsCount = 0
+		for _, group := range admins {
+			r.AdminsCount += len(group.Members)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,190 @@
+package settings
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var complianceReportConfFixture = qa.HTTPFixture{
+	Method:   http.MethodGet,
+	Resource: "/api/2.0/workspace-conf?keys=enableTokensConfig%2CmaxTokenLifetimeDays%2CenableIpAccessLists%2CenableDeprecatedGlobalInitScripts%2CenableDeprecatedClusterNamedInitScripts",
+	Response: map[string]string{
+		"enableTokensConfig":                      "true",
+		"maxTokenLifetimeDays":                    "90",
+		"enableIpAccessLists":                     "true",
+		"enableDeprecatedGlobalInitScripts":       "false",
+		"enableDeprecatedClusterNamedInitScripts": "",
+	},
+}
+
+var complianceReportAdminsFixtures = []qa.HTTPFixture{
+	{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/preview/scim/v2/Groups?attributes=members&count=100&filter=displayName+eq+%22admins%22&startIndex=1",
+		Response: iam.ListGroupsResponse{
+			Resources: []iam.Group{
+				{
+					DisplayName: "admins",
+					Members: []iam.ComplexValue{
+						{Value: "1"},
+						{Value: "2"},
+					},
+				},
+			},
+			StartIndex:   1,
+			TotalResults: 1,
+		},
+	},
+	{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/preview/scim/v2/Groups?attributes=members&count=100&filter=displayName+eq+%22admins%22&startIndex=2",
+		Response: iam.ListGroupsResponse{
+			StartIndex:   2,
+			TotalResults: 1,
+		},
+	},
+}
+
+func TestDataSourceComplianceReport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			complianceReportConfFixture,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{ListId: "1"},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ws_db/names/default?",
+				Response: settings.EnhancedSecurityMonitoringSetting{
+					EnhancedSecurityMonitoringWorkspace: settings.EnhancedSecurityMonitoring{
+						IsEnabled: true,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/shield_csp_enablement_ws_db/names/default?",
+				Response: settings.ComplianceSecurityProfileSetting{
+					ComplianceSecurityProfileWorkspace: settings.ComplianceSecurityProfile{
+						IsEnabled:           true,
+						ComplianceStandards: []settings.ComplianceStandard{settings.ComplianceStandardHipaa},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/restrict_workspace_admins/names/default?",
+				Response: settings.RestrictWorkspaceAdminsSetting{
+					RestrictWorkspaceAdmins: settings.RestrictWorkspaceAdminsMessage{
+						Status: settings.RestrictWorkspaceAdminsMessageStatusRestrictTokensAndJobRunAs,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: disableLegacyDbfsPath + "?",
+				Response: DisableLegacyDbfsSetting{
+					DisableLegacyDbfs: DisableLegacyDbfs{Value: true},
+				},
+			},
+		}, complianceReportAdminsFixtures...),
+		Resource:    DataSourceComplianceReport(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"tokens_enabled":                            true,
+		"max_token_lifetime_days":                   90,
+		"ip_access_lists_enabled":                   true,
+		"ip_access_lists_count":                     1,
+		"enhanced_security_monitoring_enabled":      true,
+		"compliance_security_profile_enabled":       true,
+		"compliance_standards":                      []any{"HIPAA"},
+		"restrict_workspace_admins":                 "RESTRICT_TOKENS_AND_JOB_RUN_AS",
+		"legacy_dbfs_disabled":                      true,
+		"legacy_global_init_scripts_enabled":        false,
+		"legacy_cluster_named_init_scripts_enabled": false,
+		"admins_count":                              2,
+	})
+}
+
+func TestDataSourceComplianceReport_DefaultSettings(t *testing.T) {
+	notFound := apierr.APIError{
+		ErrorCode:  "NOT_FOUND",
+		StatusCode: 404,
+		Message:    "setting doesn't exist",
+	}
+	qa.ResourceFixture{
+		Fixtures: append([]qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: complianceReportConfFixture.Resource,
+				Response: map[string]string{},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ws_db/names/default?",
+				Response: notFound,
+				Status:   404,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/shield_csp_enablement_ws_db/names/default?",
+				Response: notFound,
+				Status:   404,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/settings/types/restrict_workspace_admins/names/default?",
+				Response: notFound,
+				Status:   404,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: disableLegacyDbfsPath + "?",
+				Response: notFound,
+				Status:   404,
+			},
+		}, complianceReportAdminsFixtures...),
+		Resource:    DataSourceComplianceReport(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"tokens_enabled":                       true,
+		"max_token_lifetime_days":              0,
+		"ip_access_lists_enabled":              false,
+		"ip_access_lists_count":                0,
+		"enhanced_security_monitoring_enabled": false,
+		"compliance_security_profile_enabled":  false,
+		"restrict_workspace_admins":            "ALLOW_ALL",
+		"legacy_dbfs_disabled":                 false,
+		"admins_count":                         2,
+	})
+}
+
+func TestDataSourceComplianceReport_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceComplianceReport(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "cannot read workspace configuration: i'm a teapot")
+}
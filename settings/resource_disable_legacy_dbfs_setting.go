@@ -0,0 +1,84 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/client"
+)
+
+// DisableLegacyDbfs isn't available in the Go SDK yet, so the setting is defined here
+type DisableLegacyDbfs struct {
+	Value bool `json:"value"`
+}
+
+type DisableLegacyDbfsSetting struct {
+	DisableLegacyDbfs DisableLegacyDbfs `json:"disable_legacy_dbfs"`
+	Etag              string            `json:"etag,omitempty"`
+	SettingName       string            `json:"setting_name,omitempty"`
+}
+
+type disableLegacyDbfsUpdateRequest struct {
+	AllowMissing bool                     `json:"allow_missing"`
+	FieldMask    string                   `json:"field_mask"`
+	Setting      DisableLegacyDbfsSetting `json:"setting"`
+}
+
+type disableLegacyDbfsEtagRequest struct {
+	Etag string `json:"-" url:"etag,omitempty"`
+}
+
+type disableLegacyDbfsDeleteResponse struct {
+	Etag string `json:"etag"`
+}
+
+const disableLegacyDbfsPath = "/api/2.0/settings/types/disable_legacy_dbfs/names/default"
+
+func disableLegacyDbfsDo(ctx context.Context, w *databricks.WorkspaceClient, method string, request, response any) error {
+	c, err := client.New(w.Config)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{
+		"Accept": "application/json",
+	}
+	if method == http.MethodPatch {
+		headers["Content-Type"] = "application/json"
+	}
+	return c.Do(ctx, method, disableLegacyDbfsPath, headers, request, response)
+}
+
+// Disable Legacy DBFS setting, that disables browsing of DBFS root and mounts in the workspace UI
+var disableLegacyDbfsSetting = workspaceSetting[DisableLegacyDbfsSetting]{
+	settingStruct: DisableLegacyDbfsSetting{},
+	readFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (*DisableLegacyDbfsSetting, error) {
+		var res DisableLegacyDbfsSetting
+		err := disableLegacyDbfsDo(ctx, w, http.MethodGet, disableLegacyDbfsEtagRequest{Etag: etag}, &res)
+		if err != nil {
+			return nil, err
+		}
+		return &res, nil
+	},
+	updateFunc: func(ctx context.Context, w *databricks.WorkspaceClient, t DisableLegacyDbfsSetting) (string, error) {
+		t.SettingName = "default"
+		var res DisableLegacyDbfsSetting
+		err := disableLegacyDbfsDo(ctx, w, http.MethodPatch, disableLegacyDbfsUpdateRequest{
+			AllowMissing: true,
+			Setting:      t,
+			FieldMask:    "disable_legacy_dbfs.value",
+		}, &res)
+		if err != nil {
+			return "", err
+		}
+		return res.Etag, err
+	},
+	deleteFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (string, error) {
+		var res disableLegacyDbfsDeleteResponse
+		err := disableLegacyDbfsDo(ctx, w, http.MethodDelete, disableLegacyDbfsEtagRequest{Etag: etag}, &res)
+		if err != nil {
+			return "", err
+		}
+		return res.Etag, err
+	},
+}
@@ -0,0 +1,80 @@
+package settings
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDisableLegacyDbfsSetting = AllSettingsResources()["disable_legacy_dbfs"]
+
+func TestDisableLegacyDbfsSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: disableLegacyDbfsPath,
+				ExpectedRequest: disableLegacyDbfsUpdateRequest{
+					AllowMissing: true,
+					FieldMask:    "disable_legacy_dbfs.value",
+					Setting: DisableLegacyDbfsSetting{
+						DisableLegacyDbfs: DisableLegacyDbfs{Value: true},
+						SettingName:       "default",
+					},
+				},
+				Response: DisableLegacyDbfsSetting{
+					DisableLegacyDbfs: DisableLegacyDbfs{Value: true},
+					Etag:              "etag1",
+					SettingName:       "default",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: disableLegacyDbfsPath + "?etag=etag1",
+				Response: DisableLegacyDbfsSetting{
+					DisableLegacyDbfs: DisableLegacyDbfs{Value: true},
+					Etag:              "etag2",
+					SettingName:       "default",
+				},
+			},
+		},
+		Resource: testDisableLegacyDbfsSetting,
+		Create:   true,
+		HCL: `
+			disable_legacy_dbfs {
+				value = true
+			}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSettingId, d.Id())
+	assert.Equal(t, "etag2", d.Get(etagAttrName).(string))
+	assert.Equal(t, true, d.Get("disable_legacy_dbfs.0.value"))
+}
+
+func TestDisableLegacyDbfsSettingDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: disableLegacyDbfsPath + "?etag=etag1",
+				Response: disableLegacyDbfsDeleteResponse{
+					Etag: "etag2",
+				},
+			},
+		},
+		Resource: testDisableLegacyDbfsSetting,
+		Delete:   true,
+		HCL: `
+			disable_legacy_dbfs {
+				value = true
+			}
+			etag = "etag1"
+		`,
+		ID: defaultSettingId,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSettingId, d.Id())
+}
@@ -0,0 +1,88 @@
+package sharing
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ShareDynamicView is a view, that is created by the provider and added to the share. It returns only the rows
+// of the source table, where the column matches the property of the recipient, who queries the share.
+type ShareDynamicView struct {
+	// Name is the full name of the view, e.g. main.shared.sales_by_region
+	Name string `json:"name"`
+	// SourceTable is the full name of the table, which rows are filtered
+	SourceTable string `json:"source_table"`
+	// Column is compared with the value of the recipient property
+	Column string `json:"column"`
+	// RecipientPropertyKey is the key of the property of databricks_recipient, e.g. country
+	RecipientPropertyKey string `json:"recipient_property_key"`
+	SharedAs             string `json:"shared_as,omitempty"`
+	// WarehouseID is the SQL warehouse, that executes CREATE VIEW and DROP VIEW statements
+	WarehouseID string `json:"warehouse_id"`
+}
+
+// dynamicViewsTimeout bounds CREATE VIEW and DROP VIEW statements, including the start of the SQL warehouse
+const dynamicViewsTimeout = 20 * time.Minute
+
+var validateThreeLevelName = validation.StringMatch(regexp.MustCompile(`^[^.]+\.[^.]+\.[^.]+$`),
+	"must be a full name in form of <catalog>.<schema>.<name>")
+
+func (dv ShareDynamicView) createStatement() string {
+	return fmt.Sprintf("CREATE OR REPLACE VIEW %s AS SELECT * FROM %s WHERE %s = CURRENT_RECIPIENT(%s)",
+		catalog.QuoteSQLFullName(dv.Name), catalog.QuoteSQLFullName(dv.SourceTable),
+		catalog.QuoteSQLIdentifier(dv.Column), catalog.QuoteSQLString(dv.RecipientPropertyKey))
+}
+
+func (dv ShareDynamicView) dropStatement() string {
+	return fmt.Sprintf("DROP VIEW IF EXISTS %s", catalog.QuoteSQLFullName(dv.Name))
+}
+
+func (dv ShareDynamicView) dataObject() sharing.SharedDataObject {
+	return sharing.SharedDataObject{
+		Name:           dv.Name,
+		DataObjectType: "VIEW",
+		SharedAs:       dv.SharedAs,
+	}
+}
+
+// createDynamicViews creates new views and replaces views, which definition has changed
+func createDynamicViews(ctx context.Context, w *databricks.WorkspaceClient, before, after []ShareDynamicView) error {
+	existing := make(map[string]ShareDynamicView, len(before))
+	for _, dv := range before {
+		existing[dv.Name] = dv
+	}
+	for _, dv := range after {
+		old, ok := existing[dv.Name]
+		if ok && old.createStatement() == dv.createStatement() {
+			continue
+		}
+		if err := catalog.ExecuteWarehouseStatement(ctx, w, dv.WarehouseID, dv.createStatement()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropDynamicViews drops views, that are no longer shared
+func dropDynamicViews(ctx context.Context, w *databricks.WorkspaceClient, before, after []ShareDynamicView) error {
+	remaining := make(map[string]bool, len(after))
+	for _, dv := range after {
+		remaining[dv.Name] = true
+	}
+	for _, dv := range before {
+		if remaining[dv.Name] {
+			continue
+		}
+		if err := catalog.ExecuteWarehouseStatement(ctx, w, dv.WarehouseID, dv.dropStatement()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
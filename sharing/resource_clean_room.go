@@ -0,0 +1,145 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type CleanRoomCollaborator struct {
+	// GlobalMetastoreID is the sharing identifier of the collaborator in form of <cloud>:<region>:<metastore-uuid>
+	GlobalMetastoreID string `json:"global_metastore_id"`
+	OrganizationName  string `json:"organization_name,omitempty" tf:"computed"`
+}
+
+type CleanRoomInfo struct {
+	Name    string `json:"name" tf:"force_new"`
+	Comment string `json:"comment,omitempty"`
+	Owner   string `json:"owner,omitempty" tf:"computed"`
+	// Collaborators could only be set when the clean room is created
+	Collaborators []CleanRoomCollaborator `json:"collaborators" tf:"alias:collaborator,force_new"`
+	StationCloud  string                  `json:"station_cloud,omitempty" tf:"force_new,computed"`
+	StationRegion string                  `json:"station_region,omitempty" tf:"force_new,computed"`
+	CreatedAt     int64                   `json:"created_at,omitempty" tf:"computed"`
+	CreatedBy     string                  `json:"created_by,omitempty" tf:"computed"`
+}
+
+// collaboratorsFrom returns collaborators of the clean room, except for its creator, which is added implicitly
+func collaboratorsFrom(remote *sharing.CentralCleanRoomInfo) (collaborators []CleanRoomCollaborator) {
+	if remote == nil {
+		return nil
+	}
+	for _, c := range remote.Collaborators {
+		if remote.Creator != nil && c.GlobalMetastoreId == remote.Creator.GlobalMetastoreId {
+			continue
+		}
+		collaborators = append(collaborators, CleanRoomCollaborator{
+			GlobalMetastoreID: c.GlobalMetastoreId,
+			OrganizationName:  c.OrganizationName,
+		})
+	}
+	return collaborators
+}
+
+func ResourceCleanRoom() common.Resource {
+	s := common.StructToSchema(CleanRoomInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+		common.CustomizeSchemaPath(m, "collaborator").SetMinItems(1)
+		common.CustomizeSchemaPath(m, "collaborator", "global_metastore_id").SetForceNew()
+		common.CustomizeSchemaPath(m, "collaborator", "organization_name").SetForceNew()
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var cr CleanRoomInfo
+			common.DataToStructPointer(d, s, &cr)
+			remote := sharing.CentralCleanRoomInfo{
+				StationCloud:  cr.StationCloud,
+				StationRegion: cr.StationRegion,
+			}
+			for _, collaborator := range cr.Collaborators {
+				remote.Collaborators = append(remote.Collaborators, sharing.CleanRoomCollaboratorInfo{
+					GlobalMetastoreId: collaborator.GlobalMetastoreID,
+					OrganizationName:  collaborator.OrganizationName,
+				})
+			}
+			created, err := w.CleanRooms.Create(ctx, sharing.CreateCleanRoom{
+				Name:               cr.Name,
+				Comment:            cr.Comment,
+				RemoteDetailedInfo: remote,
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(created.Name)
+			if cr.Owner == "" {
+				return nil
+			}
+			_, err = w.CleanRooms.Update(ctx, sharing.UpdateCleanRoom{
+				Name:  created.Name,
+				Owner: cr.Owner,
+			})
+			return err
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			info, err := w.CleanRooms.Get(ctx, sharing.GetCleanRoomRequest{
+				Name:                 d.Id(),
+				IncludeRemoteDetails: true,
+			})
+			if err != nil {
+				return err
+			}
+			var cr CleanRoomInfo
+			common.DataToStructPointer(d, s, &cr)
+			cr.Name = info.Name
+			cr.Comment = info.Comment
+			cr.Owner = info.Owner
+			cr.CreatedAt = info.CreatedAt
+			cr.CreatedBy = info.CreatedBy
+			if info.RemoteDetailedInfo != nil {
+				cr.StationCloud = info.RemoteDetailedInfo.StationCloud
+				cr.StationRegion = info.RemoteDetailedInfo.StationRegion
+			}
+			// configured collaborators are kept, as the clean room also lists its creator and collaborators,
+			// that joined it later, so they are only read on import
+			if len(cr.Collaborators) == 0 {
+				cr.Collaborators = collaboratorsFrom(info.RemoteDetailedInfo)
+			}
+			return common.StructToData(cr, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			update := sharing.UpdateCleanRoom{
+				Name:            d.Id(),
+				Comment:         d.Get("comment").(string),
+				ForceSendFields: []string{"Comment"},
+			}
+			if d.HasChange("owner") {
+				update.Owner = d.Get("owner").(string)
+			}
+			_, err = w.CleanRooms.Update(ctx, update)
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.CleanRooms.DeleteByName(ctx, d.Id())
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package sharing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// CleanRoomAsset is a table or a notebook, that is shared by the current collaborator with the clean room
+type CleanRoomAsset struct {
+	CleanRoom string `json:"clean_room" tf:"force_new"`
+	// Name is the full name of the table, or the name of the notebook
+	Name           string `json:"name" tf:"force_new"`
+	DataObjectType string `json:"data_object_type" tf:"force_new"`
+	// CatalogName is the alias of the catalog in the clean room station. It's empty for notebooks.
+	CatalogName string `json:"catalog_name,omitempty" tf:"force_new"`
+	// Content is the base64 representation of the notebook in HTML. It isn't returned by the API.
+	Content string `json:"content,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+func (a CleanRoomAsset) validate() error {
+	switch a.DataObjectType {
+	case "TABLE":
+		if a.CatalogName == "" {
+			return fmt.Errorf("catalog_name is required for TABLE assets")
+		}
+		if a.Content != "" {
+			return fmt.Errorf("content is only supported for NOTEBOOK_FILE assets")
+		}
+	case "NOTEBOOK_FILE":
+		if a.CatalogName != "" {
+			return fmt.Errorf("catalog_name isn't supported for NOTEBOOK_FILE assets")
+		}
+		if a.Content == "" {
+			return fmt.Errorf("content is required for NOTEBOOK_FILE assets")
+		}
+	}
+	return nil
+}
+
+func (a CleanRoomAsset) update(action sharing.SharedDataObjectUpdateAction) sharing.UpdateCleanRoom {
+	return sharing.UpdateCleanRoom{
+		Name: a.CleanRoom,
+		CatalogUpdates: []sharing.CleanRoomCatalogUpdate{
+			{
+				CatalogName: a.CatalogName,
+				Updates: &sharing.SharedDataObjectUpdate{
+					Action: action,
+					DataObject: &sharing.SharedDataObject{
+						Name:           a.Name,
+						DataObjectType: sharing.SharedDataObjectDataObjectType(a.DataObjectType),
+						Content:        a.Content,
+						Comment:        a.Comment,
+					},
+				},
+			},
+		},
+	}
+}
+
+// setFrom finds the asset among local catalogs of the clean room
+func (a *CleanRoomAsset) setFrom(info *sharing.CleanRoomInfo) error {
+	for _, catalog := range info.LocalCatalogs {
+		for _, objects := range [][]sharing.SharedDataObject{catalog.Tables, catalog.NotebookFiles} {
+			for _, object := range objects {
+				if object.Name != a.Name {
+					continue
+				}
+				a.CatalogName = catalog.CatalogName
+				a.DataObjectType = string(object.DataObjectType)
+				a.Comment = object.Comment
+				return nil
+			}
+		}
+	}
+	return apierr.NotFound(fmt.Sprintf("%s isn't shared with clean room %s", a.Name, a.CleanRoom))
+}
+
+func ResourceCleanRoomAsset() common.Resource {
+	s := common.StructToSchema(CleanRoomAsset{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "data_object_type").SetValidateFunc(
+			validation.StringInSlice([]string{"TABLE", "NOTEBOOK_FILE"}, false))
+		common.CustomizeSchemaPath(m, "content").SetSensitive()
+		return m
+	})
+	p := common.NewPairID("clean_room", "name")
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var a CleanRoomAsset
+			common.DiffToStructPointer(d, s, &a)
+			return a.validate()
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var a CleanRoomAsset
+			common.DataToStructPointer(d, s, &a)
+			_, err = w.CleanRooms.Update(ctx, a.update(sharing.SharedDataObjectUpdateActionAdd))
+			if err != nil {
+				return err
+			}
+			p.Pack(d)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			cleanRoom, name, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			info, err := w.CleanRooms.GetByName(ctx, cleanRoom)
+			if err != nil {
+				return err
+			}
+			var a CleanRoomAsset
+			common.DataToStructPointer(d, s, &a)
+			a.CleanRoom = cleanRoom
+			a.Name = name
+			if err = a.setFrom(info); err != nil {
+				return err
+			}
+			return common.StructToData(a, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var a CleanRoomAsset
+			common.DataToStructPointer(d, s, &a)
+			_, err = w.CleanRooms.Update(ctx, a.update(sharing.SharedDataObjectUpdateActionUpdate))
+			return err
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var a CleanRoomAsset
+			common.DataToStructPointer(d, s, &a)
+			// the content isn't needed to remove the notebook
+			a.Content = ""
+			_, err = w.CleanRooms.Update(ctx, a.update(sharing.SharedDataObjectUpdateActionRemove))
+			return err
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var getCleanRoomWithAssets = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.1/unity-catalog/clean-rooms/ads?",
+	Response: sharing.CleanRoomInfo{
+		Name: "ads",
+		LocalCatalogs: []sharing.CleanRoomCatalog{
+			{
+				CatalogName: "shared_sales",
+				Tables: []sharing.SharedDataObject{
+					{
+						Name:           "main.sales.orders",
+						DataObjectType: "TABLE",
+						Comment:        "orders of the last year",
+					},
+				},
+			},
+			{
+				NotebookFiles: []sharing.SharedDataObject{
+					{
+						Name:           "overlap",
+						DataObjectType: "NOTEBOOK_FILE",
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestCleanRoomAssetCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCleanRoomAsset(), qa.CornerCaseID("ads|main.sales.orders"))
+}
+
+func TestCreateCleanRoomAssetTable(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/clean-rooms/ads",
+				ExpectedRequest: sharing.UpdateCleanRoom{
+					CatalogUpdates: []sharing.CleanRoomCatalogUpdate{
+						{
+							CatalogName: "shared_sales",
+							Updates: &sharing.SharedDataObjectUpdate{
+								Action: "ADD",
+								DataObject: &sharing.SharedDataObject{
+									Name:           "main.sales.orders",
+									DataObjectType: "TABLE",
+									Comment:        "orders of the last year",
+								},
+							},
+						},
+					},
+				},
+				Response: sharing.CleanRoomInfo{
+					Name: "ads",
+				},
+			},
+			getCleanRoomWithAssets,
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Create:   true,
+		HCL: `
+		clean_room       = "ads"
+		name             = "main.sales.orders"
+		data_object_type = "TABLE"
+		catalog_name     = "shared_sales"
+		comment          = "orders of the last year"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "ads|main.sales.orders",
+		"catalog_name": "shared_sales",
+	})
+}
+
+func TestReadCleanRoomAssetNotebookKeepsContent(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			getCleanRoomWithAssets,
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Read:     true,
+		ID:       "ads|overlap",
+		InstanceState: map[string]string{
+			"clean_room":       "ads",
+			"name":             "overlap",
+			"data_object_type": "NOTEBOOK_FILE",
+			"content":          "PGh0bWw+",
+		},
+		HCL: `
+		clean_room       = "ads"
+		name             = "overlap"
+		data_object_type = "NOTEBOOK_FILE"
+		content          = "PGh0bWw+"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"data_object_type": "NOTEBOOK_FILE",
+		"catalog_name":     "",
+		"content":          "PGh0bWw+",
+	})
+}
+
+func TestReadCleanRoomAssetRemoved(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			getCleanRoomWithAssets,
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Read:     true,
+		Removed:  true,
+		New:      true,
+		ID:       "ads|main.sales.customers",
+	}.ApplyNoError(t)
+}
+
+func TestDeleteCleanRoomAssetNotebook(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/clean-rooms/ads",
+				ExpectedRequest: sharing.UpdateCleanRoom{
+					CatalogUpdates: []sharing.CleanRoomCatalogUpdate{
+						{
+							Updates: &sharing.SharedDataObjectUpdate{
+								Action: "REMOVE",
+								DataObject: &sharing.SharedDataObject{
+									Name:           "overlap",
+									DataObjectType: "NOTEBOOK_FILE",
+								},
+							},
+						},
+					},
+				},
+				Response: sharing.CleanRoomInfo{
+					Name: "ads",
+				},
+			},
+		},
+		Resource: ResourceCleanRoomAsset(),
+		Delete:   true,
+		ID:       "ads|overlap",
+		HCL: `
+		clean_room       = "ads"
+		name             = "overlap"
+		data_object_type = "NOTEBOOK_FILE"
+		content          = "PGh0bWw+"`,
+	}.ApplyNoError(t)
+}
+
+func TestCleanRoomAssetTableRequiresCatalog(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCleanRoomAsset(),
+		Create:   true,
+		HCL: `
+		clean_room       = "ads"
+		name             = "main.sales.orders"
+		data_object_type = "TABLE"`,
+	}.ExpectError(t, "catalog_name is required for TABLE assets")
+}
+
+func TestCleanRoomAssetNotebookRequiresContent(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCleanRoomAsset(),
+		Create:   true,
+		HCL: `
+		clean_room       = "ads"
+		name             = "overlap"
+		data_object_type = "NOTEBOOK_FILE"`,
+	}.ExpectError(t, "content is required for NOTEBOOK_FILE assets")
+}
@@ -0,0 +1,159 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+var getCleanRoomWithRemoteDetails = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.1/unity-catalog/clean-rooms/ads?include_remote_details=true",
+	Response: sharing.CleanRoomInfo{
+		Name:      "ads",
+		Comment:   "joint campaign analysis",
+		Owner:     "analysts",
+		CreatedBy: "someone@example.com",
+		RemoteDetailedInfo: &sharing.CentralCleanRoomInfo{
+			StationCloud:  "aws",
+			StationRegion: "us-west-2",
+			Creator: &sharing.CleanRoomCollaboratorInfo{
+				GlobalMetastoreId: "aws:us-west-2:ours",
+				OrganizationName:  "Us",
+			},
+			Collaborators: []sharing.CleanRoomCollaboratorInfo{
+				{
+					GlobalMetastoreId: "aws:us-west-2:ours",
+					OrganizationName:  "Us",
+				},
+				{
+					GlobalMetastoreId: "aws:us-east-1:theirs",
+					OrganizationName:  "Partner",
+				},
+			},
+		},
+	},
+}
+
+func TestCleanRoomCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCleanRoom())
+}
+
+func TestCreateCleanRoom(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/clean-rooms",
+				ExpectedRequest: sharing.CreateCleanRoom{
+					Name:    "ads",
+					Comment: "joint campaign analysis",
+					RemoteDetailedInfo: sharing.CentralCleanRoomInfo{
+						Collaborators: []sharing.CleanRoomCollaboratorInfo{
+							{
+								GlobalMetastoreId: "aws:us-east-1:theirs",
+							},
+						},
+					},
+				},
+				Response: sharing.CleanRoomInfo{
+					Name: "ads",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/clean-rooms/ads",
+				ExpectedRequest: sharing.UpdateCleanRoom{
+					Owner: "analysts",
+				},
+				Response: sharing.CleanRoomInfo{
+					Name: "ads",
+				},
+			},
+			getCleanRoomWithRemoteDetails,
+		},
+		Resource: ResourceCleanRoom(),
+		Create:   true,
+		HCL: `
+		name    = "ads"
+		comment = "joint campaign analysis"
+		owner   = "analysts"
+		collaborator {
+			global_metastore_id = "aws:us-east-1:theirs"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                 "ads",
+		"station_cloud":                      "aws",
+		"station_region":                     "us-west-2",
+		"collaborator.#":                     1,
+		"collaborator.0.global_metastore_id": "aws:us-east-1:theirs",
+	})
+}
+
+func TestReadCleanRoomOnImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			getCleanRoomWithRemoteDetails,
+		},
+		Resource: ResourceCleanRoom(),
+		Read:     true,
+		New:      true,
+		ID:       "ads",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":                               "ads",
+		"owner":                              "analysts",
+		"collaborator.#":                     1,
+		"collaborator.0.global_metastore_id": "aws:us-east-1:theirs",
+		"collaborator.0.organization_name":   "Partner",
+	})
+}
+
+func TestUpdateCleanRoomComment(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/clean-rooms/ads",
+				ExpectedRequest: sharing.UpdateCleanRoom{
+					Comment:         "",
+					ForceSendFields: []string{"Comment"},
+				},
+				Response: sharing.CleanRoomInfo{
+					Name: "ads",
+				},
+			},
+			getCleanRoomWithRemoteDetails,
+		},
+		Resource: ResourceCleanRoom(),
+		Update:   true,
+		ID:       "ads",
+		InstanceState: map[string]string{
+			"name":                               "ads",
+			"comment":                            "joint campaign analysis",
+			"owner":                              "analysts",
+			"collaborator.#":                     "1",
+			"collaborator.0.global_metastore_id": "aws:us-east-1:theirs",
+		},
+		HCL: `
+		name  = "ads"
+		owner = "analysts"
+		collaborator {
+			global_metastore_id = "aws:us-east-1:theirs"
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestDeleteCleanRoom(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/clean-rooms/ads?",
+			},
+		},
+		Resource: ResourceCleanRoom(),
+		Delete:   true,
+		ID:       "ads",
+	}.ApplyNoError(t)
+}
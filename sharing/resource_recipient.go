@@ -2,6 +2,7 @@ package sharing
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -42,6 +43,21 @@ func ResourceRecipient() common.Resource {
 		for _, path := range []string{"id", "created_at", "created_by", "activation_url", "expiration_time", "updated_at", "updated_by"} {
 			common.CustomizeSchemaPath(s, "tokens", path).SetReadOnly()
 		}
+		// activation URLs allow to download credentials of the recipient, so they are kept out of the plan output
+		common.CustomizeSchemaPath(s, "activation_url").SetSensitive()
+		common.CustomizeSchemaPath(s, "tokens", "activation_url").SetSensitive()
+
+		// the token of the recipient is rotated every time the value of the trigger changes
+		s["token_rotation_trigger"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		s["token_rotation_expire_in_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
 
 		return s
 	})
@@ -99,10 +115,24 @@ func ResourceRecipient() common.Resource {
 				}
 			}
 
-			if !d.HasChangeExcept("owner") {
+			if d.HasChange("token_rotation_trigger") {
+				_, err = w.Recipients.RotateToken(ctx, sharing.RotateRecipientToken{
+					Name:                         updateRecipientRequest.Name,
+					ExistingTokenExpireInSeconds: int64(d.Get("token_rotation_expire_in_seconds").(int)),
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if !d.HasChangesExcept("owner", "token_rotation_trigger", "token_rotation_expire_in_seconds") {
 				return nil
 			}
 
+			if d.HasChange("ip_access_list") && updateRecipientRequest.IpAccessList == nil {
+				// an empty list removes all IP restrictions of the recipient
+				updateRecipientRequest.IpAccessList = &sharing.IpAccessList{}
+			}
 			updateRecipientRequest.Owner = ""
 			err = w.Recipients.Update(ctx, updateRecipientRequest)
 			if err != nil {
@@ -121,6 +151,21 @@ func ResourceRecipient() common.Resource {
 			}
 			return nil
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if d.Get("token_rotation_trigger").(string) != "" && d.Get("authentication_type").(string) != "TOKEN" {
+				return fmt.Errorf("token_rotation_trigger could only be used with authentication_type = \"TOKEN\"")
+			}
+			if d.Id() == "" || !d.HasChange("token_rotation_trigger") {
+				return nil
+			}
+			// rotation issues a new token, so existing tokens and the activation URL change
+			for _, key := range []string{"tokens", "activation_url", "activated"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -241,6 +241,111 @@ func TestUpdateRecipientFieldsOtherThanOwner(t *testing.T) {
 	assert.Equal(t, "administrators", d.Get("owner"))
 }
 
+func TestUpdateRecipientRotateToken(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/recipients/a/rotate-token",
+				ExpectedRequest: sharing.RotateRecipientToken{
+					ExistingTokenExpireInSeconds: 3600,
+				},
+				Response: sharing.RecipientInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/recipients/a?",
+				Response: sharing.RecipientInfo{
+					Name:               "a",
+					AuthenticationType: "TOKEN",
+					Owner:              "administrators",
+					ActivationUrl:      "https://a/new",
+					Tokens: []sharing.RecipientTokenInfo{
+						{
+							Id:            "t2",
+							ActivationUrl: "https://a/new",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                             "a",
+			"authentication_type":              "TOKEN",
+			"owner":                            "administrators",
+			"activation_url":                   "https://a/old",
+			"token_rotation_trigger":           "2024-01",
+			"token_rotation_expire_in_seconds": "3600",
+		},
+		HCL: `
+		name = "a"
+		authentication_type = "TOKEN"
+		token_rotation_trigger = "2024-02"
+		token_rotation_expire_in_seconds = 3600
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"activation_url":         "https://a/new",
+		"tokens.0.id":            "t2",
+		"token_rotation_trigger": "2024-02",
+	})
+}
+
+func TestRecipientRotateTokenRequiresTokenAuthentication(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		authentication_type = "DATABRICKS"
+		data_recipient_global_metastore_id = "aws:us-west-2:abc"
+		token_rotation_trigger = "2024-02"
+		`,
+	}.ExpectError(t, `token_rotation_trigger could only be used with authentication_type = "TOKEN"`)
+}
+
+func TestUpdateRecipientRemoveIpAccessList(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.1/unity-catalog/recipients/a",
+				ExpectedRequest: map[string]any{
+					"ip_access_list": map[string]any{},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/recipients/a?",
+				Response: sharing.RecipientInfo{
+					Name:               "a",
+					AuthenticationType: "TOKEN",
+					Owner:              "administrators",
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                "a",
+			"authentication_type": "TOKEN",
+			"owner":               "administrators",
+			"ip_access_list.#":    "1",
+			"ip_access_list.0.allowed_ip_addresses.#": "1",
+			"ip_access_list.0.allowed_ip_addresses.0": "10.0.0.0/16",
+		},
+		HCL: `
+		name = "a"
+		authentication_type = "TOKEN"
+		`,
+	}.ApplyNoError(t)
+}
+
 func TestUpdateRecipientOnlyOwner(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
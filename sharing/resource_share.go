@@ -2,17 +2,21 @@ package sharing
 
 import (
 	"context"
-
+	"errors"
+	"fmt"
 	"reflect"
 	"sort"
 
 	"github.com/databricks/databricks-sdk-go/service/sharing"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type ShareInfo struct {
 	sharing.ShareInfo
+	// DynamicViews are created by the provider and shared together with objects
+	DynamicViews []ShareDynamicView `json:"dynamic_views,omitempty"`
 }
 
 func (ShareInfo) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
@@ -27,7 +31,13 @@ func (ShareInfo) CustomizeSchema(s *common.CustomizableSchema) *common.Customiza
 
 	s.SchemaPath("object").SetMinItems(1)
 	s.SchemaPath("object", "data_object_type").SetRequired()
+	s.SchemaPath("object", "data_object_type").SetValidateFunc(validation.StringInSlice(sharedDataObjectTypes, false))
+	s.SchemaPath("object", "history_data_sharing_status").SetValidateFunc(
+		validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false))
+	s.SchemaPath("object", "partition", "value", "op").SetValidateFunc(
+		validation.StringInSlice([]string{"EQUAL", "LIKE"}, false))
 	s.SchemaPath("object", "shared_as").SetSuppressDiff()
+	s.SchemaPath("object", "string_shared_as").SetSuppressDiff()
 	s.SchemaPath("object", "cdf_enabled").SetSuppressDiff()
 	s.SchemaPath("object", "start_version").SetSuppressDiff()
 	s.SchemaPath("object", "history_data_sharing_status").SetSuppressDiff()
@@ -37,13 +47,17 @@ func (ShareInfo) CustomizeSchema(s *common.CustomizableSchema) *common.Customiza
 	s.SchemaPath("object", "partition", "value", "op").SetRequired()
 	s.SchemaPath("object", "partition", "value", "name").SetRequired()
 
+	s.SchemaPath("dynamic_view", "name").SetValidateFunc(validateThreeLevelName)
+	s.SchemaPath("dynamic_view", "source_table").SetValidateFunc(validateThreeLevelName)
+
 	return s
 }
 
 func (ShareInfo) Aliases() map[string]map[string]string {
 	return map[string]map[string]string{
 		"sharing.ShareInfo": {
-			"objects": "object",
+			"objects":       "object",
+			"dynamic_views": "dynamic_view",
 		},
 		"sharing.SharedDataObject": {
 			"partitions": "partition",
@@ -54,6 +68,88 @@ func (ShareInfo) Aliases() map[string]map[string]string {
 	}
 }
 
+// sharedDataObjectTypes are the types of objects, that could be added to a share
+var sharedDataObjectTypes = []string{
+	"TABLE", "VIEW", "MATERIALIZED_VIEW", "STREAMING_TABLE", "SCHEMA", "NOTEBOOK_FILE", "VOLUME", "MODEL",
+}
+
+// tableLikeObjectTypes are the types of objects, which data is shared with partitions, history or change data feed
+var tableLikeObjectTypes = map[string]bool{
+	"TABLE":             true,
+	"VIEW":              true,
+	"MATERIALIZED_VIEW": true,
+	"STREAMING_TABLE":   true,
+}
+
+// validateSharedObject checks, that only the attributes, which are supported by the type of the object, are set
+func validateSharedObject(i int, obj sharing.SharedDataObject) error {
+	objectType := string(obj.DataObjectType)
+	tableLike := tableLikeObjectTypes[objectType]
+	historyEnabled := obj.HistoryDataSharingStatus == sharing.SharedDataObjectHistoryDataSharingStatusEnabled
+	switch {
+	case len(obj.Partitions) > 0 && objectType != "TABLE":
+		return fmt.Errorf("object.%d.partition could only be used with TABLE objects", i)
+	case (obj.CdfEnabled || obj.HistoryDataSharingStatus != "") && !tableLike && objectType != "SCHEMA":
+		return fmt.Errorf("object.%d cdf_enabled and history_data_sharing_status could only be used with tables, views and schemas", i)
+	case obj.CdfEnabled && historyEnabled:
+		return fmt.Errorf("object.%d cdf_enabled can't be used together with history_data_sharing_status = \"ENABLED\"", i)
+	case obj.StartVersion != 0 && !obj.CdfEnabled && !historyEnabled:
+		return fmt.Errorf("object.%d.start_version requires cdf_enabled or history_data_sharing_status = \"ENABLED\"", i)
+	case obj.Content != "" && objectType != "NOTEBOOK_FILE":
+		return fmt.Errorf("object.%d.content could only be used with NOTEBOOK_FILE objects", i)
+	case obj.SharedAs != "" && !tableLike && objectType != "SCHEMA":
+		return fmt.Errorf("object.%d.shared_as could only be used with tables, views and schemas, use string_shared_as instead", i)
+	case obj.StringSharedAs != "" && (tableLike || objectType == "SCHEMA"):
+		return fmt.Errorf("object.%d.string_shared_as could only be used with notebooks, volumes and models, use shared_as instead", i)
+	}
+	for j, partition := range obj.Partitions {
+		for k, value := range partition.Values {
+			if value.Value != "" && value.RecipientPropertyKey != "" {
+				return fmt.Errorf("object.%d.partition.%d.value.%d can't have both value and recipient_property_key", i, j, k)
+			}
+		}
+	}
+	return nil
+}
+
+func (si ShareInfo) validateObjects() error {
+	var errs []error
+	for i, obj := range si.Objects {
+		if err := validateSharedObject(i, obj); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// withContentsFrom sets contents of notebook files, which aren't returned by the API, from the given objects
+func (si *ShareInfo) withContentsFrom(objects []sharing.SharedDataObject) {
+	contents := make(map[string]string, len(objects))
+	for _, obj := range objects {
+		contents[obj.Name] = obj.Content
+	}
+	for i := range si.Objects {
+		if si.Objects[i].Content == "" {
+			si.Objects[i].Content = contents[si.Objects[i].Name]
+		}
+	}
+}
+
+func objectsFromState(raw any) []sharing.SharedDataObject {
+	var objects []sharing.SharedDataObject
+	for _, v := range raw.([]any) {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		objects = append(objects, sharing.SharedDataObject{
+			Name:    m["name"].(string),
+			Content: m["content"].(string),
+		})
+	}
+	return objects
+}
+
 type Shares struct {
 	Shares []ShareInfo `json:"shares"`
 }
@@ -73,6 +169,54 @@ func (si *ShareInfo) suppressCDFEnabledDiff() {
 	}
 }
 
+// withDynamicViews adds views, that are created by the provider, to objects of the share
+func (si ShareInfo) withDynamicViews() ShareInfo {
+	objects := append([]sharing.SharedDataObject{}, si.Objects...)
+	for _, dv := range si.DynamicViews {
+		objects = append(objects, dv.dataObject())
+	}
+	si.Objects = objects
+	return si
+}
+
+// withoutDynamicViews removes views, that are created by the provider, from objects of the share,
+// so that they don't appear as a drift of object blocks
+func (si *ShareInfo) withoutDynamicViews(dynamicViews []ShareDynamicView) {
+	if len(dynamicViews) == 0 {
+		return
+	}
+	names := make(map[string]bool, len(dynamicViews))
+	for _, dv := range dynamicViews {
+		names[dv.Name] = true
+	}
+	objects := []sharing.SharedDataObject{}
+	for _, obj := range si.Objects {
+		if !names[obj.Name] {
+			objects = append(objects, obj)
+		}
+	}
+	si.Objects = objects
+}
+
+func dynamicViewsFromState(raw any) []ShareDynamicView {
+	var dynamicViews []ShareDynamicView
+	for _, v := range raw.([]any) {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		dynamicViews = append(dynamicViews, ShareDynamicView{
+			Name:                 m["name"].(string),
+			SourceTable:          m["source_table"].(string),
+			Column:               m["column"].(string),
+			RecipientPropertyKey: m["recipient_property_key"].(string),
+			SharedAs:             m["shared_as"].(string),
+			WarehouseID:          m["warehouse_id"].(string),
+		})
+	}
+	return dynamicViews
+}
+
 func (si ShareInfo) shareChanges(action string) sharing.UpdateShare {
 	var changes []sharing.SharedDataObjectUpdate
 	for _, obj := range si.Objects {
@@ -99,6 +243,9 @@ func Equal(this sharing.SharedDataObject, other sharing.SharedDataObject) bool {
 	if other.SharedAs == "" {
 		other.SharedAs = this.SharedAs
 	}
+	if other.StringSharedAs == "" {
+		other.StringSharedAs = this.StringSharedAs
+	}
 	//don't compare computed fields
 	other.AddedAt = this.AddedAt
 	other.AddedBy = this.AddedBy
@@ -131,6 +278,7 @@ func (beforeSi ShareInfo) Diff(afterSi ShareInfo) []sharing.SharedDataObjectUpda
 			if !Equal(beforeSdo, afterSdo) {
 				// do not send SharedAs
 				afterSdo.SharedAs = ""
+				afterSdo.StringSharedAs = ""
 				changes = append(changes, sharing.SharedDataObjectUpdate{
 					Action:     sharing.SharedDataObjectUpdateActionUpdate,
 					DataObject: &afterSdo,
@@ -156,6 +304,12 @@ func ResourceShare() common.Resource {
 				return err
 			}
 
+			var si ShareInfo
+			common.DataToStructPointer(d, shareSchema, &si)
+			if err := createDynamicViews(ctx, w, nil, si.DynamicViews); err != nil {
+				return err
+			}
+
 			var createRequest sharing.CreateShare
 			common.DataToStructPointer(d, shareSchema, &createRequest)
 			if _, err := w.Shares.Create(ctx, createRequest); err != nil {
@@ -163,9 +317,7 @@ func ResourceShare() common.Resource {
 			}
 
 			//can only create empty share, objects & owners have to be added using update API
-			var si ShareInfo
-			common.DataToStructPointer(d, shareSchema, &si)
-			shareChanges := si.shareChanges(string(sharing.SharedDataObjectUpdateActionAdd))
+			shareChanges := si.withDynamicViews().shareChanges(string(sharing.SharedDataObjectUpdateActionAdd))
 			shareChanges.Name = si.Name
 			shareChanges.Owner = si.Owner
 			if _, err := w.Shares.Update(ctx, shareChanges); err != nil {
@@ -188,12 +340,14 @@ func ResourceShare() common.Resource {
 				Name:              d.Id(),
 				IncludeSharedData: true,
 			})
-			var si = ShareInfo{*shareInfo}
-			si.sortSharesByName()
-			si.suppressCDFEnabledDiff()
 			if err != nil {
 				return err
 			}
+			var si = ShareInfo{ShareInfo: *shareInfo}
+			si.sortSharesByName()
+			si.suppressCDFEnabledDiff()
+			si.withoutDynamicViews(dynamicViewsFromState(d.Get("dynamic_view")))
+			si.withContentsFrom(objectsFromState(d.Get("object")))
 
 			return common.StructToData(si, shareSchema, d)
 		},
@@ -211,12 +365,19 @@ func ResourceShare() common.Resource {
 				return err
 			}
 
-			var beforeSi = ShareInfo{*si}
+			var beforeSi = ShareInfo{ShareInfo: *si}
 			beforeSi.sortSharesByName()
 			beforeSi.suppressCDFEnabledDiff()
+			oldObjects, _ := d.GetChange("object")
+			beforeSi.withContentsFrom(objectsFromState(oldObjects))
 			var afterSi ShareInfo
 			common.DataToStructPointer(d, shareSchema, &afterSi)
-			changes := beforeSi.Diff(afterSi)
+			oldDynamicViews, _ := d.GetChange("dynamic_view")
+			beforeDynamicViews := dynamicViewsFromState(oldDynamicViews)
+			if err = createDynamicViews(ctx, client, beforeDynamicViews, afterSi.DynamicViews); err != nil {
+				return err
+			}
+			changes := beforeSi.Diff(afterSi.withDynamicViews())
 
 			if d.HasChange("owner") {
 				_, err = client.Shares.Update(ctx, sharing.UpdateShare{
@@ -233,7 +394,7 @@ func ResourceShare() common.Resource {
 			}
 
 			if len(changes) == 0 {
-				return nil
+				return dropDynamicViews(ctx, client, beforeDynamicViews, afterSi.DynamicViews)
 			}
 
 			_, err = client.Shares.Update(ctx, sharing.UpdateShare{
@@ -254,14 +415,27 @@ func ResourceShare() common.Resource {
 				}
 				return err
 			}
-			return nil
+			return dropDynamicViews(ctx, client, beforeDynamicViews, afterSi.DynamicViews)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
-			return w.Shares.DeleteByName(ctx, d.Id())
+			if err = w.Shares.DeleteByName(ctx, d.Id()); err != nil {
+				return err
+			}
+			return dropDynamicViews(ctx, w, dynamicViewsFromState(d.Get("dynamic_view")), nil)
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var si ShareInfo
+			common.DiffToStructPointer(d, shareSchema, &si)
+			return si.validateObjects()
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(dynamicViewsTimeout),
+			Update: schema.DefaultTimeout(dynamicViewsTimeout),
+			Delete: schema.DefaultTimeout(dynamicViewsTimeout),
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 )
@@ -334,7 +335,7 @@ func TestUpdateShare(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name:  "abc",
 						Owner: "admin",
 						Objects: []sharing.SharedDataObject{
@@ -389,7 +390,7 @@ func TestUpdateShareRollback(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "abc",
 						Objects: []sharing.SharedDataObject{
 							{
@@ -458,7 +459,7 @@ func TestUpdateShareRollback(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name:  "abc",
 						Owner: "admin",
 						Objects: []sharing.SharedDataObject{
@@ -515,7 +516,7 @@ func TestUpdateShare_NoChanges(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "abc",
 						Objects: []sharing.SharedDataObject{
 							{
@@ -534,7 +535,7 @@ func TestUpdateShare_NoChanges(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "abc",
 						Objects: []sharing.SharedDataObject{
 							{
@@ -574,7 +575,7 @@ func TestCreateShare_ThrowError(t *testing.T) {
 				Method:   "POST",
 				Resource: "/api/2.1/unity-catalog/shares",
 				ExpectedRequest: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "a",
 					},
 				},
@@ -612,12 +613,12 @@ func TestCreateShareButPatchFails(t *testing.T) {
 				Method:   "POST",
 				Resource: "/api/2.1/unity-catalog/shares",
 				ExpectedRequest: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "a",
 					},
 				},
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "a",
 					},
 				},
@@ -684,7 +685,7 @@ func TestUpdateShareComplexDiff(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "abc",
 						Objects: []sharing.SharedDataObject{
 							{
@@ -718,7 +719,7 @@ func TestUpdateShareComplexDiff(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.1/unity-catalog/shares/abc?include_shared_data=true",
 				Response: ShareInfo{
-					sharing.ShareInfo{
+					ShareInfo: sharing.ShareInfo{
 						Name: "abc",
 						Objects: []sharing.SharedDataObject{
 							{
@@ -763,3 +764,488 @@ func TestUpdateShareComplexDiff(t *testing.T) {
 		Resource: ResourceShare(),
 	}.ApplyNoError(t)
 }
+
+func TestShareDynamicViewStatements(t *testing.T) {
+	dv := ShareDynamicView{
+		Name:                 "main.shared.sales",
+		SourceTable:          "main.sales.orders",
+		Column:               "region",
+		RecipientPropertyKey: "region's",
+	}
+	assert.Equal(t, "CREATE OR REPLACE VIEW `main`.`shared`.`sales` AS SELECT * FROM `main`.`sales`.`orders` "+
+		"WHERE `region` = CURRENT_RECIPIENT('region\\'s')", dv.createStatement())
+	assert.Equal(t, "DROP VIEW IF EXISTS `main`.`shared`.`sales`", dv.dropStatement())
+}
+
+func TestCreateShareWithDynamicView(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sqlStatement("CREATE OR REPLACE VIEW `main`.`shared`.`sales` AS " +
+					"SELECT * FROM `main`.`sales`.`orders` WHERE `region` = CURRENT_RECIPIENT('region')"),
+				Response: sqlStatementSucceeded,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/shares",
+				ExpectedRequest: sharing.CreateShare{
+					Name: "a",
+				},
+				Response: sharing.ShareInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/a",
+				ExpectedRequest: sharing.UpdateShare{
+					Updates: []sharing.SharedDataObjectUpdate{
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.a",
+								DataObjectType: "TABLE",
+							},
+						},
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.shared.sales",
+								DataObjectType: "VIEW",
+								SharedAs:       "shared.sales",
+							},
+						},
+					},
+				},
+				Response: sharing.ShareInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: sharing.ShareInfo{
+					Name: "a",
+					Objects: []sharing.SharedDataObject{
+						{
+							Name:           "main.a",
+							DataObjectType: "TABLE",
+						},
+						{
+							Name:           "main.shared.sales",
+							DataObjectType: "VIEW",
+							SharedAs:       "shared.sales",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name  = "a"
+			object {
+				name = "main.a"
+				data_object_type = "TABLE"
+			}
+			dynamic_view {
+				name                   = "main.shared.sales"
+				source_table           = "main.sales.orders"
+				column                 = "region"
+				recipient_property_key = "region"
+				shared_as              = "shared.sales"
+				warehouse_id           = "abc"
+			}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object.#":       1,
+		"object.0.name":  "main.a",
+		"dynamic_view.#": 1,
+	})
+}
+
+func TestCreateShareWithDynamicView_LongRunningStatement(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements/",
+				ExpectedRequest: sqlStatement("CREATE OR REPLACE VIEW `main`.`shared`.`sales` AS " +
+					"SELECT * FROM `main`.`sales`.`orders` WHERE `region` = CURRENT_RECIPIENT('region')"),
+				Response: sql.StatementResponse{
+					StatementId: "123",
+					Status: &sql.StatementStatus{
+						State: sql.StatementStateRunning,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/statements/123?",
+				Response: sql.StatementResponse{
+					StatementId: "123",
+					Status: &sql.StatementStatus{
+						State: sql.StatementStateSucceeded,
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/shares",
+				ExpectedRequest: sharing.CreateShare{
+					Name: "a",
+				},
+				Response: sharing.ShareInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/a",
+				ExpectedRequest: sharing.UpdateShare{
+					Updates: []sharing.SharedDataObjectUpdate{
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.shared.sales",
+								DataObjectType: "VIEW",
+							},
+						},
+					},
+				},
+				Response: sharing.ShareInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: sharing.ShareInfo{
+					Name: "a",
+					Objects: []sharing.SharedDataObject{
+						{
+							Name:           "main.shared.sales",
+							DataObjectType: "VIEW",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name  = "a"
+			dynamic_view {
+				name                   = "main.shared.sales"
+				source_table           = "main.sales.orders"
+				column                 = "region"
+				recipient_property_key = "region"
+				warehouse_id           = "abc"
+			}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"dynamic_view.#": 1,
+	})
+}
+
+func TestUpdateShare_RemoveDynamicView(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: sharing.ShareInfo{
+					Name: "a",
+					Objects: []sharing.SharedDataObject{
+						{
+							Name:           "main.a",
+							DataObjectType: "TABLE",
+						},
+						{
+							Name:           "main.shared.sales",
+							DataObjectType: "VIEW",
+							SharedAs:       "shared.sales",
+						},
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/a",
+				ExpectedRequest: sharing.UpdateShare{
+					Updates: []sharing.SharedDataObjectUpdate{
+						{
+							Action: "REMOVE",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.shared.sales",
+								DataObjectType: "VIEW",
+								SharedAs:       "shared.sales",
+							},
+						},
+					},
+				},
+				Response: sharing.ShareInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/sql/statements/",
+				ExpectedRequest: sqlStatement("DROP VIEW IF EXISTS `main`.`shared`.`sales`"),
+				Response:        sqlStatementSucceeded,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: sharing.ShareInfo{
+					Name: "a",
+					Objects: []sharing.SharedDataObject{
+						{
+							Name:           "main.a",
+							DataObjectType: "TABLE",
+						},
+					},
+				},
+			},
+		},
+		ID:       "a",
+		Resource: ResourceShare(),
+		Update:   true,
+		InstanceState: map[string]string{
+			"name":                                  "a",
+			"object.#":                              "1",
+			"object.0.name":                         "main.a",
+			"object.0.data_object_type":             "TABLE",
+			"dynamic_view.#":                        "1",
+			"dynamic_view.0.name":                   "main.shared.sales",
+			"dynamic_view.0.source_table":           "main.sales.orders",
+			"dynamic_view.0.column":                 "region",
+			"dynamic_view.0.recipient_property_key": "region",
+			"dynamic_view.0.shared_as":              "shared.sales",
+			"dynamic_view.0.warehouse_id":           "abc",
+		},
+		HCL: `
+			name  = "a"
+			object {
+				name = "main.a"
+				data_object_type = "TABLE"
+			}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestShareDynamicView_InvalidName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name  = "a"
+			dynamic_view {
+				name                   = "sales"
+				source_table           = "main.sales.orders"
+				column                 = "region"
+				recipient_property_key = "region"
+				warehouse_id           = "abc"
+			}
+		`,
+	}.ExpectError(t, "invalid config supplied. [dynamic_view.#.name] invalid value for dynamic_view.0.name "+
+		"(must be a full name in form of <catalog>.<schema>.<name>)")
+}
+
+func sqlStatement(statement string) sql.ExecuteStatementRequest {
+	return sql.ExecuteStatementRequest{
+		Statement:     statement,
+		WarehouseId:   "abc",
+		WaitTimeout:   "50s",
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	}
+}
+
+var sqlStatementSucceeded = sql.StatementResponse{
+	Status: &sql.StatementStatus{
+		State: sql.StatementStateSucceeded,
+	},
+}
+
+func TestCreateShareWithNotebookVolumeAndModel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/shares",
+				ExpectedRequest: ShareInfo{
+					ShareInfo: sharing.ShareInfo{
+						Name: "a",
+					},
+				},
+				Response: ShareInfo{
+					ShareInfo: sharing.ShareInfo{
+						Name: "a",
+					}},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/shares/a",
+				ExpectedRequest: sharing.UpdateShare{
+					Updates: []sharing.SharedDataObjectUpdate{
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.ml.churn",
+								DataObjectType: "MODEL",
+								StringSharedAs: "ml.churn",
+							},
+						},
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "main.raw.files",
+								DataObjectType: "VOLUME",
+							},
+						},
+						{
+							Action: "ADD",
+							DataObject: &sharing.SharedDataObject{
+								Name:           "report",
+								DataObjectType: "NOTEBOOK_FILE",
+								Content:        "YWJj",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: ShareInfo{
+					ShareInfo: sharing.ShareInfo{
+						Name: "a",
+						Objects: []sharing.SharedDataObject{
+							{
+								Name:           "main.ml.churn",
+								DataObjectType: "MODEL",
+								StringSharedAs: "ml.churn",
+							},
+							{
+								Name:           "main.raw.files",
+								DataObjectType: "VOLUME",
+								StringSharedAs: "raw.files",
+							},
+							{
+								Name:           "report",
+								DataObjectType: "NOTEBOOK_FILE",
+								StringSharedAs: "report",
+							},
+						}},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+			name  = "a"
+			object {
+				name = "main.ml.churn"
+				data_object_type = "MODEL"
+				string_shared_as = "ml.churn"
+			}
+			object {
+				name = "main.raw.files"
+				data_object_type = "VOLUME"
+			}
+			object {
+				name = "report"
+				data_object_type = "NOTEBOOK_FILE"
+				content = "YWJj"
+			}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object.1.string_shared_as": "raw.files",
+		// content of notebooks isn't returned by the API, so it's kept from the configuration
+		"object.2.content": "YWJj",
+	})
+}
+
+func TestShareObjectValidation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		object string
+		err    string
+	}{
+		"unknown type": {
+			object: `name = "main.a.b"
+				data_object_type = "FUNCTION"`,
+			err: "invalid config supplied. [object.#.data_object_type] expected object.0.data_object_type to be one of " +
+				"[TABLE VIEW MATERIALIZED_VIEW STREAMING_TABLE SCHEMA NOTEBOOK_FILE VOLUME MODEL], got FUNCTION",
+		},
+		"partition of schema": {
+			object: `name = "main.a"
+				data_object_type = "SCHEMA"
+				partition {
+					value {
+						name = "year"
+						op = "EQUAL"
+						value = "2024"
+					}
+				}`,
+			err: "object.0.partition could only be used with TABLE objects",
+		},
+		"history of volume": {
+			object: `name = "main.a.b"
+				data_object_type = "VOLUME"
+				history_data_sharing_status = "ENABLED"`,
+			err: "object.0 cdf_enabled and history_data_sharing_status could only be used with tables, views and schemas",
+		},
+		"cdf and history": {
+			object: `name = "main.a.b"
+				data_object_type = "TABLE"
+				cdf_enabled = true
+				history_data_sharing_status = "ENABLED"`,
+			err: `object.0 cdf_enabled can't be used together with history_data_sharing_status = "ENABLED"`,
+		},
+		"start version without cdf": {
+			object: `name = "main.a.b"
+				data_object_type = "TABLE"
+				start_version = 10`,
+			err: `object.0.start_version requires cdf_enabled or history_data_sharing_status = "ENABLED"`,
+		},
+		"content of table": {
+			object: `name = "main.a.b"
+				data_object_type = "TABLE"
+				content = "YWJj"`,
+			err: "object.0.content could only be used with NOTEBOOK_FILE objects",
+		},
+		"shared_as of model": {
+			object: `name = "main.a.b"
+				data_object_type = "MODEL"
+				shared_as = "a.b"`,
+			err: "object.0.shared_as could only be used with tables, views and schemas, use string_shared_as instead",
+		},
+		"value and recipient property": {
+			object: `name = "main.a.b"
+				data_object_type = "TABLE"
+				partition {
+					value {
+						name = "region"
+						op = "EQUAL"
+						value = "EMEA"
+						recipient_property_key = "region"
+					}
+				}`,
+			err: "object.0.partition.0.value.0 can't have both value and recipient_property_key",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			qa.ResourceFixture{
+				Resource: ResourceShare(),
+				Create:   true,
+				HCL: `
+				name = "a"
+				object {
+					` + tc.object + `
+				}`,
+			}.ExpectError(t, tc.err)
+		})
+	}
+}
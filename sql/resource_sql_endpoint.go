@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +27,9 @@ type SqlWarehouse struct {
 	// We manually resolve it by retrieving the list of data sources
 	// and matching this entity's endpoint ID.
 	DataSourceId string `json:"data_source_id,omitempty" tf:"computed"`
+
+	// DBSQL version that the channel of the warehouse is currently resolved to.
+	DbsqlVersion string `json:"dbsql_version,omitempty" tf:"computed"`
 }
 
 func getSqlWarehouse(ctx context.Context, w *databricks.WorkspaceClient, id string) (*SqlWarehouse, error) {
@@ -35,9 +40,26 @@ func getSqlWarehouse(ctx context.Context, w *databricks.WorkspaceClient, id stri
 	warehouse := SqlWarehouse{
 		GetWarehouseResponse: *se,
 	}
+	if se.Channel != nil {
+		warehouse.DbsqlVersion = se.Channel.DbsqlVersion
+	}
 	return &warehouse, nil
 }
 
+// suppressChannelVersionDiff ignores DBSQL version changes made by Databricks when the channel isn't pinned
+// to a custom version, so that migrations of CHANNEL_NAME_CURRENT or CHANNEL_NAME_PREVIEW don't cause drift.
+func suppressChannelVersionDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("channel.0.name").(string) != string(sql.ChannelNameChannelNameCustom)
+}
+
+// withoutResolvedVersion drops the DBSQL version from channels, that are not pinned to a custom version,
+// as the version in the state is the one resolved by Databricks and must not be sent back.
+func withoutResolvedVersion(channel *sql.Channel) {
+	if channel != nil && channel.Name != sql.ChannelNameChannelNameCustom {
+		channel.DbsqlVersion = ""
+	}
+}
+
 func resolveDataSourceID(ctx context.Context, w *databricks.WorkspaceClient, warehouseId string) (string, error) {
 	list, err := w.DataSources.List(ctx)
 	if err != nil {
@@ -51,6 +73,90 @@ func resolveDataSourceID(ctx context.Context, w *databricks.WorkspaceClient, war
 	return "", fmt.Errorf("no data source found for endpoint %s", warehouseId)
 }
 
+// keepWarmQueryText is a no-op query, that is periodically executed to prevent auto-stop of the warehouse
+const keepWarmQueryText = "SELECT 1"
+
+// keepWarm is a scheduled job, that periodically runs a no-op query on the warehouse,
+// so that it's not stopped by auto-stop and is ready, when SQL resources depending on it are applied
+type keepWarm struct {
+	QuartzCronExpression string `json:"quartz_cron_expression"`
+	TimezoneID           string `json:"timezone_id,omitempty" tf:"default:UTC"`
+	JobID                int64  `json:"job_id,omitempty" tf:"computed"`
+	QueryID              string `json:"query_id,omitempty" tf:"computed"`
+}
+
+var keepWarmSchema = common.StructToSchema(keepWarm{}, nil)
+
+func createKeepWarm(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData) error {
+	kw := keepWarm{
+		QuartzCronExpression: d.Get("keep_warm.0.quartz_cron_expression").(string),
+		TimezoneID:           d.Get("keep_warm.0.timezone_id").(string),
+	}
+	name := fmt.Sprintf("%s keep warm", d.Get("name").(string))
+	query, err := w.Queries.Create(ctx, sql.CreateQueryRequest{
+		Query: &sql.CreateQueryRequestQuery{
+			DisplayName: name,
+			QueryText:   keepWarmQueryText,
+			WarehouseId: d.Id(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create keep warm query: %w", err)
+	}
+	job, err := w.Jobs.Create(ctx, jobs.CreateJob{
+		Name: name,
+		Schedule: &jobs.CronSchedule{
+			QuartzCronExpression: kw.QuartzCronExpression,
+			TimezoneId:           kw.TimezoneID,
+		},
+		MaxConcurrentRuns: 1,
+		Tasks: []jobs.Task{
+			{
+				TaskKey: "keep_warm",
+				SqlTask: &jobs.SqlTask{
+					Query: &jobs.SqlTaskQuery{
+						QueryId: query.Id,
+					},
+					WarehouseId: d.Id(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create keep warm job: %w", err)
+	}
+	kw.QueryID = query.Id
+	kw.JobID = job.JobId
+	return d.Set("keep_warm", []any{map[string]any{
+		"quartz_cron_expression": kw.QuartzCronExpression,
+		"timezone_id":            kw.TimezoneID,
+		"job_id":                 kw.JobID,
+		"query_id":               kw.QueryID,
+	}})
+}
+
+func deleteKeepWarm(ctx context.Context, w *databricks.WorkspaceClient, keepWarmBlocks []any) error {
+	for _, v := range keepWarmBlocks {
+		kw, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if jobID, _ := kw["job_id"].(int); jobID != 0 {
+			err := w.Jobs.DeleteByJobId(ctx, int64(jobID))
+			if err != nil && !apierr.IsMissing(err) {
+				return err
+			}
+		}
+		if queryID, _ := kw["query_id"].(string); queryID != "" {
+			err := w.Queries.DeleteById(ctx, queryID)
+			if err != nil && !apierr.IsMissing(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func ResourceSqlEndpoint() common.Resource {
 	s := common.StructToSchema(SqlWarehouse{}, func(
 		m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -58,6 +164,14 @@ func ResourceSqlEndpoint() common.Resource {
 		common.SetDefault(m["auto_stop_mins"], 120)
 		common.CustomizeSchemaPath(m, "channel").SetSuppressDiff()
 		common.MustSchemaPath(m, "channel", "name").Default = "CHANNEL_NAME_CURRENT"
+		common.CustomizeSchemaPath(m, "channel", "name").SetValidateDiagFunc(validation.ToDiagFunc(
+			validation.StringInSlice([]string{
+				string(sql.ChannelNameChannelNameCurrent),
+				string(sql.ChannelNameChannelNamePreview),
+				string(sql.ChannelNameChannelNamePrevious),
+				string(sql.ChannelNameChannelNameCustom),
+			}, false)))
+		common.CustomizeSchemaPath(m, "channel", "dbsql_version").SetCustomSuppressDiff(suppressChannelVersionDiff)
 		common.SetRequired(m["cluster_size"])
 		common.SetReadOnly(m["creator_name"])
 		m["cluster_size"].ValidateDiagFunc = validation.ToDiagFunc(
@@ -82,11 +196,28 @@ func ResourceSqlEndpoint() common.Resource {
 		common.CustomizeSchemaPath(m, "warehouse_type").
 			SetSuppressDiff().
 			SetValidateDiagFunc(validation.ToDiagFunc(validation.StringInSlice([]string{"PRO", "CLASSIC"}, false)))
+		m["no_wait"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return old == "" && new == "false"
+			},
+		}
+		m["keep_warm"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: keepWarmSchema,
+			},
+		}
 		return m
 	})
 	return common.Resource{
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -96,15 +227,23 @@ func ResourceSqlEndpoint() common.Resource {
 			var se sql.CreateWarehouseRequest
 			common.DataToStructPointer(d, s, &se)
 			common.SetForceSendFields(&se, d, []string{"enable_serverless_compute", "enable_photon"})
+			withoutResolvedVersion(se.Channel)
 			wait, err := w.Warehouses.Create(ctx, se)
 			if err != nil {
 				return fmt.Errorf("failed creating warehouse: %w", err)
 			}
-			resp, err := wait.Get()
-			if err != nil {
-				return fmt.Errorf("failed waiting for warehouse to start: %w", err)
+			if d.Get("no_wait").(bool) {
+				d.SetId(wait.Id)
+			} else {
+				resp, err := wait.GetWithTimeout(d.Timeout(schema.TimeoutCreate))
+				if err != nil {
+					return fmt.Errorf("failed waiting for warehouse to start: %w", err)
+				}
+				d.SetId(resp.Id)
+			}
+			if len(d.Get("keep_warm").([]any)) > 0 {
+				return createKeepWarm(ctx, w, d)
 			}
-			d.SetId(resp.Id)
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -131,10 +270,29 @@ func ResourceSqlEndpoint() common.Resource {
 			common.DataToStructPointer(d, s, &se)
 			common.SetForceSendFields(&se, d, []string{"enable_serverless_compute", "enable_photon"})
 			se.Id = d.Id()
-			_, err = w.Warehouses.Edit(ctx, se)
+			withoutResolvedVersion(se.Channel)
+			wait, err := w.Warehouses.Edit(ctx, se)
 			if err != nil {
 				return err
 			}
+			// running warehouses are restarted to apply the changes
+			state := sql.State(d.Get("state").(string))
+			if !d.Get("no_wait").(bool) && (state == sql.StateRunning || state == sql.StateStarting) {
+				_, err = wait.GetWithTimeout(d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return fmt.Errorf("failed waiting for warehouse to start: %w", err)
+				}
+			}
+			if d.HasChange("keep_warm") {
+				old, _ := d.GetChange("keep_warm")
+				err = deleteKeepWarm(ctx, w, old.([]any))
+				if err != nil {
+					return err
+				}
+				if len(d.Get("keep_warm").([]any)) > 0 {
+					return createKeepWarm(ctx, w, d)
+				}
+			}
 			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -142,6 +300,10 @@ func ResourceSqlEndpoint() common.Resource {
 			if err != nil {
 				return err
 			}
+			err = deleteKeepWarm(ctx, w, d.Get("keep_warm").([]any))
+			if err != nil {
+				return err
+			}
 			return w.Warehouses.DeleteById(ctx, d.Id())
 		},
 		Schema: s,
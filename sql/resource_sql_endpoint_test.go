@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/qa/poll"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
@@ -144,6 +146,117 @@ func TestResourceSQLEndpointCreate_ForceSendFields(t *testing.T) {
 
 }
 
+func TestResourceSQLEndpointCreate_NoWait(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			api := w.GetMockWarehousesAPI()
+			api.EXPECT().Create(mock.Anything, createRequest).Return(&sql.WaitGetWarehouseRunning[sql.CreateWarehouseResponse]{
+				Id: "abc",
+				Poll: func(_ time.Duration, _ func(*sql.GetWarehouseResponse)) (*sql.GetWarehouseResponse, error) {
+					return nil, errors.New("must not wait")
+				},
+			}, nil)
+			starting := getResponse
+			starting.State = sql.StateStarting
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&starting, nil)
+			addDataSourceListHttpFixture(w)
+		},
+		Resource: ResourceSqlEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		no_wait = true
+		`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "STARTING", d.Get("state"))
+}
+
+func TestResourceSQLEndpointCreate_KeepWarm(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			api := w.GetMockWarehousesAPI()
+			api.EXPECT().Create(mock.Anything, createRequest).Return(&sql.WaitGetWarehouseRunning[sql.CreateWarehouseResponse]{
+				Poll: poll.Simple(getResponse),
+			}, nil)
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&getResponse, nil)
+			addDataSourceListHttpFixture(w)
+			w.GetMockQueriesAPI().EXPECT().Create(mock.Anything, sql.CreateQueryRequest{
+				Query: &sql.CreateQueryRequestQuery{
+					DisplayName: "foo keep warm",
+					QueryText:   "SELECT 1",
+					WarehouseId: "abc",
+				},
+			}).Return(&sql.Query{Id: "query1"}, nil)
+			w.GetMockJobsAPI().EXPECT().Create(mock.Anything, jobs.CreateJob{
+				Name: "foo keep warm",
+				Schedule: &jobs.CronSchedule{
+					QuartzCronExpression: "0 */10 8-18 ? * MON-FRI",
+					TimezoneId:           "Europe/Amsterdam",
+				},
+				MaxConcurrentRuns: 1,
+				Tasks: []jobs.Task{
+					{
+						TaskKey: "keep_warm",
+						SqlTask: &jobs.SqlTask{
+							Query: &jobs.SqlTaskQuery{
+								QueryId: "query1",
+							},
+							WarehouseId: "abc",
+						},
+					},
+				},
+			}).Return(&jobs.CreateResponse{JobId: 123}, nil)
+		},
+		Resource: ResourceSqlEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		keep_warm {
+			quartz_cron_expression = "0 */10 8-18 ? * MON-FRI"
+			timezone_id            = "Europe/Amsterdam"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                      "abc",
+		"keep_warm.0.job_id":      123,
+		"keep_warm.0.query_id":    "query1",
+		"keep_warm.0.timezone_id": "Europe/Amsterdam",
+	})
+}
+
+func TestResourceSQLEndpointDelete_KeepWarm(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
+			mwc.GetMockJobsAPI().EXPECT().DeleteByJobId(mock.Anything, int64(123)).Return(nil)
+			mwc.GetMockQueriesAPI().EXPECT().DeleteById(mock.Anything, "query1").Return(apierr.ErrNotFound)
+			mwc.GetMockWarehousesAPI().EXPECT().DeleteById(mock.Anything, "abc").Return(nil)
+		},
+		Resource: ResourceSqlEndpoint(),
+		InstanceState: map[string]string{
+			"name":                               "foo",
+			"cluster_size":                       "Small",
+			"keep_warm.#":                        "1",
+			"keep_warm.0.quartz_cron_expression": "0 */10 * * * ?",
+			"keep_warm.0.timezone_id":            "UTC",
+			"keep_warm.0.job_id":                 "123",
+			"keep_warm.0.query_id":               "query1",
+		},
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		keep_warm {
+			quartz_cron_expression = "0 */10 * * * ?"
+		}
+		`,
+		ID:     "abc",
+		Delete: true,
+	}.ApplyNoError(t)
+}
+
 func TestResourceSQLEndpointCreateNoAutoTermination(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
@@ -240,6 +353,103 @@ func TestResourceSQLEndpointUpdate(t *testing.T) {
 	assert.Equal(t, "d7c9d05c-7496-4c69-b089-48823edad40c", d.Get("data_source_id"))
 }
 
+func TestResourceSQLEndpointUpdate_ChannelResolvedVersionIsNotSent(t *testing.T) {
+	response := getResponse
+	response.Channel = &sql.Channel{
+		Name:         sql.ChannelNameChannelNamePreview,
+		DbsqlVersion: "2024.35",
+	}
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(mwc *mocks.MockWorkspaceClient) {
+			api := mwc.GetMockWarehousesAPI()
+			api.EXPECT().Edit(mock.Anything, sql.EditWarehouseRequest{
+				Id:                 "abc",
+				Name:               "foo",
+				ClusterSize:        "Medium",
+				AutoStopMins:       120,
+				MaxNumClusters:     1,
+				EnablePhoton:       true,
+				SpotInstancePolicy: "COST_OPTIMIZED",
+				Channel: &sql.Channel{
+					Name: sql.ChannelNameChannelNamePreview,
+				},
+			}).Return(&sql.WaitGetWarehouseRunning[struct{}]{Poll: poll.Simple(response)}, nil)
+			api.EXPECT().GetById(mock.Anything, "abc").Return(&response, nil)
+			addDataSourceListHttpFixture(mwc)
+		},
+		Resource: ResourceSqlEndpoint(),
+		ID:       "abc",
+		Update:   true,
+		InstanceState: map[string]string{
+			"name":                    "foo",
+			"cluster_size":            "Small",
+			"channel.#":               "1",
+			"channel.0.name":          "CHANNEL_NAME_PREVIEW",
+			"channel.0.dbsql_version": "2024.30",
+		},
+		HCL: `
+		name = "foo"
+		cluster_size = "Medium"
+		channel {
+			name = "CHANNEL_NAME_PREVIEW"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"dbsql_version":           "2024.35",
+		"channel.0.dbsql_version": "2024.35",
+	})
+}
+
+func TestResourceSQLEndpoint_ChannelVersionDriftIsSuppressed(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlEndpoint(),
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":                      "foo",
+			"cluster_size":              "Small",
+			"auto_stop_mins":            "120",
+			"enable_photon":             "true",
+			"max_num_clusters":          "1",
+			"spot_instance_policy":      "COST_OPTIMIZED",
+			"channel.#":                 "1",
+			"channel.0.name":            "CHANNEL_NAME_CURRENT",
+			"channel.0.dbsql_version":   "2024.30",
+			"dbsql_version":             "2024.30",
+			"state":                     "RUNNING",
+			"odbc_params.#":             "0",
+			"num_clusters":              "1",
+			"num_active_sessions":       "0",
+			"jdbc_url":                  "jdbc",
+			"id":                        "abc",
+			"enable_serverless_compute": "false",
+			"data_source_id":            "d7c9d05c",
+			"creator_name":              "me",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{},
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		channel {
+			name = "CHANNEL_NAME_CURRENT"
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceSQLEndpoint_InvalidChannelName(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlEndpoint(),
+		Create:   true,
+		HCL: `
+		name = "foo"
+		cluster_size = "Small"
+		channel {
+			name = "CHANNEL_NAME_LATEST"
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [channel.#.name] expected name to be one of [CHANNEL_NAME_CURRENT CHANNEL_NAME_PREVIEW CHANNEL_NAME_PREVIOUS CHANNEL_NAME_CUSTOM], got CHANNEL_NAME_LATEST")
+}
+
 // Testing the customizeDiff on clearing "health" diff is working as expected.
 func TestResourceSQLEndpointUpdateHealthNoDiff(t *testing.T) {
 	qa.ResourceFixture{
@@ -262,6 +472,7 @@ func TestResourceSQLEndpointUpdateHealthNoDiff(t *testing.T) {
 			"id":                        {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
 			"enable_serverless_compute": {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
 			"data_source_id":            {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
+			"dbsql_version":             {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
 			"creator_name":              {Old: "", New: "", NewComputed: true, NewRemoved: false, RequiresNew: false, Sensitive: false},
 		},
 		HCL: `
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// mountedDbfsPaths are not stored in the DBFS root, so they are skipped unless explicitly requested
+var mountedDbfsPaths = []string{"/mnt", "/databricks-datasets"}
+
+type dbfsInventoryEntry struct {
+	Path             string `json:"path"`
+	IsDir            bool   `json:"is_dir,omitempty"`
+	SizeBytes        int64  `json:"size_bytes"`
+	FileCount        int64  `json:"file_count"`
+	LastModifiedTime int64  `json:"last_modified_time,omitempty"`
+}
+
+type dbfsInventoryData struct {
+	Path           string               `json:"path,omitempty" tf:"default:/"`
+	IncludeMounts  bool                 `json:"include_mounts,omitempty"`
+	MaxEntries     int64                `json:"max_entries,omitempty" tf:"default:100000"`
+	Entries        []dbfsInventoryEntry `json:"entries,omitempty" tf:"computed"`
+	TotalSizeBytes int64                `json:"total_size_bytes,omitempty" tf:"computed"`
+	TotalFileCount int64                `json:"total_file_count,omitempty" tf:"computed"`
+}
+
+func isMountedDbfsPath(path string) bool {
+	for _, v := range mountedDbfsPaths {
+		if path == v || strings.HasPrefix(path, v+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// dbfsInventory walks DBFS directories, but lists no more than the limited number of entries,
+// so that a DBFS root with millions of files doesn't block every plan
+type dbfsInventory struct {
+	api       DbfsAPI
+	remaining int64
+	limit     int64
+}
+
+func (i *dbfsInventory) list(path string) ([]FileInfo, error) {
+	files, err := i.api.list(path)
+	if err != nil {
+		return nil, err
+	}
+	i.remaining -= int64(len(files))
+	if i.remaining < 0 {
+		return nil, fmt.Errorf("more than %d entries found, increase max_entries or inventory a narrower path", i.limit)
+	}
+	return files, nil
+}
+
+// summarize walks the directory and accumulates size, number of files and the latest modification time
+func (i *dbfsInventory) summarize(entry *dbfsInventoryEntry) error {
+	files, err := i.list(entry.Path)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.ModificationTime > entry.LastModifiedTime {
+			entry.LastModifiedTime = f.ModificationTime
+		}
+		if !f.IsDir {
+			entry.SizeBytes += f.FileSize
+			entry.FileCount++
+			continue
+		}
+		sub := dbfsInventoryEntry{Path: f.Path}
+		if err = i.summarize(&sub); err != nil {
+			return fmt.Errorf("cannot list subfolder: %w", err)
+		}
+		entry.SizeBytes += sub.SizeBytes
+		entry.FileCount += sub.FileCount
+		if sub.LastModifiedTime > entry.LastModifiedTime {
+			entry.LastModifiedTime = sub.LastModifiedTime
+		}
+	}
+	return nil
+}
+
+// DataSourceDbfsInventory summarizes usage of the top-level entries of a DBFS directory, so that
+// the DBFS root could be cleaned up before disabling it
+func DataSourceDbfsInventory() common.Resource {
+	return common.DataResource(dbfsInventoryData{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*dbfsInventoryData)
+		if data.MaxEntries <= 0 {
+			return fmt.Errorf("max_entries must be positive")
+		}
+		inventory := &dbfsInventory{
+			api:       NewDbfsAPI(ctx, c),
+			remaining: data.MaxEntries,
+			limit:     data.MaxEntries,
+		}
+		files, err := inventory.list(data.Path)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if !data.IncludeMounts && isMountedDbfsPath(f.Path) {
+				continue
+			}
+			entry := dbfsInventoryEntry{
+				Path:             f.Path,
+				IsDir:            f.IsDir,
+				SizeBytes:        f.FileSize,
+				LastModifiedTime: f.ModificationTime,
+			}
+			if f.IsDir {
+				if err = inventory.summarize(&entry); err != nil {
+					return err
+				}
+			} else {
+				entry.FileCount = 1
+			}
+			data.TotalSizeBytes += entry.SizeBytes
+			data.TotalFileCount += entry.FileCount
+			data.Entries = append(data.Entries, entry)
+		}
+		sort.Slice(data.Entries, func(i, j int) bool {
+			return data.Entries[i].Path < data.Entries[j].Path
+		})
+		return nil
+	})
+}
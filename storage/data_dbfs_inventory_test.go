@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceDbfsInventory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2F",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/tmp", IsDir: true, ModificationTime: 100},
+						{Path: "/mnt", IsDir: true},
+						{Path: "/FileStore", IsDir: true},
+						{Path: "/init.sh", FileSize: 10, ModificationTime: 50},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Ftmp",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/tmp/a", FileSize: 1024, ModificationTime: 200},
+						{Path: "/tmp/b", IsDir: true, ModificationTime: 150},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Ftmp%2Fb",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/tmp/b/c", FileSize: 2048, ModificationTime: 300},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2FFileStore",
+				Response: FileList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDbfsInventory(),
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"path":                         "/",
+		"max_entries":                  100000,
+		"total_size_bytes":             3082,
+		"total_file_count":             3,
+		"entries.#":                    3,
+		"entries.0.path":               "/FileStore",
+		"entries.0.size_bytes":         0,
+		"entries.0.file_count":         0,
+		"entries.1.path":               "/init.sh",
+		"entries.1.is_dir":             false,
+		"entries.1.size_bytes":         10,
+		"entries.1.file_count":         1,
+		"entries.1.last_modified_time": 50,
+		"entries.2.path":               "/tmp",
+		"entries.2.is_dir":             true,
+		"entries.2.size_bytes":         3072,
+		"entries.2.file_count":         2,
+		"entries.2.last_modified_time": 300,
+	})
+}
+
+func TestDataSourceDbfsInventory_IncludeMounts(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2F",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/mnt", IsDir: true},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Fmnt",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/mnt/data", FileSize: 5},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDbfsInventory(),
+		HCL:         `include_mounts = true`,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"total_size_bytes":     5,
+		"total_file_count":     1,
+		"entries.#":            1,
+		"entries.0.path":       "/mnt",
+		"entries.0.size_bytes": 5,
+	})
+}
+
+func TestDataSourceDbfsInventory_MaxEntries(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2F",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/tmp", IsDir: true},
+						{Path: "/init.sh", FileSize: 10},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Ftmp",
+				Response: FileList{
+					[]FileInfo{
+						{Path: "/tmp/a", FileSize: 1024},
+						{Path: "/tmp/b", FileSize: 2048},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDbfsInventory(),
+		HCL:         `max_entries = 3`,
+		ID:          "_",
+	}.ExpectError(t, "more than 3 entries found, increase max_entries or inventory a narrower path")
+}
+
+func TestDataSourceDbfsInventory_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Fdata",
+				Status:   404,
+				Response: map[string]any{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "No file or directory exists on path /data.",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDbfsInventory(),
+		HCL:         `path = "/data"`,
+		ID:          "_",
+	}.ExpectError(t, "cannot list /data: No file or directory exists on path /data.")
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type VolumeFileInfo struct {
+	Path        string `json:"path"`
+	Name        string `json:"name"`
+	IsDirectory bool   `json:"is_directory,omitempty"`
+	FileSize    int64  `json:"file_size,omitempty"`
+	// ModificationTime is the time of the last modification in milliseconds since epoch
+	ModificationTime int64 `json:"modification_time,omitempty"`
+}
+
+func DataSourceVolumeFiles() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id        string           `json:"id,omitempty" tf:"computed"`
+		Path      string           `json:"path"`
+		Recursive bool             `json:"recursive,omitempty"`
+		Files     []VolumeFileInfo `json:"files,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		if _, errs := validateVolumePath(data.Path, "path"); len(errs) > 0 {
+			return errs[0]
+		}
+		entries, err := listVolumeDirectory(ctx, w, data.Path, data.Recursive)
+		if err != nil {
+			return err
+		}
+		data.Id = data.Path
+		data.Files = make([]VolumeFileInfo, 0, len(entries))
+		for _, entry := range entries {
+			data.Files = append(data.Files, VolumeFileInfo{
+				Path:             entry.Path,
+				Name:             entry.Name,
+				IsDirectory:      entry.IsDirectory,
+				FileSize:         entry.FileSize,
+				ModificationTime: entry.LastModified,
+			})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceVolumeFiles(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockFilesAPI().EXPECT()
+			e.ListDirectoryContentsAll(mock.Anything, files.ListDirectoryContentsRequest{
+				DirectoryPath: "/Volumes/main/default/artifacts",
+			}).Return([]files.DirectoryEntry{
+				{Path: "/Volumes/main/default/artifacts/models/", Name: "models", IsDirectory: true},
+				{Path: "/Volumes/main/default/artifacts/README.md", Name: "README.md",
+					FileSize: 10, LastModified: 1700000000000},
+			}, nil)
+			e.ListDirectoryContentsAll(mock.Anything, files.ListDirectoryContentsRequest{
+				DirectoryPath: "/Volumes/main/default/artifacts/models",
+			}).Return([]files.DirectoryEntry{
+				{Path: "/Volumes/main/default/artifacts/models/model.bin", Name: "model.bin",
+					FileSize: 1024, LastModified: 1700000001000},
+			}, nil)
+		},
+		Resource:    DataSourceVolumeFiles(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `path = "/Volumes/main/default/artifacts"
+		recursive = true`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                        "/Volumes/main/default/artifacts",
+		"files.#":                   3,
+		"files.0.is_directory":      true,
+		"files.1.file_size":         10,
+		"files.2.path":              "/Volumes/main/default/artifacts/models/model.bin",
+		"files.2.modification_time": 1700000001000,
+	})
+}
+
+func TestDataSourceVolumeFiles_InvalidPath(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceVolumeFiles(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `path = "/dbfs/tmp"`,
+	}.ExpectError(t, "path must start with /Volumes/, got /dbfs/tmp")
+}
@@ -19,6 +19,8 @@ type FileInfo struct {
 	Path     string `json:"path,omitempty"`
 	IsDir    bool   `json:"is_dir,omitempty"`
 	FileSize int64  `json:"file_size,omitempty"`
+	// ModificationTime is the last modification time of the file, in epoch milliseconds
+	ModificationTime int64 `json:"modification_time,omitempty"`
 }
 
 // createHandle contains the payload to create a handle which is a connection for uploading blocks of file data
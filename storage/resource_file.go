@@ -6,9 +6,11 @@ import (
 	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"hash"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/databricks/databricks-sdk-go/service/files"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -45,12 +47,54 @@ func getContentReader(data *schema.ResourceData) (*hashReadCloser, error) {
 			return nil, err
 		}
 	}
+	if reader == nil {
+		return nil, fmt.Errorf("either source or content_base64 must be set")
+	}
 	hash := md5.New()
 	tee := io.TeeReader(reader, hash)
 	teeCloser := hashReadCloser{tee, reader, hash}
 	return &teeCloser, err
 }
 
+// sourceChecksums caches checksums of local files by their path, size and modification time, so that
+// multi-gigabyte files are hashed only once, instead of on every plan of the resource
+var sourceChecksums sync.Map
+
+// contentMD5 returns the checksum of the content, that is cached for local files
+func contentMD5(data *schema.ResourceData) (string, error) {
+	source := data.Get("source").(string)
+	if source == "" {
+		return hashContent(data)
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", err
+	}
+	key := fmt.Sprintf("%s:%d:%d", source, info.Size(), info.ModTime().UnixNano())
+	if checksum, ok := sourceChecksums.Load(key); ok {
+		return checksum.(string), nil
+	}
+	checksum, err := hashContent(data)
+	if err != nil {
+		return "", err
+	}
+	sourceChecksums.Store(key, checksum)
+	return checksum, nil
+}
+
+// hashContent streams the content through the hash, so that multi-gigabyte files aren't loaded into memory
+func hashContent(data *schema.ResourceData) (string, error) {
+	reader, err := getContentReader(data)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	if _, err = io.Copy(io.Discard, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(reader.Sum(nil)), nil
+}
+
 func upload(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient, path string) error {
 	w, err := c.WorkspaceClient()
 	if err != nil {
@@ -60,6 +104,8 @@ func upload(ctx context.Context, data *schema.ResourceData, c *common.Databricks
 	if err != nil {
 		return err
 	}
+	defer reader.Close()
+	// content is streamed to the API without buffering it in memory
 	err = w.Files.Upload(ctx, files.UploadRequest{Contents: reader, FilePath: path})
 	if err != nil {
 		return err
@@ -92,6 +138,13 @@ func ResourceFile() common.Resource {
 			Optional: true,
 		},
 	})
+	s["md5"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		checksum, err := contentMD5(d)
+		if err != nil {
+			return false
+		}
+		return old == checksum
+	}
 	return common.Resource{
 		Schema: s,
 		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
@@ -1,13 +1,18 @@
 package storage
 
 import (
+	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/service/files"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceFileCreate(t *testing.T) {
@@ -247,3 +252,65 @@ func TestResourceFileBadPrefix(t *testing.T) {
 		`,
 	}.ExpectError(t, "invalid config supplied. [path] Path should start with /Volumes")
 }
+
+func TestResourceFileSourceUnchanged(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		path   = "/Volumes/CatalogName/SchemaName/VolumeName/fileName"
+		source = "testdata/tf-test-python.py"
+		`,
+		InstanceState: map[string]string{
+			"path":              "/Volumes/CatalogName/SchemaName/VolumeName/fileName",
+			"source":            "testdata/tf-test-python.py",
+			"md5":               "e4ba3a99cc1b65aff280ed8b016686b9",
+			"file_size":         "1024",
+			"modification_time": "Wed, 21 Oct 2015 07:28:00 GMT",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{},
+		Resource:     ResourceFile(),
+		ID:           "/Volumes/CatalogName/SchemaName/VolumeName/fileName",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFileSourceChanged(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		path   = "/Volumes/CatalogName/SchemaName/VolumeName/fileName"
+		source = "testdata/tf-test-python.py"
+		`,
+		InstanceState: map[string]string{
+			"path":              "/Volumes/CatalogName/SchemaName/VolumeName/fileName",
+			"source":            "testdata/tf-test-python.py",
+			"md5":               "d41d8cd98f00b204e9800998ecf8427e",
+			"file_size":         "1024",
+			"modification_time": "Wed, 21 Oct 2015 07:28:00 GMT",
+		},
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"md5": {Old: "d41d8cd98f00b204e9800998ecf8427e", New: "different"},
+		},
+		Resource: ResourceFile(),
+		ID:       "/Volumes/CatalogName/SchemaName/VolumeName/fileName",
+	}.ApplyNoError(t)
+}
+
+func TestContentMD5_CachesSourceChecksum(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(source, []byte("abc"), 0600))
+	d := ResourceFile().ToResource().TestResourceData()
+	require.NoError(t, d.Set("source", source))
+	checksum, err := contentMD5(d)
+	require.NoError(t, err)
+	assert.Equal(t, "900150983cd24fb0d6963f7d28e17f72", checksum)
+
+	info, err := os.Stat(source)
+	require.NoError(t, err)
+	sourceChecksums.Store(fmt.Sprintf("%s:%d:%d", source, info.Size(), info.ModTime().UnixNano()), "cached")
+	checksum, err = contentMD5(d)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", checksum, "unchanged files aren't hashed again")
+
+	require.NoError(t, os.WriteFile(source, []byte("abcd"), 0600))
+	checksum, err = contentMD5(d)
+	require.NoError(t, err)
+	assert.Equal(t, "e2fc714c4727ee9395f324cd2e7f331f", checksum)
+}
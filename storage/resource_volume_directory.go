@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type VolumeDirectory struct {
+	Path string `json:"path" tf:"force_new"`
+	// DeleteRecursive removes all files and subdirectories on destroy, as only empty directories could be deleted
+	DeleteRecursive bool `json:"delete_recursive,omitempty"`
+}
+
+func ResourceVolumeDirectory() common.Resource {
+	s := common.StructToSchema(VolumeDirectory{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "path").SetValidateFunc(validateVolumePath)
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			path := d.Get("path").(string)
+			// parent directories are created as well
+			err = w.Files.CreateDirectory(ctx, files.CreateDirectoryRequest{DirectoryPath: path})
+			if err != nil {
+				return err
+			}
+			d.SetId(path)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			err = w.Files.GetDirectoryMetadataByDirectoryPath(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			return d.Set("path", d.Id())
+		},
+		// only delete_recursive could be changed, and it's used on destroy
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			if d.Get("delete_recursive").(bool) {
+				entries, err := listVolumeDirectory(ctx, w, d.Id(), true)
+				if err != nil {
+					return err
+				}
+				var directories []string
+				for _, entry := range entries {
+					if entry.IsDirectory {
+						directories = append(directories, entry.Path)
+						continue
+					}
+					if err = w.Files.DeleteByFilePath(ctx, entry.Path); err != nil {
+						return err
+					}
+				}
+				// children are listed after their parents, so directories are deleted in the reverse order
+				for i := len(directories) - 1; i >= 0; i-- {
+					if err = w.Files.DeleteDirectoryByDirectoryPath(ctx, directories[i]); err != nil {
+						return err
+					}
+				}
+			}
+			return w.Files.DeleteDirectoryByDirectoryPath(ctx, d.Id())
+		},
+	}
+}
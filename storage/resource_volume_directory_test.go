@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+const volumeDirectory = "/Volumes/main/default/artifacts/models"
+
+func TestResourceVolumeDirectoryCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockFilesAPI().EXPECT()
+			e.CreateDirectory(mock.Anything, files.CreateDirectoryRequest{
+				DirectoryPath: volumeDirectory,
+			}).Return(nil)
+			e.GetDirectoryMetadataByDirectoryPath(mock.Anything, volumeDirectory).Return(nil)
+		},
+		Resource: ResourceVolumeDirectory(),
+		Create:   true,
+		HCL:      `path = "/Volumes/main/default/artifacts/models"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":   volumeDirectory,
+		"path": volumeDirectory,
+	})
+}
+
+func TestResourceVolumeDirectoryInvalidPath(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceVolumeDirectory(),
+		Create:   true,
+		HCL:      `path = "/Volumes/main/default/artifacts/../models"`,
+	}.ExpectError(t, "invalid config supplied. [path] path must be a clean path, "+
+		"replace /Volumes/main/default/artifacts/../models with /Volumes/main/default/models")
+}
+
+func TestResourceVolumeDirectoryRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockFilesAPI().EXPECT().GetDirectoryMetadataByDirectoryPath(mock.Anything, volumeDirectory).
+				Return(apierr.ErrNotFound)
+		},
+		Resource: ResourceVolumeDirectory(),
+		Read:     true,
+		Removed:  true,
+		ID:       volumeDirectory,
+	}.ApplyNoError(t)
+}
+
+func TestResourceVolumeDirectoryDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockFilesAPI().EXPECT().DeleteDirectoryByDirectoryPath(mock.Anything, volumeDirectory).Return(nil)
+		},
+		Resource: ResourceVolumeDirectory(),
+		Delete:   true,
+		ID:       volumeDirectory,
+		HCL:      `path = "/Volumes/main/default/artifacts/models"`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceVolumeDirectoryDeleteRecursive(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockFilesAPI().EXPECT()
+			e.ListDirectoryContentsAll(mock.Anything, files.ListDirectoryContentsRequest{
+				DirectoryPath: volumeDirectory,
+			}).Return([]files.DirectoryEntry{
+				{Path: volumeDirectory + "/v1/", Name: "v1", IsDirectory: true},
+				{Path: volumeDirectory + "/README.md", Name: "README.md", FileSize: 10},
+			}, nil)
+			e.ListDirectoryContentsAll(mock.Anything, files.ListDirectoryContentsRequest{
+				DirectoryPath: volumeDirectory + "/v1",
+			}).Return([]files.DirectoryEntry{
+				{Path: volumeDirectory + "/v1/model.bin", Name: "model.bin", FileSize: 1024},
+			}, nil)
+			e.DeleteByFilePath(mock.Anything, volumeDirectory+"/README.md").Return(nil)
+			e.DeleteByFilePath(mock.Anything, volumeDirectory+"/v1/model.bin").Return(nil)
+			e.DeleteDirectoryByDirectoryPath(mock.Anything, volumeDirectory+"/v1/").Return(nil)
+			e.DeleteDirectoryByDirectoryPath(mock.Anything, volumeDirectory).Return(nil)
+		},
+		Resource: ResourceVolumeDirectory(),
+		Delete:   true,
+		ID:       volumeDirectory,
+		HCL: `path = "/Volumes/main/default/artifacts/models"
+		delete_recursive = true`,
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/files"
+)
+
+// validateVolumePath checks that the path points to a location inside of a Unity Catalog volume
+func validateVolumePath(i any, k string) (_ []string, errs []error) {
+	v := i.(string)
+	if !strings.HasPrefix(v, "/Volumes/") {
+		return nil, []error{fmt.Errorf("%s must start with /Volumes/, got %s", k, v)}
+	}
+	if clean := filepath.ToSlash(filepath.Clean(v)); v != clean {
+		return nil, []error{fmt.Errorf("%s must be a clean path, replace %s with %s", k, v, clean)}
+	}
+	return nil, nil
+}
+
+// listVolumeDirectory returns the contents of the directory. If recursive is set, contents of
+// subdirectories are returned as well, so that parent directories are always followed by their children.
+func listVolumeDirectory(ctx context.Context, w *databricks.WorkspaceClient, path string,
+	recursive bool) ([]files.DirectoryEntry, error) {
+	var result []files.DirectoryEntry
+	queue := []string{path}
+	for len(queue) > 0 {
+		directory := queue[0]
+		queue = queue[1:]
+		entries, err := w.Files.ListDirectoryContentsAll(ctx, files.ListDirectoryContentsRequest{
+			DirectoryPath: directory,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			result = append(result, entry)
+			if recursive && entry.IsDirectory {
+				queue = append(queue, strings.TrimSuffix(entry.Path, "/"))
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,298 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	tokensEnabledConfKey        = "enableTokensConfig"
+	maxTokenLifetimeDaysConfKey = "maxTokenLifetimeDays"
+)
+
+// tokenUsage lists principals that are allowed to create and use personal access tokens.
+// Members of the `admins` group can always use tokens, so they are never part of the policy.
+type tokenUsage struct {
+	GroupNames            []string `json:"group_names,omitempty" tf:"slice_set"`
+	UserNames             []string `json:"user_names,omitempty" tf:"slice_set"`
+	ServicePrincipalNames []string `json:"service_principal_names,omitempty" tf:"slice_set"`
+}
+
+func (tu tokenUsage) accessControlList() []iam.AccessControlRequest {
+	acl := []iam.AccessControlRequest{}
+	for _, v := range tu.GroupNames {
+		acl = append(acl, iam.AccessControlRequest{GroupName: v, PermissionLevel: iam.PermissionLevelCanUse})
+	}
+	for _, v := range tu.UserNames {
+		acl = append(acl, iam.AccessControlRequest{UserName: v, PermissionLevel: iam.PermissionLevelCanUse})
+	}
+	for _, v := range tu.ServicePrincipalNames {
+		acl = append(acl, iam.AccessControlRequest{ServicePrincipalName: v, PermissionLevel: iam.PermissionLevelCanUse})
+	}
+	return acl
+}
+
+func newTokenUsage(permissions *iam.ObjectPermissions) tokenUsage {
+	tu := tokenUsage{}
+	for _, ac := range permissions.AccessControlList {
+		if ac.GroupName == "admins" {
+			continue
+		}
+		direct := false
+		for _, p := range ac.AllPermissions {
+			if !p.Inherited && p.PermissionLevel == iam.PermissionLevelCanUse {
+				direct = true
+			}
+		}
+		if !direct {
+			continue
+		}
+		switch {
+		case ac.GroupName != "":
+			tu.GroupNames = append(tu.GroupNames, ac.GroupName)
+		case ac.UserName != "":
+			tu.UserNames = append(tu.UserNames, ac.UserName)
+		case ac.ServicePrincipalName != "":
+			tu.ServicePrincipalNames = append(tu.ServicePrincipalNames, ac.ServicePrincipalName)
+		}
+	}
+	sort.Strings(tu.GroupNames)
+	sort.Strings(tu.UserNames)
+	sort.Strings(tu.ServicePrincipalNames)
+	return tu
+}
+
+// hasTokenUsage is true if permissions to use tokens are managed, even if only admins may use them
+func hasTokenUsage(d *schema.ResourceData) bool {
+	usage, ok := d.Get("token_usage").([]any)
+	return ok && len(usage) > 0
+}
+
+func tokenUsageFrom(v any) tokenUsage {
+	tu := tokenUsage{}
+	for _, item := range v.([]any) {
+		m, ok := item.(map[string]any)
+		if !ok {
+			// empty block
+			continue
+		}
+		for _, name := range m["group_names"].(*schema.Set).List() {
+			tu.GroupNames = append(tu.GroupNames, name.(string))
+		}
+		for _, name := range m["user_names"].(*schema.Set).List() {
+			tu.UserNames = append(tu.UserNames, name.(string))
+		}
+		for _, name := range m["service_principal_names"].(*schema.Set).List() {
+			tu.ServicePrincipalNames = append(tu.ServicePrincipalNames, name.(string))
+		}
+	}
+	return tu
+}
+
+func (tu tokenUsage) without(other tokenUsage) tokenUsage {
+	result := tokenUsage{}
+	for _, v := range tu.GroupNames {
+		if !slices.Contains(other.GroupNames, v) {
+			result.GroupNames = append(result.GroupNames, v)
+		}
+	}
+	for _, v := range tu.UserNames {
+		if !slices.Contains(other.UserNames, v) {
+			result.UserNames = append(result.UserNames, v)
+		}
+	}
+	for _, v := range tu.ServicePrincipalNames {
+		if !slices.Contains(other.ServicePrincipalNames, v) {
+			result.ServicePrincipalNames = append(result.ServicePrincipalNames, v)
+		}
+	}
+	return result
+}
+
+// updateTokenUsage gives CAN_USE to the added principals and revokes direct permissions of the removed ones,
+// keeping permissions of all other principals
+func updateTokenUsage(ctx context.Context, w *databricks.WorkspaceClient, removed, added tokenUsage) error {
+	revoked := removed.accessControlList()
+	acl := added.accessControlList()
+	if len(revoked) == 0 {
+		if len(acl) == 0 {
+			return nil
+		}
+		_, err := w.Permissions.Update(ctx, iam.PermissionsRequest{
+			RequestObjectType: "authorization",
+			RequestObjectId:   "tokens",
+			AccessControlList: acl,
+		})
+		return err
+	}
+	// permissions could only be revoked by setting the whole access control list
+	permissions, err := w.Permissions.Get(ctx, iam.GetPermissionRequest{
+		RequestObjectType: "authorization",
+		RequestObjectId:   "tokens",
+	})
+	if err != nil {
+		return err
+	}
+	kept := []iam.AccessControlRequest{}
+	for _, ac := range permissions.AccessControlList {
+		for _, p := range ac.AllPermissions {
+			if p.Inherited {
+				continue
+			}
+			change := iam.AccessControlRequest{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      p.PermissionLevel,
+			}
+			samePrincipal := func(other iam.AccessControlRequest) bool {
+				return other.UserName == change.UserName && other.GroupName == change.GroupName &&
+					other.ServicePrincipalName == change.ServicePrincipalName
+			}
+			if slices.ContainsFunc(revoked, samePrincipal) || slices.ContainsFunc(acl, samePrincipal) {
+				continue
+			}
+			kept = append(kept, change)
+		}
+	}
+	_, err = w.Permissions.Set(ctx, iam.PermissionsRequest{
+		RequestObjectType: "authorization",
+		RequestObjectId:   "tokens",
+		AccessControlList: append(kept, acl...),
+	})
+	return err
+}
+
+type workspaceApiTokenPolicy struct {
+	TokensEnabled        bool        `json:"tokens_enabled,omitempty" tf:"default:true"`
+	MaxTokenLifetimeDays int         `json:"max_token_lifetime_days,omitempty"`
+	TokenUsage           *tokenUsage `json:"token_usage,omitempty"`
+}
+
+func applyWorkspaceApiTokenPolicy(s map[string]*schema.Schema) func(context.Context, *schema.ResourceData, *common.DatabricksClient) error {
+	return func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		var policy workspaceApiTokenPolicy
+		common.DataToStructPointer(d, s, &policy)
+		if d.IsNewResource() || d.HasChanges("tokens_enabled", "max_token_lifetime_days") {
+			maxLifetime := ""
+			if policy.MaxTokenLifetimeDays > 0 {
+				maxLifetime = strconv.Itoa(policy.MaxTokenLifetimeDays)
+			}
+			err = w.WorkspaceConf.SetStatus(ctx, settings.WorkspaceConf{
+				tokensEnabledConfKey:        strconv.FormatBool(policy.TokensEnabled),
+				maxTokenLifetimeDaysConfKey: maxLifetime,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if d.IsNewResource() || d.HasChange("token_usage") {
+			// without the block, permissions to use tokens are not managed by this resource. Once the
+			// block is removed, permissions of the principals listed in it are revoked.
+			old, new := d.GetChange("token_usage")
+			removed := tokenUsageFrom(old)
+			if d.IsNewResource() && policy.TokenUsage != nil {
+				// principals, that are not listed in the block, can't use tokens
+				permissions, err := w.Permissions.Get(ctx, iam.GetPermissionRequest{
+					RequestObjectType: "authorization",
+					RequestObjectId:   "tokens",
+				})
+				if err != nil {
+					return err
+				}
+				removed = newTokenUsage(permissions)
+			}
+			added := tokenUsageFrom(new)
+			err = updateTokenUsage(ctx, w, removed.without(added), added)
+			if err != nil {
+				return fmt.Errorf("cannot set token usage permissions: %w", err)
+			}
+		}
+		d.SetId("_")
+		return nil
+	}
+}
+
+// ResourceWorkspaceApiTokenPolicy manages whether personal access tokens are enabled in the workspace,
+// their maximum lifetime and who is allowed to create them
+func ResourceWorkspaceApiTokenPolicy() common.Resource {
+	s := common.StructToSchema(workspaceApiTokenPolicy{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "max_token_lifetime_days").SetValidateFunc(validation.IntAtLeast(1))
+		return m
+	})
+	apply := applyWorkspaceApiTokenPolicy(s)
+	return common.Resource{
+		Schema: s,
+		Create: apply,
+		Update: apply,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			remote, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+				Keys: strings.Join([]string{tokensEnabledConfKey, maxTokenLifetimeDaysConfKey}, ","),
+			})
+			if err != nil {
+				return err
+			}
+			var policy workspaceApiTokenPolicy
+			// tokens are enabled, unless explicitly disabled
+			policy.TokensEnabled = !strings.EqualFold((*remote)[tokensEnabledConfKey], "false")
+			if v := (*remote)[maxTokenLifetimeDaysConfKey]; v != "" {
+				policy.MaxTokenLifetimeDays, err = strconv.Atoi(v)
+				if err != nil {
+					return fmt.Errorf("invalid value of %s: %s", maxTokenLifetimeDaysConfKey, v)
+				}
+			}
+			if hasTokenUsage(d) {
+				permissions, err := w.Permissions.Get(ctx, iam.GetPermissionRequest{
+					RequestObjectType: "authorization",
+					RequestObjectId:   "tokens",
+				})
+				if err != nil {
+					return err
+				}
+				usage := newTokenUsage(permissions)
+				policy.TokenUsage = &usage
+			}
+			err = common.StructToData(policy, s, d)
+			if err != nil {
+				return err
+			}
+			// false is skipped by StructToData as an empty value
+			return d.Set("tokens_enabled", policy.TokensEnabled)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			// reset to the workspace defaults
+			err = w.WorkspaceConf.SetStatus(ctx, settings.WorkspaceConf{
+				tokensEnabledConfKey:        "true",
+				maxTokenLifetimeDaysConfKey: "",
+			})
+			if err != nil {
+				return err
+			}
+			// only the principals, that are listed in the state, lose permissions to use tokens
+			return updateTokenUsage(ctx, w, tokenUsageFrom(d.Get("token_usage")), tokenUsage{})
+		},
+	}
+}
@@ -0,0 +1,312 @@
+package tokens
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+const tokenPolicyConfGet = "/api/2.0/workspace-conf?keys=enableTokensConfig%2CmaxTokenLifetimeDays"
+
+func TestWorkspaceApiTokenPolicyCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableTokensConfig":   "true",
+					"maxTokenLifetimeDays": "90",
+				},
+			},
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/permissions/authorization/tokens",
+				ExpectedRequest: map[string]any{
+					"access_control_list": []any{
+						map[string]any{
+							"group_name":       "data-engineers",
+							"permission_level": "CAN_USE",
+						},
+						map[string]any{
+							"service_principal_name": "abc",
+							"permission_level":       "CAN_USE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: tokenPolicyConfGet,
+				Response: map[string]any{
+					"enableTokensConfig":   "true",
+					"maxTokenLifetimeDays": "90",
+				},
+			},
+			{
+				ReuseRequest: true,
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/permissions/authorization/tokens?",
+				Response: map[string]any{
+					"object_id":   "/authorization/tokens",
+					"object_type": "tokens",
+					"access_control_list": []any{
+						map[string]any{
+							"group_name": "admins",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_MANAGE"},
+							},
+						},
+						map[string]any{
+							"group_name": "data-engineers",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+						map[string]any{
+							"service_principal_name": "abc",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+						map[string]any{
+							"user_name": "inherited@example.com",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE", "inherited": true},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		HCL: `
+		max_token_lifetime_days = 90
+		token_usage {
+			group_names             = ["data-engineers"]
+			service_principal_names = ["abc"]
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                          "_",
+		"tokens_enabled":              true,
+		"max_token_lifetime_days":     90,
+		"token_usage.#":               1,
+		"token_usage.0.group_names.#": 1,
+		"token_usage.0.service_principal_names.#": 1,
+		"token_usage.0.user_names.#":              0,
+	})
+}
+
+func TestWorkspaceApiTokenPolicyCreate_WithoutTokenUsage(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableTokensConfig":   "false",
+					"maxTokenLifetimeDays": "",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: tokenPolicyConfGet,
+				Response: map[string]any{
+					"enableTokensConfig": "false",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		HCL:      `tokens_enabled = false`,
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                      "_",
+		"tokens_enabled":          false,
+		"max_token_lifetime_days": 0,
+		"token_usage.#":           0,
+	})
+}
+
+func TestWorkspaceApiTokenPolicyUpdate_RemoveTokenUsage(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/authorization/tokens?",
+				Response: map[string]any{
+					"object_id":   "/authorization/tokens",
+					"object_type": "tokens",
+					"access_control_list": []any{
+						map[string]any{
+							"group_name": "admins",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_MANAGE"},
+							},
+						},
+						map[string]any{
+							"group_name": "users",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+						map[string]any{
+							"group_name": "ops",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/authorization/tokens",
+				ExpectedRequest: map[string]any{
+					"access_control_list": []any{
+						map[string]any{
+							"group_name":       "admins",
+							"permission_level": "CAN_MANAGE",
+						},
+						map[string]any{
+							"group_name":       "ops",
+							"permission_level": "CAN_USE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: tokenPolicyConfGet,
+				Response: map[string]any{
+					"enableTokensConfig":   "true",
+					"maxTokenLifetimeDays": "30",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		InstanceState: map[string]string{
+			"tokens_enabled":              "true",
+			"max_token_lifetime_days":     "30",
+			"token_usage.#":               "1",
+			"token_usage.0.group_names.#": "1",
+			"token_usage.0.group_names.0": "users",
+		},
+		HCL:    `max_token_lifetime_days = 30`,
+		Update: true,
+		ID:     "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"max_token_lifetime_days": 30,
+		"token_usage.#":           0,
+	})
+}
+
+func TestWorkspaceApiTokenPolicyRead_InvalidValue(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: tokenPolicyConfGet,
+				Response: map[string]any{
+					"maxTokenLifetimeDays": "forever",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		Read:     true,
+		ID:       "_",
+	}.ExpectError(t, "invalid value of maxTokenLifetimeDays: forever")
+}
+
+func TestWorkspaceApiTokenPolicyDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableTokensConfig":   "true",
+					"maxTokenLifetimeDays": "",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/authorization/tokens?",
+				Response: map[string]any{
+					"object_id":   "/authorization/tokens",
+					"object_type": "tokens",
+					"access_control_list": []any{
+						map[string]any{
+							"group_name": "admins",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_MANAGE"},
+							},
+						},
+						map[string]any{
+							"group_name": "users",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+						map[string]any{
+							"group_name": "ops",
+							"all_permissions": []any{
+								map[string]any{"permission_level": "CAN_USE"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/authorization/tokens",
+				ExpectedRequest: map[string]any{
+					"access_control_list": []any{
+						map[string]any{
+							"group_name":       "admins",
+							"permission_level": "CAN_MANAGE",
+						},
+						map[string]any{
+							"group_name":       "ops",
+							"permission_level": "CAN_USE",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		InstanceState: map[string]string{
+			"tokens_enabled":              "true",
+			"max_token_lifetime_days":     "30",
+			"token_usage.#":               "1",
+			"token_usage.0.group_names.#": "1",
+			"token_usage.0.group_names.0": "users",
+		},
+		Delete: true,
+		ID:     "_",
+	}.ApplyNoError(t)
+}
+
+func TestWorkspaceApiTokenPolicyRead_TokensDisabled(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: tokenPolicyConfGet,
+				Response: map[string]any{
+					"enableTokensConfig": "false",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceApiTokenPolicy(),
+		InstanceState: map[string]string{
+			"tokens_enabled": "true",
+		},
+		Read: true,
+		ID:   "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"tokens_enabled": false,
+	})
+}
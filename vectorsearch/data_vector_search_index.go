@@ -0,0 +1,46 @@
+package vectorsearch
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataSourceVectorSearchIndex exposes readiness of a vector search index, so that dependent resources,
+// e.g. serving endpoints, are only updated once the index is current
+func DataSourceVectorSearchIndex() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id              string `json:"id,omitempty" tf:"computed"`
+		Name            string `json:"name"`
+		EndpointName    string `json:"endpoint_name,omitempty" tf:"computed"`
+		IndexType       string `json:"index_type,omitempty" tf:"computed"`
+		PrimaryKey      string `json:"primary_key,omitempty" tf:"computed"`
+		SourceTable     string `json:"source_table,omitempty" tf:"computed"`
+		PipelineId      string `json:"pipeline_id,omitempty" tf:"computed"`
+		Ready           bool   `json:"ready,omitempty" tf:"computed"`
+		IndexedRowCount int64  `json:"indexed_row_count,omitempty" tf:"computed"`
+		Message         string `json:"message,omitempty" tf:"computed"`
+		IndexUrl        string `json:"index_url,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		index, err := w.VectorSearchIndexes.GetIndexByIndexName(ctx, data.Name)
+		if err != nil {
+			return err
+		}
+		data.Id = index.Name
+		data.EndpointName = index.EndpointName
+		data.IndexType = string(index.IndexType)
+		data.PrimaryKey = index.PrimaryKey
+		if index.DeltaSyncIndexSpec != nil {
+			data.SourceTable = index.DeltaSyncIndexSpec.SourceTable
+			data.PipelineId = index.DeltaSyncIndexSpec.PipelineId
+		}
+		if index.Status != nil {
+			data.Ready = index.Status.Ready
+			data.IndexedRowCount = index.Status.IndexedRowCount
+			data.Message = index.Status.Message
+			data.IndexUrl = index.Status.IndexUrl
+		}
+		return nil
+	})
+}
@@ -0,0 +1,49 @@
+package vectorsearch
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVectorSearchIndexDataSource(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockVectorSearchIndexesAPI().EXPECT().GetIndexByIndexName(mock.Anything, "main.default.idx").Return(&vectorsearch.VectorIndex{
+				Name:         "main.default.idx",
+				EndpointName: "test",
+				PrimaryKey:   "id",
+				IndexType:    "DELTA_SYNC",
+				DeltaSyncIndexSpec: &vectorsearch.DeltaSyncVectorIndexSpecResponse{
+					SourceTable: "main.default.test",
+					PipelineId:  "pipeline",
+				},
+				Status: &vectorsearch.VectorIndexStatus{
+					Ready:           true,
+					IndexedRowCount: 42,
+					Message:         "Index is ready",
+					IndexUrl:        "https://example.com/idx",
+				},
+			}, nil)
+		},
+		Resource:    DataSourceVectorSearchIndex(),
+		HCL:         `name = "main.default.idx"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                "main.default.idx",
+		"endpoint_name":     "test",
+		"index_type":        "DELTA_SYNC",
+		"primary_key":       "id",
+		"source_table":      "main.default.test",
+		"pipeline_id":       "pipeline",
+		"ready":             true,
+		"indexed_row_count": 42,
+		"message":           "Index is ready",
+		"index_url":         "https://example.com/idx",
+	})
+}
@@ -9,8 +9,8 @@ import (
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/terraform-provider-databricks/common"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
@@ -18,35 +18,104 @@ import (
 
 const defaultIndexProvisionTimeout = 15 * time.Minute
 
-func waitForVectorSearchIndexDeletion(w *databricks.WorkspaceClient, ctx context.Context, searchIndexName string) error {
-	return retry.RetryContext(ctx, defaultIndexProvisionTimeout, func() *retry.RetryError {
+func waitForVectorSearchIndexDeletion(w *databricks.WorkspaceClient, ctx context.Context, searchIndexName string, timeout time.Duration) error {
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		_, err := w.VectorSearchIndexes.GetIndexByIndexName(ctx, searchIndexName)
 		if err == nil {
-			return retry.RetryableError(fmt.Errorf("vector search index %s is still not deleted", searchIndexName))
+			return common.InProgress("vector search index %s is still not deleted", searchIndexName), nil
 		}
 		if errors.Is(err, apierr.ErrResourceDoesNotExist) || errors.Is(err, apierr.ErrNotFound) {
-			return nil
+			return common.Completed(), nil
 		}
-		return retry.NonRetryableError(fmt.Errorf("vector search index %w", err))
+		return common.Progress{}, fmt.Errorf("vector search index %w", err)
 	})
 }
 
-func waitForSearchIndexCreation(w *databricks.WorkspaceClient, ctx context.Context, searchIndexName string) error {
-	return retry.RetryContext(ctx, defaultIndexProvisionTimeout-deleteCallTimeout, func() *retry.RetryError {
+func waitForSearchIndexCreation(w *databricks.WorkspaceClient, ctx context.Context, searchIndexName string, timeout time.Duration) error {
+	return common.WaitForProgress(ctx, timeout, func(ctx context.Context) (common.Progress, error) {
 		index, err := w.VectorSearchIndexes.GetIndexByIndexName(ctx, searchIndexName)
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return common.Progress{}, err
+		}
+		if index.Status == nil {
+			return common.InProgress("vector search index %s status is not available yet", searchIndexName), nil
 		}
 		if index.Status.Ready { // We really need to depend on the detailed status of the index, but it's not available in the API yet
-			return nil
+			return common.Completed(), nil
+		}
+		progress := common.InProgress("vector search index %s is still pending", searchIndexName)
+		if index.Status.Message != "" {
+			progress.Message += ": " + index.Status.Message
 		}
-		return retry.RetryableError(fmt.Errorf("vector search index %s is still pending", searchIndexName))
+		return progress, nil
 	})
 }
 
+// syncVectorSearchIndex triggers synchronization of a delta sync index with its source table
+// and waits until the index is ready for search again
+func syncVectorSearchIndex(w *databricks.WorkspaceClient, ctx context.Context, searchIndexName string, timeout time.Duration) error {
+	err := w.VectorSearchIndexes.SyncIndex(ctx, vectorsearch.SyncIndexRequest{
+		IndexName: searchIndexName,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot sync vector search index %s: %w", searchIndexName, err)
+	}
+	return waitForSearchIndexCreation(w, ctx, searchIndexName, timeout)
+}
+
+// validateVectorSearchIndexSpec checks, that the index specification matches the index type and that embeddings
+// are configured completely, so that misconfigurations are reported on plan and not after the index creation timed out
+func validateVectorSearchIndexSpec(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("index_type") {
+		return nil
+	}
+	specs := map[string]string{
+		string(vectorsearch.VectorIndexTypeDeltaSync):    "delta_sync_index_spec",
+		string(vectorsearch.VectorIndexTypeDirectAccess): "direct_access_index_spec",
+	}
+	indexType := d.Get("index_type").(string)
+	spec, ok := specs[indexType]
+	if !ok {
+		return nil
+	}
+	if len(d.Get(spec).([]any)) == 0 {
+		return fmt.Errorf("%s is required for %s indexes", spec, indexType)
+	}
+	sourceColumns := d.Get(spec + ".0.embedding_source_columns").([]any)
+	vectorColumns := d.Get(spec + ".0.embedding_vector_columns").([]any)
+	if len(sourceColumns) == 0 && len(vectorColumns) == 0 {
+		return fmt.Errorf("%s must have either embedding_source_columns or embedding_vector_columns", spec)
+	}
+	for i := range sourceColumns {
+		// the model endpoint is mandatory for computing embeddings of delta sync indexes,
+		// but is used only at query time for direct access indexes
+		key := fmt.Sprintf("%s.0.embedding_source_columns.%d.embedding_model_endpoint_name", spec, i)
+		if spec == "delta_sync_index_spec" && d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("%s is required", key)
+		}
+	}
+	for i := range vectorColumns {
+		key := fmt.Sprintf("%s.0.embedding_vector_columns.%d.embedding_dimension", spec, i)
+		if d.NewValueKnown(key) && d.Get(key).(int) <= 0 {
+			return fmt.Errorf("%s must be positive", key)
+		}
+	}
+	if spec == "direct_access_index_spec" && d.NewValueKnown(spec+".0.schema_json") &&
+		d.Get(spec+".0.schema_json").(string) == "" {
+		return fmt.Errorf("%s.0.schema_json is required for %s indexes", spec, indexType)
+	}
+	return nil
+}
+
+type VectorSearchIndex struct {
+	vectorsearch.VectorIndex
+	// SyncTriggers are arbitrary values, that trigger a sync of the index when changed, e.g. the version of the source table
+	SyncTriggers map[string]string `json:"sync_triggers,omitempty"`
+}
+
 func ResourceVectorSearchIndex() common.Resource {
 	s := common.StructToSchema(
-		vectorsearch.VectorIndex{},
+		VectorSearchIndex{},
 		func(s map[string]*schema.Schema) map[string]*schema.Schema {
 			common.MustSchemaPath(s, "delta_sync_index_spec", "embedding_vector_columns").MinItems = 1
 			exof := []string{"delta_sync_index_spec", "direct_access_index_spec"}
@@ -61,6 +130,30 @@ func ResourceVectorSearchIndex() common.Resource {
 			common.CustomizeSchemaPath(s, "name").SetRequired()
 			common.CustomizeSchemaPath(s, "index_type").SetRequired()
 			common.CustomizeSchemaPath(s, "delta_sync_index_spec", "pipeline_id").SetReadOnly()
+			common.CustomizeSchemaPath(s, "index_type").SetValidateFunc(validation.StringInSlice([]string{
+				string(vectorsearch.VectorIndexTypeDeltaSync),
+				string(vectorsearch.VectorIndexTypeDirectAccess),
+			}, false))
+			common.CustomizeSchemaPath(s, "delta_sync_index_spec", "pipeline_type").SetValidateFunc(validation.StringInSlice([]string{
+				string(vectorsearch.PipelineTypeTriggered),
+				string(vectorsearch.PipelineTypeContinuous),
+			}, false))
+			// only a sync could be triggered in place, any other change recreates the index. Update is defined,
+			// so nested attributes have to be marked explicitly, the same way as common.Resource does without Update
+			queue := []map[string]*schema.Schema{s}
+			for len(queue) > 0 {
+				head := queue[0]
+				queue = queue[1:]
+				for k, v := range head {
+					if v.Computed || k == "sync_triggers" {
+						continue
+					}
+					if nested, ok := v.Elem.(*schema.Resource); ok {
+						queue = append(queue, nested.Schema)
+					}
+					v.ForceNew = true
+				}
+			}
 			return s
 		})
 
@@ -76,7 +169,8 @@ func ResourceVectorSearchIndex() common.Resource {
 			if err != nil {
 				return err
 			}
-			err = waitForSearchIndexCreation(w, ctx, req.Name)
+			// leave some time to clean up the index, if it isn't ready in time
+			err = waitForSearchIndexCreation(w, ctx, req.Name, d.Timeout(schema.TimeoutCreate)-deleteCallTimeout)
 			if err != nil {
 				nestedErr := w.VectorSearchIndexes.DeleteIndexByIndexName(ctx, req.Name)
 				if nestedErr != nil {
@@ -98,6 +192,23 @@ func ResourceVectorSearchIndex() common.Resource {
 			}
 			return common.StructToData(*index, s, d)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if !d.HasChange("sync_triggers") {
+				return nil
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return syncVectorSearchIndex(w, ctx, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			triggers := d.Get("sync_triggers").(map[string]any)
+			if len(triggers) > 0 && d.Get("index_type").(string) != string(vectorsearch.VectorIndexTypeDeltaSync) {
+				return fmt.Errorf("sync_triggers could only be used with %s indexes", vectorsearch.VectorIndexTypeDeltaSync)
+			}
+			return validateVectorSearchIndexSpec(d)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -107,13 +218,15 @@ func ResourceVectorSearchIndex() common.Resource {
 			if err != nil {
 				return err
 			}
-			return waitForVectorSearchIndexDeletion(w, ctx, d.Id())
+			return waitForVectorSearchIndexDeletion(w, ctx, d.Id(), d.Timeout(schema.TimeoutDelete))
 		},
 		StateUpgraders: []schema.StateUpgrader{},
 		Schema:         s,
 		SchemaVersion:  0,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(defaultIndexProvisionTimeout),
+			Update: schema.DefaultTimeout(defaultIndexProvisionTimeout),
+			Delete: schema.DefaultTimeout(defaultIndexProvisionTimeout),
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
 
@@ -111,3 +112,225 @@ func TestVectorSearchIndexDelete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "abc", d.Id())
 }
+
+func TestVectorSearchIndexUpdate_SyncTriggers(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockVectorSearchIndexesAPI().EXPECT()
+			e.SyncIndex(mock.Anything, vectorsearch.SyncIndexRequest{IndexName: "abc"}).Return(nil)
+			e.GetIndexByIndexName(mock.Anything, "abc").Return(indexResponse, nil)
+		},
+		Resource: ResourceVectorSearchIndex(),
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":                                  "abc",
+			"endpoint_name":                         "test",
+			"primary_key":                           "id",
+			"index_type":                            "DELTA_SYNC",
+			"delta_sync_index_spec.#":               "1",
+			"delta_sync_index_spec.0.source_table":  "main.default.test",
+			"delta_sync_index_spec.0.pipeline_type": "TRIGGERED",
+			"delta_sync_index_spec.0.embedding_source_columns.#":                               "1",
+			"delta_sync_index_spec.0.embedding_source_columns.0.name":                          "text",
+			"delta_sync_index_spec.0.embedding_source_columns.0.embedding_model_endpoint_name": "e5_small_v2",
+			"sync_triggers.%":       "1",
+			"sync_triggers.version": "1",
+		},
+		HCL: indexHcl + `
+		sync_triggers = {
+			version = "2"
+		}`,
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", d.Get("sync_triggers.version"))
+}
+
+func TestVectorSearchIndexSyncTriggers_DirectAccess(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceVectorSearchIndex(),
+		HCL: `
+		name          = "abc"
+		endpoint_name = "test"
+		primary_key   = "id"
+		index_type    = "DIRECT_ACCESS"
+		direct_access_index_spec {
+			schema_json = "{}"
+		}
+		sync_triggers = {
+			version = "1"
+		}`,
+		Create: true,
+	}.ExpectError(t, "sync_triggers could only be used with DELTA_SYNC indexes")
+}
+
+func TestVectorSearchIndexCreate_DirectAccess(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockVectorSearchIndexesAPI().EXPECT()
+			e.CreateIndex(mock.Anything, vectorsearch.CreateVectorIndexRequest{
+				Name:         "abc",
+				EndpointName: "test",
+				PrimaryKey:   "id",
+				IndexType:    "DIRECT_ACCESS",
+				DirectAccessIndexSpec: &vectorsearch.DirectAccessVectorIndexSpec{
+					SchemaJson: `{"id": "integer", "vector": "array<float>"}`,
+					EmbeddingVectorColumns: []vectorsearch.EmbeddingVectorColumn{
+						{
+							Name:               "vector",
+							EmbeddingDimension: 1024,
+						},
+					},
+				},
+			}).Return(&vectorsearch.CreateVectorIndexResponse{}, nil)
+			e.GetIndexByIndexName(mock.Anything, "abc").Return(&vectorsearch.VectorIndex{
+				Name:         "abc",
+				EndpointName: "test",
+				PrimaryKey:   "id",
+				IndexType:    "DIRECT_ACCESS",
+				DirectAccessIndexSpec: &vectorsearch.DirectAccessVectorIndexSpec{
+					SchemaJson: `{"id": "integer", "vector": "array<float>"}`,
+					EmbeddingVectorColumns: []vectorsearch.EmbeddingVectorColumn{
+						{
+							Name:               "vector",
+							EmbeddingDimension: 1024,
+						},
+					},
+				},
+				Status: &vectorsearch.VectorIndexStatus{
+					Ready: true,
+				},
+			}, nil)
+		},
+		Resource: ResourceVectorSearchIndex(),
+		HCL: `
+		name          = "abc"
+		endpoint_name = "test"
+		primary_key   = "id"
+		index_type    = "DIRECT_ACCESS"
+		direct_access_index_spec {
+			schema_json = "{\"id\": \"integer\", \"vector\": \"array<float>\"}"
+			embedding_vector_columns {
+				name                = "vector"
+				embedding_dimension = 1024
+			}
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 1024, d.Get("direct_access_index_spec.0.embedding_vector_columns.0.embedding_dimension"))
+}
+
+func TestVectorSearchIndexValidation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hcl string
+		err string
+	}{
+		"spec of other index type": {
+			hcl: `index_type = "DELTA_SYNC"
+			direct_access_index_spec {
+				schema_json = "{}"
+			}`,
+			err: "delta_sync_index_spec is required for DELTA_SYNC indexes",
+		},
+		"no embeddings": {
+			hcl: `index_type = "DELTA_SYNC"
+			delta_sync_index_spec {
+				source_table = "main.default.test"
+			}`,
+			err: "delta_sync_index_spec must have either embedding_source_columns or embedding_vector_columns",
+		},
+		"no model endpoint": {
+			hcl: `index_type = "DELTA_SYNC"
+			delta_sync_index_spec {
+				source_table = "main.default.test"
+				embedding_source_columns {
+					name = "text"
+				}
+			}`,
+			err: "delta_sync_index_spec.0.embedding_source_columns.0.embedding_model_endpoint_name is required",
+		},
+		"no dimension": {
+			hcl: `index_type = "DIRECT_ACCESS"
+			direct_access_index_spec {
+				schema_json = "{}"
+				embedding_vector_columns {
+					name = "vector"
+				}
+			}`,
+			err: "direct_access_index_spec.0.embedding_vector_columns.0.embedding_dimension must be positive",
+		},
+		"no schema": {
+			hcl: `index_type = "DIRECT_ACCESS"
+			direct_access_index_spec {
+				embedding_vector_columns {
+					name                = "vector"
+					embedding_dimension = 1024
+				}
+			}`,
+			err: "direct_access_index_spec.0.schema_json is required for DIRECT_ACCESS indexes",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			qa.ResourceFixture{
+				Resource: ResourceVectorSearchIndex(),
+				HCL: `
+				name          = "abc"
+				endpoint_name = "test"
+				primary_key   = "id"
+				` + tc.hcl,
+				Create: true,
+			}.ExpectError(t, tc.err)
+		})
+	}
+}
+
+func TestVectorSearchIndexSourceTableChangeRequiresNew(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceVectorSearchIndex(),
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":                                  "abc",
+			"endpoint_name":                         "test",
+			"primary_key":                           "id",
+			"index_type":                            "DELTA_SYNC",
+			"delta_sync_index_spec.#":               "1",
+			"delta_sync_index_spec.0.source_table":  "main.default.old",
+			"delta_sync_index_spec.0.pipeline_type": "TRIGGERED",
+			"delta_sync_index_spec.0.embedding_source_columns.#":                               "1",
+			"delta_sync_index_spec.0.embedding_source_columns.0.name":                          "text",
+			"delta_sync_index_spec.0.embedding_source_columns.0.embedding_model_endpoint_name": "e5_small_v2",
+		},
+		HCL: `
+		name          = "abc"
+		endpoint_name = "test"
+		primary_key   = "id"
+		index_type    = "DELTA_SYNC"
+		delta_sync_index_spec {
+			source_table  = "main.default.new"
+			pipeline_type = "TRIGGERED"
+			embedding_source_columns {
+				name                          = "text"
+				embedding_model_endpoint_name = "e5_small_v2"
+			}
+		}`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"creator":                 {NewComputed: true},
+			"status.#":                {NewComputed: true},
+			"name":                    {Old: "abc", New: "abc"},
+			"endpoint_name":           {Old: "test", New: "test"},
+			"primary_key":             {Old: "id", New: "id"},
+			"index_type":              {Old: "DELTA_SYNC", New: "DELTA_SYNC"},
+			"delta_sync_index_spec.#": {Old: "1", New: "1"},
+			"delta_sync_index_spec.0.source_table": {
+				Old: "main.default.old", New: "main.default.new", RequiresNew: true},
+			"delta_sync_index_spec.0.pipeline_id":                     {NewComputed: true},
+			"delta_sync_index_spec.0.pipeline_type":                   {Old: "TRIGGERED", New: "TRIGGERED"},
+			"delta_sync_index_spec.0.embedding_source_columns.#":      {Old: "1", New: "1"},
+			"delta_sync_index_spec.0.embedding_source_columns.0.name": {Old: "text", New: "text"},
+			"delta_sync_index_spec.0.embedding_source_columns.0.embedding_model_endpoint_name": {
+				Old: "e5_small_v2", New: "e5_small_v2"},
+		},
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,53 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// knownWorkspaceConfKeys are documented workspace configuration keys, that are read when no keys are specified.
+// Keys that aren't supported by a particular workspace are skipped by getWorkspaceConf
+var knownWorkspaceConfKeys = []string{
+	"enableDbfsFileBrowser",
+	"enableDeprecatedGlobalInitScripts",
+	"enableExportNotebook",
+	"enableIpAccessLists",
+	"enableNotebookTableClipboard",
+	"enableResultsDownloading",
+	"enableTokensConfig",
+	"enableUploadDataUis",
+	"enableVerboseAuditLogs",
+	"enableWebTerminal",
+	"enforceUserIsolation",
+	"maxTokenLifetimeDays",
+	"storeInteractiveNotebookResultsInCustomerAccount",
+}
+
+// DataSourceWorkspaceConf returns current values of workspace configuration keys, so that settings
+// which aren't managed by databricks_workspace_conf could be checked
+func DataSourceWorkspaceConf() common.Resource {
+	type workspaceConfData struct {
+		Keys   []string          `json:"keys,omitempty" tf:"computed"`
+		Config map[string]string `json:"config,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *workspaceConfData, w *databricks.WorkspaceClient) error {
+		if len(data.Keys) == 0 {
+			data.Keys = knownWorkspaceConfKeys
+		}
+		conf, err := getWorkspaceConf(ctx, w, data.Keys)
+		if err != nil {
+			return err
+		}
+		data.Config = map[string]string{}
+		for k, v := range conf {
+			// keys that were never set are returned without a value
+			if v == "" {
+				continue
+			}
+			data.Config[k] = v
+		}
+		return nil
+	})
+}
@@ -0,0 +1,105 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceWorkspaceConf(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists%2CmaxTokenLifetimeDays%2CenableWebTerminal",
+				Response: map[string]any{
+					"enableIpAccessLists":  "true",
+					"maxTokenLifetimeDays": "90",
+					"enableWebTerminal":    "",
+				},
+			},
+		},
+		Resource:    DataSourceWorkspaceConf(),
+		HCL:         `keys = ["enableIpAccessLists", "maxTokenLifetimeDays", "enableWebTerminal"]`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"config.%":                    "2",
+		"config.enableIpAccessLists":  "true",
+		"config.maxTokenLifetimeDays": "90",
+	})
+}
+
+func TestDataSourceWorkspaceConf_KnownKeys(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableDbfsFileBrowser%2CenableDeprecatedGlobalInitScripts%2CenableExportNotebook%2CenableIpAccessLists%2CenableNotebookTableClipboard%2CenableResultsDownloading%2CenableTokensConfig%2CenableUploadDataUis%2CenableVerboseAuditLogs%2CenableWebTerminal%2CenforceUserIsolation%2CmaxTokenLifetimeDays%2CstoreInteractiveNotebookResultsInCustomerAccount",
+				Response: map[string]any{
+					"enableTokensConfig": "true",
+				},
+			},
+		},
+		Resource:    DataSourceWorkspaceConf(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"keys.#":                    len(knownWorkspaceConfKeys),
+		"config.%":                  "1",
+		"config.enableTokensConfig": "true",
+	})
+}
+
+func TestDataSourceWorkspaceConf_UnknownKey(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists%2CenableSomethingNew",
+				Status:   400,
+				Response: map[string]any{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Invalid keys: [\"enableSomethingNew\"]",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]any{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableSomethingNew",
+				Status:   400,
+				Response: map[string]any{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Invalid keys: [\"enableSomethingNew\"]",
+				},
+			},
+		},
+		Resource:    DataSourceWorkspaceConf(),
+		HCL:         `keys = ["enableIpAccessLists", "enableSomethingNew"]`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"config.%":                   "1",
+		"config.enableIpAccessLists": "true",
+	})
+}
+
+func TestDataSourceWorkspaceConf_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceWorkspaceConf(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,42 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataSourceWorkspaceObject resolves any workspace path into its object ID and type
+func DataSourceWorkspaceObject() common.Resource {
+	type WorkspaceObject struct {
+		Id            string `json:"id,omitempty" tf:"computed"`
+		Path          string `json:"path"`
+		ObjectType    string `json:"object_type,omitempty" tf:"computed"`
+		ObjectId      int64  `json:"object_id,omitempty" tf:"computed"`
+		ResourceId    string `json:"resource_id,omitempty" tf:"computed"`
+		Language      string `json:"language,omitempty" tf:"computed"`
+		WorkspacePath string `json:"workspace_path,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, d *WorkspaceObject, client *databricks.WorkspaceClient) error {
+		data, err := common.RetryOnTimeout(ctx, func(ctx context.Context) (*workspace.ObjectInfo, error) {
+			return client.Workspace.GetStatusByPath(ctx, d.Path)
+		})
+		if err != nil {
+			return err
+		}
+		// object_type is only checked, when it's specified in the configuration
+		if d.ObjectType != "" && d.ObjectType != string(data.ObjectType) {
+			return fmt.Errorf("'%s' is %s, not %s", d.Path, data.ObjectType, d.ObjectType)
+		}
+		d.Id = data.Path
+		d.ObjectType = string(data.ObjectType)
+		d.ObjectId = data.ObjectId
+		d.ResourceId = data.ResourceId
+		d.Language = string(data.Language)
+		d.WorkspacePath = "/Workspace" + data.Path
+		return nil
+	})
+}
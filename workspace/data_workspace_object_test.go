@@ -0,0 +1,98 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceWorkspaceObject(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb%2Fc",
+				Response: ObjectStatus{
+					ObjectID:   987,
+					Language:   "PYTHON",
+					ObjectType: "NOTEBOOK",
+					Path:       "/a/b/c",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceWorkspaceObject(),
+		ID:          ".",
+		HCL:         `path = "/a/b/c"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":             "/a/b/c",
+		"object_id":      987,
+		"object_type":    "NOTEBOOK",
+		"language":       "PYTHON",
+		"workspace_path": "/Workspace/a/b/c",
+	})
+}
+
+func TestDataSourceWorkspaceObject_Dashboard(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fsales.lvdash.json",
+				Response: map[string]any{
+					"object_id":   123,
+					"object_type": "DASHBOARD",
+					"path":        "/a/sales.lvdash.json",
+					"resource_id": "01ef",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceWorkspaceObject(),
+		ID:          ".",
+		HCL: `
+		path        = "/a/sales.lvdash.json"
+		object_type = "DASHBOARD"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          "/a/sales.lvdash.json",
+		"object_id":   123,
+		"object_type": "DASHBOARD",
+		"resource_id": "01ef",
+	})
+}
+
+func TestDataSourceWorkspaceObject_WrongType(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb%2Fc",
+				Response: ObjectStatus{
+					ObjectID:   987,
+					ObjectType: "DIRECTORY",
+					Path:       "/a/b/c",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceWorkspaceObject(),
+		ID:          ".",
+		HCL: `
+		path        = "/a/b/c"
+		object_type = "NOTEBOOK"`,
+	}.ExpectError(t, "'/a/b/c' is DIRECTORY, not NOTEBOOK")
+}
+
+func TestDataSourceWorkspaceObject_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceWorkspaceObject(),
+		ID:          ".",
+		HCL:         `path = "/a/b/c"`,
+	}.ExpectError(t, "i'm a teapot")
+}
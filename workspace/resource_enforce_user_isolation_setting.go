@@ -0,0 +1,62 @@
+package workspace
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const enforceUserIsolationConfKey = "enforceUserIsolation"
+
+func setEnforceUserIsolation(ctx context.Context, c *common.DatabricksClient, enabled bool) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	return w.WorkspaceConf.SetStatus(ctx, settings.WorkspaceConf{
+		enforceUserIsolationConfKey: strconv.FormatBool(enabled),
+	})
+}
+
+// ResourceEnforceUserIsolationSetting blocks creation and start of clusters without user isolation,
+// so that only shared (USER_ISOLATION) and single user clusters could be used in the workspace
+func ResourceEnforceUserIsolationSetting() common.Resource {
+	apply := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		err := setEnforceUserIsolation(ctx, c, d.Get("enabled").(bool))
+		if err != nil {
+			return err
+		}
+		d.SetId("_")
+		return nil
+	}
+	return common.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+		Create: apply,
+		Update: apply,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			remote, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+				Keys: enforceUserIsolationConfKey,
+			})
+			if err != nil {
+				return err
+			}
+			return d.Set("enabled", strings.EqualFold((*remote)[enforceUserIsolationConfKey], "true"))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return setEnforceUserIsolation(ctx, c, false)
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestEnforceUserIsolationSettingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enforceUserIsolation": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enforceUserIsolation",
+				Response: map[string]any{
+					"enforceUserIsolation": "true",
+				},
+			},
+		},
+		Resource: ResourceEnforceUserIsolationSetting(),
+		HCL:      `enabled = true`,
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":      "_",
+		"enabled": true,
+	})
+}
+
+func TestEnforceUserIsolationSettingRead_NotSet(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enforceUserIsolation",
+				Response: map[string]any{},
+			},
+		},
+		Resource: ResourceEnforceUserIsolationSetting(),
+		Read:     true,
+		ID:       "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"enabled": false,
+	})
+}
+
+func TestEnforceUserIsolationSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enforceUserIsolation": "false",
+				},
+			},
+		},
+		Resource: ResourceEnforceUserIsolationSetting(),
+		HCL:      `enabled = true`,
+		Delete:   true,
+		ID:       "_",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,129 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// orderedGlobalInitScripts returns ids of global init scripts sorted by their execution order
+func orderedGlobalInitScripts(ctx context.Context, w *databricks.WorkspaceClient) ([]string, error) {
+	scripts, err := w.GlobalInitScripts.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(scripts, func(i, j int) bool {
+		return scripts[i].Position < scripts[j].Position
+	})
+	ids := make([]string, 0, len(scripts))
+	for _, script := range scripts {
+		ids = append(ids, script.ScriptId)
+	}
+	return ids, nil
+}
+
+// applyGlobalInitScriptOrder moves the configured scripts to the first positions in the given order,
+// so that scripts that aren't managed by this resource run after them
+func applyGlobalInitScriptOrder(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	for position, v := range d.Get("script_ids").([]any) {
+		scriptId := v.(string)
+		script, err := w.GlobalInitScripts.GetByScriptId(ctx, scriptId)
+		if err != nil {
+			return fmt.Errorf("cannot get global init script %s: %w", scriptId, err)
+		}
+		if script.Position == position {
+			continue
+		}
+		// inserting a script at a position shifts all the following scripts down
+		err = w.GlobalInitScripts.Update(ctx, compute.GlobalInitScriptUpdateRequest{
+			ScriptId:        scriptId,
+			Name:            script.Name,
+			Script:          script.Script,
+			Enabled:         script.Enabled,
+			Position:        position,
+			ForceSendFields: []string{"Position"},
+		})
+		if err != nil {
+			return fmt.Errorf("cannot move global init script %s to position %d: %w", scriptId, position, err)
+		}
+	}
+	d.SetId("_")
+	return nil
+}
+
+// ResourceGlobalInitScriptOrder manages the relative execution order of global init scripts
+func ResourceGlobalInitScriptOrder() common.Resource {
+	return common.Resource{
+		Schema: map[string]*schema.Schema{
+			"script_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			seen := map[string]bool{}
+			for _, v := range d.Get("script_ids").([]any) {
+				scriptId, _ := v.(string)
+				if scriptId == "" {
+					// not known until apply
+					continue
+				}
+				if seen[scriptId] {
+					return fmt.Errorf("global init script %s is specified more than once", scriptId)
+				}
+				seen[scriptId] = true
+			}
+			return nil
+		},
+		Create: applyGlobalInitScriptOrder,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			managed := map[string]bool{}
+			for _, v := range d.Get("script_ids").([]any) {
+				managed[v.(string)] = true
+			}
+			ordered, err := orderedGlobalInitScripts(ctx, w)
+			if err != nil {
+				return err
+			}
+			if len(managed) == 0 {
+				// all scripts are read on import
+				return d.Set("script_ids", ordered)
+			}
+			// managed scripts must take the first positions, so every script up to the last managed one is
+			// reported. Scripts that were moved in front of managed ones outside of Terraform show up as drift,
+			// while deleted scripts drop out of the list.
+			last := -1
+			for i, scriptId := range ordered {
+				if managed[scriptId] {
+					last = i
+				}
+			}
+			return d.Set("script_ids", ordered[:last+1])
+		},
+		Update: applyGlobalInitScriptOrder,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// scripts keep their current positions
+			return nil
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestResourceGlobalInitScriptOrderCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts/a?",
+				Response: compute.GlobalInitScriptDetailsWithContent{
+					ScriptId: "a",
+					Name:     "first",
+					Position: 1,
+					Enabled:  true,
+					Script:   "ZWNobyBoZWxsbw==",
+				},
+			},
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/global-init-scripts/a",
+				ExpectedRequest: map[string]any{
+					"name":     "first",
+					"position": 0,
+					"enabled":  true,
+					"script":   "ZWNobyBoZWxsbw==",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts/b?",
+				Response: compute.GlobalInitScriptDetailsWithContent{
+					ScriptId: "b",
+					Name:     "second",
+					Position: 1,
+					Script:   "ZWNobyBoZWxsbw==",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts",
+				Response: compute.ListGlobalInitScriptsResponse{
+					Scripts: []compute.GlobalInitScriptDetails{
+						{ScriptId: "c", Position: 2},
+						{ScriptId: "b", Position: 1},
+						{ScriptId: "a", Position: 0},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptOrder(),
+		HCL:      `script_ids = ["a", "b"]`,
+		Create:   true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":           "_",
+		"script_ids.#": 2,
+		"script_ids.0": "a",
+		"script_ids.1": "b",
+	})
+}
+
+func TestResourceGlobalInitScriptOrderRead_Drift(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts",
+				Response: compute.ListGlobalInitScriptsResponse{
+					Scripts: []compute.GlobalInitScriptDetails{
+						{ScriptId: "b", Position: 0},
+						{ScriptId: "c", Position: 1},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptOrder(),
+		InstanceState: map[string]string{
+			"script_ids.#": "3",
+			"script_ids.0": "a",
+			"script_ids.1": "b",
+			"script_ids.2": "c",
+		},
+		HCL:  `script_ids = ["a", "b", "c"]`,
+		Read: true,
+		New:  true,
+		ID:   "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"script_ids.#": 2,
+		"script_ids.0": "b",
+		"script_ids.1": "c",
+	})
+}
+
+func TestResourceGlobalInitScriptOrderRead_UnmanagedScripts(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts",
+				Response: compute.ListGlobalInitScriptsResponse{
+					Scripts: []compute.GlobalInitScriptDetails{
+						{ScriptId: "x", Position: 0},
+						{ScriptId: "a", Position: 1},
+						{ScriptId: "b", Position: 2},
+						{ScriptId: "y", Position: 3},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptOrder(),
+		InstanceState: map[string]string{
+			"script_ids.#": "2",
+			"script_ids.0": "a",
+			"script_ids.1": "b",
+		},
+		HCL:  `script_ids = ["a", "b"]`,
+		Read: true,
+		New:  true,
+		ID:   "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"script_ids": []any{"x", "a", "b"},
+	})
+}
+
+func TestResourceGlobalInitScriptOrder_Duplicates(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGlobalInitScriptOrder(),
+		HCL:      `script_ids = ["a", "b", "a"]`,
+		Create:   true,
+	}.ExpectError(t, "global init script a is specified more than once")
+}
+
+func TestResourceGlobalInitScriptOrderDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGlobalInitScriptOrder(),
+		Delete:   true,
+		ID:       "_",
+	}.ApplyNoError(t)
+}
+
+func TestResourceGlobalInitScriptOrderImport(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts",
+				Response: compute.ListGlobalInitScriptsResponse{
+					Scripts: []compute.GlobalInitScriptDetails{
+						{ScriptId: "b", Position: 1},
+						{ScriptId: "a", Position: 0},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptOrder(),
+		Read:     true,
+		New:      true,
+		ID:       "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"script_ids": []any{"a", "b"},
+	})
+}
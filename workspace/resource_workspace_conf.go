@@ -15,12 +15,42 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/docs"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// getWorkspaceConf reads values of the workspace configuration keys. The API fails the whole request, if one of
+// the keys is unknown to the workspace, so in that case keys are read one by one and unknown keys are skipped.
+func getWorkspaceConf(ctx context.Context, w *databricks.WorkspaceClient, keys []string) (map[string]string, error) {
+	conf, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+		Keys: strings.Join(keys, ","),
+	})
+	if err == nil {
+		return *conf, nil
+	}
+	if !errors.Is(err, apierr.ErrBadRequest) {
+		return nil, err
+	}
+	if len(keys) == 1 {
+		log.Printf("[WARN] Skipping unknown workspace configuration key %s: %s", keys[0], err)
+		return map[string]string{}, nil
+	}
+	result := map[string]string{}
+	for _, key := range keys {
+		value, err := getWorkspaceConf(ctx, w, []string{key})
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range value {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
 // This function applies configuration defined in the resource data to the workspace.
 func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 	o, n := d.GetChange("custom_config")
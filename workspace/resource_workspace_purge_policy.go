@@ -0,0 +1,182 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// workspacePurgeConfKeys maps attributes of databricks_workspace_purge_policy to workspace configuration keys
+var workspacePurgeConfKeys = map[string]string{
+	"trash_retention_days":            "workspaceTrashRetentionDays",
+	"revision_history_retention_days": "notebookRevisionHistoryRetentionDays",
+	"job_run_retention_days":          "jobRunArtifactsRetentionDays",
+	"purge_job_run_artifacts":         "enablePurgeJobRunArtifacts",
+}
+
+func workspacePurgeConfKeyList() []string {
+	keys := make([]string, 0, len(workspacePurgeConfKeys))
+	for _, k := range workspacePurgeConfKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func workspacePurgeConfValue(d *schema.ResourceData, attr string) string {
+	switch v := d.Get(attr).(type) {
+	case int:
+		if v == 0 {
+			return ""
+		}
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func applyWorkspacePurgePolicy(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	// only configured keys are sent, so that keys, which aren't supported by the workspace, fail only when used
+	patch := settings.WorkspaceConf{}
+	for attr, key := range workspacePurgeConfKeys {
+		if _, ok := d.GetOk(attr); (ok && d.IsNewResource()) || (!d.IsNewResource() && d.HasChange(attr)) {
+			patch[key] = workspacePurgeConfValue(d, attr)
+		}
+	}
+	if len(patch) == 0 {
+		d.SetId("_")
+		return nil
+	}
+	err = w.WorkspaceConf.SetStatus(ctx, patch)
+	if err != nil {
+		return err
+	}
+	err = verifyWorkspacePurgeConf(ctx, w, patch)
+	if err != nil {
+		return err
+	}
+	d.SetId("_")
+	return nil
+}
+
+// verifyWorkspacePurgeConf reads the keys back, because the workspace may accept keys that it doesn't support
+// without applying them
+func verifyWorkspacePurgeConf(ctx context.Context, w *databricks.WorkspaceClient, patch settings.WorkspaceConf) error {
+	keys := make([]string, 0, len(patch))
+	for key := range patch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	remote, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+		Keys: strings.Join(keys, ","),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot verify workspace configuration: %w", err)
+	}
+	for _, key := range keys {
+		v, ok := (*remote)[key]
+		if !ok || !strings.EqualFold(v, patch[key]) {
+			return fmt.Errorf("workspace didn't apply %s=%s, the key may not be supported by the workspace",
+				key, patch[key])
+		}
+	}
+	return nil
+}
+
+// ResourceWorkspacePurgePolicy manages retention of deleted workspace objects, notebook revisions and job run artifacts
+func ResourceWorkspacePurgePolicy() common.Resource {
+	return common.Resource{
+		Schema: map[string]*schema.Schema{
+			"trash_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 365),
+			},
+			"revision_history_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"job_run_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"purge_job_run_artifacts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+		Create: applyWorkspacePurgePolicy,
+		Update: applyWorkspacePurgePolicy,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			remote, err := getWorkspaceConf(ctx, w, workspacePurgeConfKeyList())
+			if err != nil {
+				return err
+			}
+			for attr, key := range workspacePurgeConfKeys {
+				v, ok := remote[key]
+				if !ok {
+					if _, configured := d.GetOk(attr); configured {
+						return fmt.Errorf("workspace doesn't support %s, that is used by %s", key, attr)
+					}
+					continue
+				}
+				switch attr {
+				case "purge_job_run_artifacts":
+					d.Set(attr, strings.EqualFold(v, "true"))
+				default:
+					if v == "" {
+						d.Set(attr, 0)
+						continue
+					}
+					days, err := strconv.Atoi(v)
+					if err != nil {
+						return fmt.Errorf("invalid value of %s: %s", key, v)
+					}
+					d.Set(attr, days)
+				}
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			// reset configured keys to the workspace defaults
+			patch := settings.WorkspaceConf{}
+			for attr, key := range workspacePurgeConfKeys {
+				if _, ok := d.GetOk(attr); !ok {
+					continue
+				}
+				if attr == "purge_job_run_artifacts" {
+					patch[key] = "false"
+					continue
+				}
+				patch[key] = ""
+			}
+			if len(patch) == 0 {
+				return nil
+			}
+			return w.WorkspaceConf.SetStatus(ctx, patch)
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+const workspacePurgeConfGet = "/api/2.0/workspace-conf?keys=enablePurgeJobRunArtifacts%2CjobRunArtifactsRetentionDays%2CnotebookRevisionHistoryRetentionDays%2CworkspaceTrashRetentionDays"
+
+func TestWorkspacePurgePolicyCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"workspaceTrashRetentionDays":  "7",
+					"jobRunArtifactsRetentionDays": "30",
+					"enablePurgeJobRunArtifacts":   "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enablePurgeJobRunArtifacts%2CjobRunArtifactsRetentionDays%2CworkspaceTrashRetentionDays",
+				Response: map[string]any{
+					"workspaceTrashRetentionDays":  "7",
+					"jobRunArtifactsRetentionDays": "30",
+					"enablePurgeJobRunArtifacts":   "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: workspacePurgeConfGet,
+				Response: map[string]any{
+					"workspaceTrashRetentionDays":          "7",
+					"notebookRevisionHistoryRetentionDays": "",
+					"jobRunArtifactsRetentionDays":         "30",
+					"enablePurgeJobRunArtifacts":           "true",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		HCL: `
+		trash_retention_days    = 7
+		job_run_retention_days  = 30
+		purge_job_run_artifacts = true
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                              "_",
+		"trash_retention_days":            7,
+		"revision_history_retention_days": 0,
+		"job_run_retention_days":          30,
+		"purge_job_run_artifacts":         true,
+	})
+}
+
+func TestWorkspacePurgePolicyUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"workspaceTrashRetentionDays": "14",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=workspaceTrashRetentionDays",
+				Response: map[string]any{
+					"workspaceTrashRetentionDays": "14",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: workspacePurgeConfGet,
+				Response: map[string]any{
+					"workspaceTrashRetentionDays": "14",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		InstanceState: map[string]string{
+			"trash_retention_days": "7",
+		},
+		HCL:    `trash_retention_days = 14`,
+		Update: true,
+		ID:     "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"trash_retention_days": 14,
+	})
+}
+
+func TestWorkspacePurgePolicyCreate_KeyIgnored(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"workspaceTrashRetentionDays": "7",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=workspaceTrashRetentionDays",
+				Response: map[string]any{},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		HCL:      `trash_retention_days = 7`,
+		Create:   true,
+	}.ExpectError(t, "workspace didn't apply workspaceTrashRetentionDays=7, the key may not be supported by the workspace")
+}
+
+func TestWorkspacePurgePolicyCreate_KeyRejected(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"workspaceTrashRetentionDays": "7",
+				},
+				Status: 400,
+				Response: map[string]any{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Invalid keys: [\"workspaceTrashRetentionDays\"]",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		HCL:      `trash_retention_days = 7`,
+		Create:   true,
+	}.ExpectError(t, "Invalid keys: [\"workspaceTrashRetentionDays\"]")
+}
+
+func TestWorkspacePurgePolicyRead_InvalidValue(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: workspacePurgeConfGet,
+				Response: map[string]any{
+					"workspaceTrashRetentionDays": "week",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		Read:     true,
+		ID:       "_",
+	}.ExpectError(t, "invalid value of workspaceTrashRetentionDays: week")
+}
+
+func TestWorkspacePurgePolicyRead_UnknownKey(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: workspacePurgeConfGet,
+				Status:   400,
+				Response: map[string]any{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Invalid keys: [\"enablePurgeJobRunArtifacts\"]",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enablePurgeJobRunArtifacts",
+				Status:   400,
+				Response: map[string]any{
+					"error_code": "INVALID_PARAMETER_VALUE",
+					"message":    "Invalid keys: [\"enablePurgeJobRunArtifacts\"]",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=jobRunArtifactsRetentionDays",
+				Response: map[string]any{
+					"jobRunArtifactsRetentionDays": "30",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=notebookRevisionHistoryRetentionDays",
+				Response: map[string]any{
+					"notebookRevisionHistoryRetentionDays": "",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=workspaceTrashRetentionDays",
+				Response: map[string]any{
+					"workspaceTrashRetentionDays": "7",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		Read:     true,
+		ID:       "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"trash_retention_days":   7,
+		"job_run_retention_days": 30,
+	})
+}
+
+func TestWorkspacePurgePolicyDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"workspaceTrashRetentionDays": "",
+					"enablePurgeJobRunArtifacts":  "false",
+				},
+			},
+		},
+		Resource: ResourceWorkspacePurgePolicy(),
+		HCL: `
+		trash_retention_days    = 7
+		purge_job_run_artifacts = true
+		`,
+		Delete: true,
+		ID:     "_",
+	}.ApplyNoError(t)
+}